@@ -1,6 +1,6 @@
 module github.com/orobix/azureml-go-sdk
 
-go 1.17
+go 1.18
 
 require (
 	github.com/AzureAD/microsoft-authentication-library-for-go v0.3.1
@@ -0,0 +1,195 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GetAmlWorkspacesBySubscription Return all the AML workspaces of the subscription of the client
+func (w *Workspace) GetAmlWorkspacesBySubscription() ([]AmlWorkspace, error) {
+	return w.GetAmlWorkspacesBySubscriptionWithContext(context.Background())
+}
+
+func (w *Workspace) GetAmlWorkspacesBySubscriptionWithContext(ctx context.Context) ([]AmlWorkspace, error) {
+	return w.getAmlWorkspaces(ctx, "")
+}
+
+// GetAmlWorkspaces Return all the AML workspaces of the resource group provided as argument
+func (w *Workspace) GetAmlWorkspaces(resourceGroup string) ([]AmlWorkspace, error) {
+	return w.GetAmlWorkspacesWithContext(context.Background(), resourceGroup)
+}
+
+func (w *Workspace) GetAmlWorkspacesWithContext(ctx context.Context, resourceGroup string) ([]AmlWorkspace, error) {
+	if err := validateResourceGroup(resourceGroup); err != nil {
+		return nil, err
+	}
+	return w.getAmlWorkspaces(ctx, resourceGroup)
+}
+
+func (w *Workspace) getAmlWorkspaces(ctx context.Context, resourceGroup string) ([]AmlWorkspace, error) {
+	pager := w.NewAmlWorkspacePager(resourceGroup)
+	result := make([]AmlWorkspace, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewAmlWorkspacePager Return a pager for iterating page by page over the AML workspaces of the resource group
+// provided as argument. If the resource group is empty, the pager iterates over the workspaces of the whole
+// subscription.
+func (w *Workspace) NewAmlWorkspacePager(resourceGroup string) *AmlWorkspacePager {
+	path := withApiVersion("", w.workspacesApiVersion)
+	return newTypedPager(newPager(w.httpClientBuilder.newWorkspaceCollectionClient(resourceGroup), path), unmarshalAmlWorkspaceArray)
+}
+
+func (w *Workspace) GetAmlWorkspace(resourceGroup, name string) (*AmlWorkspace, error) {
+	return w.GetAmlWorkspaceWithContext(context.Background(), resourceGroup, name)
+}
+
+func (w *Workspace) GetAmlWorkspaceWithContext(ctx context.Context, resourceGroup, name string) (*AmlWorkspace, error) {
+	if err := validateAmlWorkspaceReference(resourceGroup, name); err != nil {
+		return nil, err
+	}
+	path := withApiVersion(name, w.workspacesApiVersion)
+	body, err := w.getResourceWith(ctx, w.httpClientBuilder.newWorkspaceCollectionClient(resourceGroup), path, &ResourceNotFoundError{"workspace", name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalAmlWorkspace(body), nil
+}
+
+// CreateOrUpdateAmlWorkspace Start the creation or the update of the AML workspace provided as argument, returning
+// a poller for waiting until the workspace is provisioned. The associated resources must already exist.
+func (w *Workspace) CreateOrUpdateAmlWorkspace(resourceGroup string, workspace *AmlWorkspace) (*AmlWorkspacePoller, error) {
+	return w.CreateOrUpdateAmlWorkspaceWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) CreateOrUpdateAmlWorkspaceWithContext(ctx context.Context, resourceGroup string, workspace *AmlWorkspace) (*AmlWorkspacePoller, error) {
+	if err := validateAmlWorkspaceReference(resourceGroup, workspace.Name); err != nil {
+		return nil, err
+	}
+	if err := validateAmlWorkspace(workspace); err != nil {
+		return nil, err
+	}
+
+	path := withApiVersion(workspace.Name, w.workspacesApiVersion)
+	operation, err := w.beginPutResourceWith(ctx, w.httpClientBuilder.newWorkspaceCollectionClient(resourceGroup), path, toWriteAmlWorkspaceSchema(workspace))
+	if err != nil {
+		return nil, err
+	}
+	operation.resourceGroup = resourceGroup
+	return newPoller(operation, pollerKindAmlWorkspace, unmarshalAmlWorkspace), nil
+}
+
+// DeleteAmlWorkspace Start the deletion of the AML workspace with the name provided as argument. Deleted workspaces
+// are soft-deleted and can be recovered for a retention period, unless forceToPurge is true. The associated
+// resources are not deleted.
+func (w *Workspace) DeleteAmlWorkspace(resourceGroup, name string, forceToPurge bool) (*DeletionPoller, error) {
+	return w.DeleteAmlWorkspaceWithContext(context.Background(), resourceGroup, name, forceToPurge)
+}
+
+func (w *Workspace) DeleteAmlWorkspaceWithContext(ctx context.Context, resourceGroup, name string, forceToPurge bool) (*DeletionPoller, error) {
+	if err := validateAmlWorkspaceReference(resourceGroup, name); err != nil {
+		return nil, err
+	}
+	path := withApiVersion(name, w.workspacesApiVersion)
+	if forceToPurge {
+		path += "&forceToPurge=true"
+	}
+	operation, err := w.beginDeleteResourceWith(ctx, w.httpClientBuilder.newWorkspaceCollectionClient(resourceGroup), path, &ResourceNotFoundError{"workspace", name})
+	if err != nil {
+		return nil, err
+	}
+	operation.resourceGroup = resourceGroup
+	return newPoller(operation, pollerKindDeletion, unmarshalDeletion), nil
+}
+
+// GetAmlWorkspaceKeys Return the keys of the resources associated with the AML workspace with the name provided
+// as argument
+func (w *Workspace) GetAmlWorkspaceKeys(resourceGroup, name string) (*AmlWorkspaceKeys, error) {
+	return w.GetAmlWorkspaceKeysWithContext(context.Background(), resourceGroup, name)
+}
+
+func (w *Workspace) GetAmlWorkspaceKeysWithContext(ctx context.Context, resourceGroup, name string) (*AmlWorkspaceKeys, error) {
+	if err := validateAmlWorkspaceReference(resourceGroup, name); err != nil {
+		return nil, err
+	}
+	path := withApiVersion(fmt.Sprintf("%s/listKeys", name), w.workspacesApiVersion)
+	body, err := w.postResourceWith(ctx, w.httpClientBuilder.newWorkspaceCollectionClient(resourceGroup), path, nil, &ResourceNotFoundError{"workspace", name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalAmlWorkspaceKeys(body), nil
+}
+
+// ResyncAmlWorkspaceKeys Resynchronize the keys of the resources associated with the AML workspace with the name
+// provided as argument, e.g. after regenerating the keys of its storage account, waiting until the operation
+// completes. The operation is checked every DefaultOperationPollInterval, unless the APIs request otherwise.
+func (w *Workspace) ResyncAmlWorkspaceKeys(resourceGroup, name string) error {
+	return w.ResyncAmlWorkspaceKeysWithContext(context.Background(), resourceGroup, name)
+}
+
+func (w *Workspace) ResyncAmlWorkspaceKeysWithContext(ctx context.Context, resourceGroup, name string) error {
+	if err := validateAmlWorkspaceReference(resourceGroup, name); err != nil {
+		return err
+	}
+	path := withApiVersion(fmt.Sprintf("%s/resyncKeys", name), w.workspacesApiVersion)
+	operation, err := w.beginPostResourceWith(ctx, w.httpClientBuilder.newWorkspaceCollectionClient(resourceGroup), path, nil, &ResourceNotFoundError{"workspace", name})
+	if err != nil {
+		return err
+	}
+	return operation.pollUntilDone(ctx, DefaultOperationPollInterval)
+}
+
+// validateResourceGroup Check that the resource group is not empty, since the clients without a resource group
+// address the whole subscription
+func validateResourceGroup(resourceGroup string) error {
+	if strings.TrimSpace(resourceGroup) == "" {
+		return InvalidArgumentError{"the resource group cannot be empty"}
+	}
+	return nil
+}
+
+// validateAmlWorkspaceReference Check that the resource group and the name of a workspace are not empty and can be
+// used as segments of the request paths
+func validateAmlWorkspaceReference(resourceGroup, name string) error {
+	if err := validateResourceGroup(resourceGroup); err != nil {
+		return err
+	}
+	if strings.TrimSpace(name) == "" {
+		return InvalidArgumentError{"the workspace name cannot be empty"}
+	}
+	if strings.ContainsAny(resourceGroup+name, "/?#") {
+		return InvalidArgumentError{fmt.Sprintf("invalid workspace %s/%s, the names cannot contain '/', '?' or '#'", resourceGroup, name)}
+	}
+	return nil
+}
+
+func validateAmlWorkspace(workspace *AmlWorkspace) error {
+	if strings.TrimSpace(workspace.Name) == "" {
+		return InvalidArgumentError{"the workspace name cannot be empty"}
+	}
+	if strings.TrimSpace(workspace.Location) == "" {
+		return InvalidArgumentError{"the workspace location cannot be empty"}
+	}
+	if strings.TrimSpace(workspace.StorageAccountId) == "" {
+		return InvalidArgumentError{"the storage account of the workspace cannot be empty"}
+	}
+	if strings.TrimSpace(workspace.KeyVaultId) == "" {
+		return InvalidArgumentError{"the key vault of the workspace cannot be empty"}
+	}
+	if network := workspace.ManagedNetwork; network != nil {
+		switch network.IsolationMode {
+		case ManagedNetworkIsolationModeDisabled, ManagedNetworkIsolationModeAllowInternetOutbound, ManagedNetworkIsolationModeAllowOnlyApprovedOutbound:
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid isolation mode %q of the managed network", network.IsolationMode)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func getMockedAmlWorkspace() *AmlWorkspace {
+	return &AmlWorkspace{
+		Name:             "ws",
+		Location:         "westeurope",
+		FriendlyName:     "Workspace",
+		StorageAccountId: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/storage",
+		KeyVaultId:       "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/vault",
+		ManagedNetwork:   &ManagedNetwork{IsolationMode: ManagedNetworkIsolationModeAllowOnlyApprovedOutbound},
+	}
+}
+
+func TestHttpClient_GetUrl(t *testing.T) {
+	a := assert.New(t)
+	client := &HttpClient{subscriptionId: "sub", cloud: AzurePublicCloud, workspaceCollection: true}
+
+	a.Equal("https://management.azure.com/subscriptions/sub/providers/Microsoft.MachineLearningServices/workspaces?api-version=2023-10-01", client.getUrl("?api-version=2023-10-01"))
+
+	client.resourceGroupName = "rg"
+	a.Equal("https://management.azure.com/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/listKeys", client.getUrl("ws/listKeys"))
+
+	client.workspaceName, client.workspaceCollection = "ws", false
+	a.Equal("https://management.azure.com/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/datastores", client.getUrl("datastores"))
+}
+
+func TestHttpClient_CheckWorkspace(t *testing.T) {
+	a := assert.New(t)
+	builder := &HttpClientBuilder{subscriptionId: "sub", cloud: AzurePublicCloud}
+
+	// The request is rejected before being sent, otherwise ARM would read the path as the name of a workspace
+	client := builder.newClient("rg", "")
+	resp, err := client.doGet(context.Background(), "datastores")
+	a.Nil(resp)
+	a.Equal(InvalidArgumentError{"the workspace name cannot be empty"}, err)
+	_, err = client.doPut(context.Background(), "computes/cluster", nil)
+	a.IsType(InvalidArgumentError{}, err)
+	_, err = builder.newClient("", "ws").doDelete(context.Background(), "computes/cluster")
+	a.Equal(InvalidArgumentError{"the resource group cannot be empty"}, err)
+
+	a.Nil(builder.newWorkspaceCollectionClient("").(*HttpClient).checkWorkspace())
+	a.Nil(builder.newClient("rg", "ws").(*HttpClient).checkWorkspace())
+}
+
+func TestUnmarshalAmlWorkspace(t *testing.T) {
+	a := assert.New(t)
+
+	workspace := unmarshalAmlWorkspace(loadExampleResp("example_resp_get_aml_workspace.json"))
+	a.Equal("ws", workspace.Name)
+	a.Equal("Trains the fraud detection models", workspace.Description)
+	a.Equal(IdentityTypeSystemAssigned, workspace.Identity.Type)
+	a.Equal(getMockedAmlWorkspace().StorageAccountId, workspace.StorageAccountId)
+	a.Equal(getMockedAmlWorkspace().KeyVaultId, workspace.KeyVaultId)
+	a.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerRegistry/registries/registry", workspace.ContainerRegistryId)
+	a.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Insights/components/insights", workspace.ApplicationInsightsId)
+	a.Equal(PublicNetworkAccessEnabled, workspace.PublicNetworkAccess)
+	a.Equal(&ManagedNetwork{IsolationMode: ManagedNetworkIsolationModeAllowInternetOutbound, Status: "Active"}, workspace.ManagedNetwork)
+	a.Equal("https://westeurope.api.azureml.ms/discovery", workspace.DiscoveryUrl)
+	a.Equal("Succeeded", workspace.ProvisioningState)
+	a.Equal(map[string]string{"team": "ml"}, workspace.Tags)
+
+	keys := unmarshalAmlWorkspaceKeys(loadExampleResp("example_resp_list_aml_workspace_keys.json"))
+	a.Equal("storage-key", keys.UserStorageKey)
+	a.Equal("instrumentation-key", keys.AppInsightsInstrumentationKey)
+	a.Equal(map[string]string{"password": "first-password", "password2": "second-password"}, keys.ContainerRegistryCredentials.Passwords)
+	a.Equal(&NotebookAccessKeys{PrimaryAccessKey: "primary-key", SecondaryAccessKey: "secondary-key"}, keys.NotebookAccessKeys)
+}
+
+func TestValidateAmlWorkspace(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateAmlWorkspace(getMockedAmlWorkspace()))
+
+	invalidWorkspaces := map[string]func(w *AmlWorkspace){
+		"empty name":             func(w *AmlWorkspace) { w.Name = "" },
+		"empty location":         func(w *AmlWorkspace) { w.Location = "" },
+		"empty storage account":  func(w *AmlWorkspace) { w.StorageAccountId = "" },
+		"empty key vault":        func(w *AmlWorkspace) { w.KeyVaultId = "" },
+		"invalid isolation mode": func(w *AmlWorkspace) { w.ManagedNetwork.IsolationMode = "Enabled" },
+	}
+	for name, mutate := range invalidWorkspaces {
+		workspace := getMockedAmlWorkspace()
+		mutate(workspace)
+		a.IsType(InvalidArgumentError{}, validateAmlWorkspace(workspace), name)
+	}
+}
+
+func TestWorkspace_AmlWorkspaces(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	workspaceResp := string(loadExampleResp("example_resp_get_aml_workspace.json"))
+	apiVersion := "?api-version=" + ApiVersion20231001
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get workspaces",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", apiVersion).Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", workspaceResp), nil)
+				mockedHttpClient.On("doGet", "ws"+apiVersion).Return(http.StatusOK, workspaceResp, nil)
+				mockedHttpClient.On("doGet", "foo"+apiVersion).Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				workspaces, err := ws.GetAmlWorkspacesBySubscription()
+				a.Nil(err)
+				a.Len(workspaces, 1)
+				workspaces, err = ws.GetAmlWorkspaces("rg")
+				a.Nil(err)
+				a.Equal("ws", workspaces[0].Name)
+
+				workspace, err := ws.GetAmlWorkspace("rg", "ws")
+				a.Nil(err)
+				a.Equal("00000000-0000-0000-0000-000000000000", workspace.WorkspaceId)
+
+				_, err = ws.GetAmlWorkspace("rg", "foo")
+				a.Equal(&ResourceNotFoundError{"workspace", "foo"}, err)
+				_, err = ws.GetAmlWorkspaces("")
+				a.IsType(InvalidArgumentError{}, err)
+				_, err = ws.GetAmlWorkspace("rg", "")
+				a.IsType(InvalidArgumentError{}, err)
+			},
+		},
+		{
+			testCaseName: "Test create workspace",
+			testCase: func() {
+				workspace := getMockedAmlWorkspace()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "ws"+apiVersion, toWriteAmlWorkspaceSchema(workspace)).Return(
+					http.StatusCreated, "{\"properties\": {\"provisioningState\": \"Creating\"}}", nil,
+					newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"Succeeded\"}", nil)
+				mockedHttpClient.On("doGet", "ws"+apiVersion).Return(http.StatusOK, workspaceResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.CreateOrUpdateAmlWorkspace("rg", workspace)
+				a.Nil(err)
+				a.False(poller.Done())
+				created, err := poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				a.Equal("https://westeurope.api.azureml.ms/discovery", created.DiscoveryUrl)
+				mockedHttpClient.AssertExpectations(t)
+
+				schema := toWriteAmlWorkspaceSchema(workspace)
+				a.Equal(&IdentitySchema{Type: IdentityTypeSystemAssigned}, schema.Identity)
+				a.Equal(&ManagedNetworkSchema{IsolationMode: ManagedNetworkIsolationModeAllowOnlyApprovedOutbound}, schema.Properties.ManagedNetwork)
+
+				_, err = ws.CreateOrUpdateAmlWorkspace("rg", &AmlWorkspace{Name: "ws", Location: "westeurope"})
+				a.IsType(InvalidArgumentError{}, err)
+
+				invalidName := getMockedAmlWorkspace()
+				invalidName.Name = "ws?x=1"
+				_, err = ws.CreateOrUpdateAmlWorkspace("rg", invalidName)
+				a.Equal(InvalidArgumentError{"invalid workspace rg/ws?x=1, the names cannot contain '/', '?' or '#'"}, err)
+			},
+		},
+		{
+			testCaseName: "Test delete workspace",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "ws"+apiVersion+"&forceToPurge=true").Return(
+					http.StatusAccepted, "", nil, newMockedHeader(locationHeader, mockedLocationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusOK, "", nil)
+				mockedHttpClient.On("doDelete", "foo"+apiVersion).Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.DeleteAmlWorkspace("rg", "ws", true)
+				a.Nil(err)
+				_, err = poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+
+				_, err = ws.DeleteAmlWorkspace("rg", "foo", false)
+				a.Equal(&ResourceNotFoundError{"workspace", "foo"}, err)
+			},
+		},
+		{
+			testCaseName: "Test list and resync workspace keys",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPost", "ws/listKeys"+apiVersion, nil).Return(
+					http.StatusOK, string(loadExampleResp("example_resp_list_aml_workspace_keys.json")), nil,
+				)
+				mockedHttpClient.On("doPost", "ws/resyncKeys"+apiVersion, nil).Return(
+					http.StatusAccepted, "", nil, newMockedHeader(locationHeader, mockedLocationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusOK, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				keys, err := ws.GetAmlWorkspaceKeys("rg", "ws")
+				a.Nil(err)
+				a.Equal("registry", keys.ContainerRegistryCredentials.Username)
+
+				a.Nil(ws.ResyncAmlWorkspaceKeys("rg", "ws"))
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
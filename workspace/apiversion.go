@@ -0,0 +1,101 @@
+package workspace
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ResourceType The type of the resources of an AML workspace, corresponding to the first segment of their path.
+type ResourceType string
+
+const (
+	ResourceTypeDatastores      ResourceType = "datastores"
+	ResourceTypeDatasets        ResourceType = "datasets"
+	ResourceTypeData            ResourceType = "data"
+	ResourceTypeModels          ResourceType = "models"
+	ResourceTypeEnvironments    ResourceType = "environments"
+	ResourceTypeJobs            ResourceType = "jobs"
+	ResourceTypeComponents      ResourceType = "components"
+	ResourceTypeOnlineEndpoints ResourceType = "onlineEndpoints"
+	ResourceTypeBatchEndpoints  ResourceType = "batchEndpoints"
+
+	// ResourceTypeWorkspaces The workspaces themselves, whose paths are relative to their resource group or
+	// subscription rather than to a workspace
+	ResourceTypeWorkspaces ResourceType = "workspaces"
+)
+
+const (
+	ApiVersion20210301Preview = "2021-03-01-preview"
+	ApiVersion20220501        = "2022-05-01"
+	ApiVersion20231001        = "2023-10-01"
+
+	// firstGaApiVersion The first GA version of the APIs, which changed the payloads of datastores and
+	// replaced datasets with data containers. Versions are compared by their date.
+	firstGaApiVersion = ApiVersion20220501
+)
+
+// minApiVersions The first API versions supporting the resource types not available in all the versions. Unless
+// a version is set explicitly for these resource types, older versions are replaced by the minimum ones.
+var minApiVersions = map[ResourceType]string{
+	ResourceTypeData:            ApiVersion20220501,
+	ResourceTypeModels:          ApiVersion20220501,
+	ResourceTypeEnvironments:    ApiVersion20220501,
+	ResourceTypeJobs:            ApiVersion20220501,
+	ResourceTypeComponents:      ApiVersion20220501,
+	ResourceTypeOnlineEndpoints: ApiVersion20220501,
+	ResourceTypeBatchEndpoints:  ApiVersion20220501,
+	ResourceTypeWorkspaces:      ApiVersion20231001,
+}
+
+// ApiVersions The versions of the AML APIs used for the requests.
+type ApiVersions struct {
+	// Default The version used for the resource types without a specific version. If empty, 2021-03-01-preview is used.
+	Default string
+
+	// ByResourceType The versions used for specific resource types, overriding the default one.
+	ByResourceType map[ResourceType]string
+}
+
+// forResourceType Return the API version to use for the resource type provided as argument.
+func (v ApiVersions) forResourceType(resourceType ResourceType) string {
+	if version, ok := v.ByResourceType[resourceType]; ok && version != "" {
+		return version
+	}
+	version := v.defaultVersion()
+	if minVersion, ok := minApiVersions[resourceType]; ok && version < minVersion {
+		return minVersion
+	}
+	return version
+}
+
+// defaultVersion Return the API version to use for the resource types without a specific version.
+func (v ApiVersions) defaultVersion() string {
+	if v.Default != "" {
+		return v.Default
+	}
+	return amlApiVersion
+}
+
+// forPath Return the API version to use for the request path provided as argument, relative to the workspace.
+// If the path already specifies the API version, that version is returned.
+func (v ApiVersions) forPath(path string) string {
+	if i := strings.Index(path, "?"); i >= 0 {
+		if query, err := url.ParseQuery(path[i+1:]); err == nil && query.Get("api-version") != "" {
+			return query.Get("api-version")
+		}
+		path = path[:i]
+	}
+	return v.forResourceType(ResourceType(strings.SplitN(path, "/", 2)[0]))
+}
+
+// isGaApiVersion Return true if the version provided as argument uses the payloads of the GA APIs.
+func isGaApiVersion(version string) bool {
+	return version >= firstGaApiVersion
+}
+
+// withApiVersion Return the path with the API version provided as argument, for requests whose path does not
+// correspond to the resource type whose version has to be used.
+func withApiVersion(path, version string) string {
+	return fmt.Sprintf("%s?api-version=%s", path, url.QueryEscape(version))
+}
@@ -0,0 +1,34 @@
+package workspace
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestApiVersions_ForPath(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(amlApiVersion, ApiVersions{}.forPath("datastores/foo"))
+
+	versions := ApiVersions{
+		Default:        ApiVersion20220501,
+		ByResourceType: map[ResourceType]string{ResourceTypeDatastores: "2023-04-01"},
+	}
+	a.Equal("2023-04-01", versions.forPath("datastores"))
+	a.Equal("2023-04-01", versions.forPath("datastores/foo?$skip=1"))
+	a.Equal(ApiVersion20220501, versions.forPath("datasets/foo/versions/1"))
+	a.Equal(ApiVersion20210301Preview, versions.forPath(withApiVersion("datastores/foo", ApiVersion20210301Preview)))
+
+	a.Equal(ApiVersion20220501, ApiVersions{}.forPath("data/foo/versions/1"))
+	a.Equal("2023-04-01", ApiVersions{Default: "2023-04-01"}.forPath("data"))
+	a.Equal(ApiVersion20231001, ApiVersions{}.forResourceType(ResourceTypeWorkspaces))
+	a.Equal("2022-02-01-preview", ApiVersions{ByResourceType: map[ResourceType]string{ResourceTypeData: "2022-02-01-preview"}}.forPath("data"))
+}
+
+func TestIsGaApiVersion(t *testing.T) {
+	a := assert.New(t)
+	a.False(isGaApiVersion(ApiVersion20210301Preview))
+	a.False(isGaApiVersion("2022-02-01-preview"))
+	a.True(isGaApiVersion(ApiVersion20220501))
+	a.True(isGaApiVersion("2023-04-01-preview"))
+}
@@ -0,0 +1,272 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"github.com/tidwall/gjson"
+	"strings"
+)
+
+func (w *Workspace) GetBatchEndpoints(resourceGroup, workspace string) ([]BatchEndpoint, error) {
+	return w.GetBatchEndpointsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetBatchEndpointsWithContext(ctx context.Context, resourceGroup, workspace string) ([]BatchEndpoint, error) {
+	pager := w.NewBatchEndpointPager(resourceGroup, workspace)
+	result := make([]BatchEndpoint, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewBatchEndpointPager Return a pager for iterating page by page over the batch endpoints of the workspace.
+func (w *Workspace) NewBatchEndpointPager(resourceGroup, workspace string) *BatchEndpointPager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "batchEndpoints"), w.endpointConverter.unmarshalBatchEndpointArray)
+}
+
+func (w *Workspace) GetBatchEndpoint(resourceGroup, workspace, name string) (*BatchEndpoint, error) {
+	return w.GetBatchEndpointWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*BatchEndpoint, error) {
+	path := fmt.Sprintf("batchEndpoints/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"batch endpoint", name})
+	if err != nil {
+		return nil, err
+	}
+	return w.endpointConverter.unmarshalBatchEndpoint(body), nil
+}
+
+// CreateOrUpdateBatchEndpoint Start the creation or the update of the batch endpoint provided as argument,
+// returning a poller for waiting until the endpoint is provisioned.
+func (w *Workspace) CreateOrUpdateBatchEndpoint(resourceGroup, workspace string, endpoint *BatchEndpoint) (*BatchEndpointPoller, error) {
+	return w.CreateOrUpdateBatchEndpointWithContext(context.Background(), resourceGroup, workspace, endpoint)
+}
+
+func (w *Workspace) CreateOrUpdateBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace string, endpoint *BatchEndpoint) (*BatchEndpointPoller, error) {
+	if err := validateBatchEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("batchEndpoints/%s", endpoint.Name)
+	operation, err := w.beginPutResource(ctx, resourceGroup, workspace, path, toWriteBatchEndpointSchema(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindBatchEndpoint, w.endpointConverter.unmarshalBatchEndpoint), nil
+}
+
+// DeleteBatchEndpoint Start the deletion of the batch endpoint with the name provided as argument, together
+// with all its deployments.
+func (w *Workspace) DeleteBatchEndpoint(resourceGroup, workspace, name string) (*DeletionPoller, error) {
+	return w.DeleteBatchEndpointWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) DeleteBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*DeletionPoller, error) {
+	path := fmt.Sprintf("batchEndpoints/%s", name)
+	operation, err := w.beginDeleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"batch endpoint", name})
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindDeletion, unmarshalDeletion), nil
+}
+
+func (w *Workspace) GetBatchDeployments(resourceGroup, workspace, endpointName string) ([]BatchDeployment, error) {
+	return w.GetBatchDeploymentsWithContext(context.Background(), resourceGroup, workspace, endpointName)
+}
+
+func (w *Workspace) GetBatchDeploymentsWithContext(ctx context.Context, resourceGroup, workspace, endpointName string) ([]BatchDeployment, error) {
+	pager := w.NewBatchDeploymentPager(resourceGroup, workspace, endpointName)
+	result := make([]BatchDeployment, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewBatchDeploymentPager Return a pager for iterating page by page over the deployments of the batch endpoint
+// with the name provided as argument.
+func (w *Workspace) NewBatchDeploymentPager(resourceGroup, workspace, endpointName string) *BatchDeploymentPager {
+	path := fmt.Sprintf("batchEndpoints/%s/deployments", endpointName)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"batch endpoint", endpointName}
+	return newTypedPager(pager, func(body []byte) []BatchDeployment {
+		return w.endpointConverter.unmarshalBatchDeploymentArray(endpointName, body)
+	})
+}
+
+func (w *Workspace) GetBatchDeployment(resourceGroup, workspace, endpointName, name string) (*BatchDeployment, error) {
+	return w.GetBatchDeploymentWithContext(context.Background(), resourceGroup, workspace, endpointName, name)
+}
+
+func (w *Workspace) GetBatchDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*BatchDeployment, error) {
+	path := fmt.Sprintf("batchEndpoints/%s/deployments/%s", endpointName, name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"batch deployment", deploymentIdentifier(endpointName, name)})
+	if err != nil {
+		return nil, err
+	}
+	return w.endpointConverter.unmarshalBatchDeployment(endpointName, body), nil
+}
+
+// CreateOrUpdateBatchDeployment Start the creation or the update of the batch deployment provided as argument,
+// returning a poller for waiting until the deployment is provisioned.
+func (w *Workspace) CreateOrUpdateBatchDeployment(resourceGroup, workspace string, deployment *BatchDeployment) (*BatchDeploymentPoller, error) {
+	return w.CreateOrUpdateBatchDeploymentWithContext(context.Background(), resourceGroup, workspace, deployment)
+}
+
+func (w *Workspace) CreateOrUpdateBatchDeploymentWithContext(ctx context.Context, resourceGroup, workspace string, deployment *BatchDeployment) (*BatchDeploymentPoller, error) {
+	if err := validateBatchDeployment(deployment); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("batchEndpoints/%s/deployments/%s", deployment.EndpointName, deployment.Name)
+	operation, err := w.beginPutResource(ctx, resourceGroup, workspace, path, toWriteBatchDeploymentSchema(deployment))
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindBatchDeployment, func(body []byte) *BatchDeployment {
+		return w.endpointConverter.unmarshalBatchDeployment(deployment.EndpointName, body)
+	}), nil
+}
+
+// DeleteBatchDeployment Start the deletion of the deployment with the name provided as argument of the
+// specified batch endpoint.
+func (w *Workspace) DeleteBatchDeployment(resourceGroup, workspace, endpointName, name string) (*DeletionPoller, error) {
+	return w.DeleteBatchDeploymentWithContext(context.Background(), resourceGroup, workspace, endpointName, name)
+}
+
+func (w *Workspace) DeleteBatchDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*DeletionPoller, error) {
+	path := fmt.Sprintf("batchEndpoints/%s/deployments/%s", endpointName, name)
+	notFoundErr := &ResourceNotFoundError{"batch deployment", deploymentIdentifier(endpointName, name)}
+	operation, err := w.beginDeleteResource(ctx, resourceGroup, workspace, path, notFoundErr)
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindDeletion, unmarshalDeletion), nil
+}
+
+// BatchInvocationOptions The options of a batch endpoint invocation
+type BatchInvocationOptions struct {
+	// Deployment The name of the deployment running the job. If empty, the default deployment of the endpoint is used.
+	Deployment string
+
+	// Output The datastore path to which the results are written. If nil, the results are written to the default
+	// datastore of the workspace.
+	Output *DatastorePath
+}
+
+// InvokeBatchEndpoint Start a job scoring the data of the input provided as argument with the batch endpoint with
+// the specified name, and return the job. The input must reference the data to score, e.g. it can be created with
+// NewDatasetJobInput or NewDatastoreJobInput. Use WaitForJob or WatchJob for waiting until the job completes.
+func (w *Workspace) InvokeBatchEndpoint(resourceGroup, workspace, endpointName string, input JobInput, options *BatchInvocationOptions) (*Job, error) {
+	return w.InvokeBatchEndpointWithContext(context.Background(), resourceGroup, workspace, endpointName, input, options)
+}
+
+func (w *Workspace) InvokeBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace, endpointName string, input JobInput, options *BatchInvocationOptions) (*Job, error) {
+	if err := validateBatchInvocationInput(input); err != nil {
+		return nil, err
+	}
+	endpoint, err := w.GetBatchEndpointWithContext(ctx, resourceGroup, workspace, endpointName)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.ScoringUri == "" {
+		return nil, fmt.Errorf("batch endpoint %s has no scoring URI, provisioning state is %q", endpointName, endpoint.ProvisioningState)
+	}
+	authorizer, err := w.newAadTokenAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	if options == nil {
+		options = &BatchInvocationOptions{}
+	}
+	client := newScoringClient(endpoint.ScoringUri, w.scoringHttpClient, w.scoringRetrier, w.logger, authorizer)
+	body, err := client.Score(ctx, toBatchInvocationSchema(input, options.Output), &ScoringOptions{Deployment: options.Deployment})
+	if err != nil {
+		return nil, err
+	}
+
+	// The response describes the job with the schema of the data plane, the job is retrieved from the AML APIs
+	jobName := gjson.GetBytes(body, "name").Str
+	if jobName == "" {
+		jobId := gjson.GetBytes(body, "id").Str
+		jobName = jobId[strings.LastIndex(jobId, "/")+1:]
+	}
+	if jobName == "" {
+		return nil, fmt.Errorf("the invocation of batch endpoint %s returned no job", endpointName)
+	}
+	return w.GetJobWithContext(ctx, resourceGroup, workspace, jobName)
+}
+
+func validateBatchEndpoint(endpoint *BatchEndpoint) error {
+	if strings.TrimSpace(endpoint.Name) == "" {
+		return InvalidArgumentError{"the endpoint name cannot be empty"}
+	}
+	if strings.TrimSpace(endpoint.Location) == "" {
+		return InvalidArgumentError{"the endpoint location cannot be empty"}
+	}
+	return nil
+}
+
+func validateBatchDeployment(deployment *BatchDeployment) error {
+	if strings.TrimSpace(deployment.EndpointName) == "" {
+		return InvalidArgumentError{"the endpoint name of the deployment cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.Name) == "" {
+		return InvalidArgumentError{"the deployment name cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.Location) == "" {
+		return InvalidArgumentError{"the deployment location cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.ModelId) == "" {
+		return InvalidArgumentError{"the model of the deployment cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.ComputeId) == "" {
+		return InvalidArgumentError{"the compute of the deployment cannot be empty"}
+	}
+	if code := deployment.CodeConfiguration; code != nil && (code.CodeId == "" || code.ScoringScript == "") {
+		return InvalidArgumentError{"the code configuration of the deployment must have both the code and the scoring script"}
+	}
+	if deployment.InstanceCount < 0 || deployment.MaxConcurrencyPerInstance < 0 || deployment.MiniBatchSize < 0 {
+		return InvalidArgumentError{"the instance count, the concurrency and the mini batch size of the deployment cannot be negative"}
+	}
+	if deployment.ErrorThreshold < -1 {
+		return InvalidArgumentError{fmt.Sprintf("the error threshold of the deployment must be -1 or greater, got %d", deployment.ErrorThreshold)}
+	}
+	switch deployment.LoggingLevel {
+	case "", BatchLoggingLevelInfo, BatchLoggingLevelWarning, BatchLoggingLevelDebug:
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid logging level %q", deployment.LoggingLevel)}
+	}
+	switch deployment.OutputAction {
+	case "", BatchOutputActionSummaryOnly, BatchOutputActionAppendRow:
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid output action %q", deployment.OutputAction)}
+	}
+	if retrySettings := deployment.RetrySettings; retrySettings != nil && (retrySettings.MaxRetries < 0 || retrySettings.Timeout < 0) {
+		return InvalidArgumentError{"the retry settings of the deployment cannot be negative"}
+	}
+	return nil
+}
+
+func validateBatchInvocationInput(input JobInput) error {
+	switch input.InputType {
+	case JobInputTypeUriFile, JobInputTypeUriFolder, JobInputTypeMLTable:
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid type %q of the batch endpoint input", input.InputType)}
+	}
+	if strings.TrimSpace(input.Uri) == "" {
+		return InvalidArgumentError{"the URI of the batch endpoint input cannot be empty"}
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const mockedComputeId = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/computes/cpu-cluster"
+
+func getMockedBatchEndpoint() *BatchEndpoint {
+	return &BatchEndpoint{
+		Name:              "batch-endpoint",
+		Location:          "westeurope",
+		DefaultDeployment: "classifier",
+	}
+}
+
+func getMockedBatchDeployment() *BatchDeployment {
+	return &BatchDeployment{
+		Name:          "classifier",
+		EndpointName:  "batch-endpoint",
+		Location:      "westeurope",
+		ModelId:       "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/models/model/versions/2",
+		EnvironmentId: mockedEnvironmentId,
+		CodeConfiguration: &CodeConfiguration{
+			CodeId:        "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/codes/score/versions/1",
+			ScoringScript: "batch_score.py",
+		},
+		ComputeId:      mockedComputeId,
+		InstanceCount:  2,
+		MiniBatchSize:  10,
+		ErrorThreshold: -1,
+		OutputAction:   BatchOutputActionAppendRow,
+		RetrySettings:  &BatchRetrySettings{MaxRetries: 3, Timeout: 30 * time.Second},
+	}
+}
+
+func TestUnmarshalBatchEndpoint(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &EndpointConverter{l.Sugar()}
+
+	endpoint := converter.unmarshalBatchEndpoint(loadExampleResp("example_resp_get_batch_endpoint.json"))
+	a.Equal("batch-endpoint", endpoint.Name)
+	a.Equal("Scores the monthly transactions", endpoint.Description)
+	a.Equal("classifier", endpoint.DefaultDeployment)
+	a.Equal(IdentityTypeSystemAssigned, endpoint.Identity.Type)
+	a.Equal("https://batch-endpoint.westeurope.inference.ml.azure.com/jobs", endpoint.ScoringUri)
+	a.Equal("", endpoint.SwaggerUri)
+	a.Equal("Succeeded", endpoint.ProvisioningState)
+
+	deployment := converter.unmarshalBatchDeployment("batch-endpoint", loadExampleResp("example_resp_get_batch_deployment.json"))
+	a.Equal("classifier", deployment.Name)
+	a.Equal("batch-endpoint", deployment.EndpointName)
+	a.Equal(getMockedBatchDeployment().ModelId, deployment.ModelId)
+	a.Equal(getMockedBatchDeployment().CodeConfiguration, deployment.CodeConfiguration)
+	a.Equal(mockedComputeId, deployment.ComputeId)
+	a.Equal(2, deployment.InstanceCount)
+	a.Equal(4, deployment.MaxConcurrencyPerInstance)
+	a.Equal(10, deployment.MiniBatchSize)
+	a.Equal(-1, deployment.ErrorThreshold)
+	a.Equal(BatchLoggingLevelInfo, deployment.LoggingLevel)
+	a.Equal(BatchOutputActionAppendRow, deployment.OutputAction)
+	a.Equal("predictions.csv", deployment.OutputFileName)
+	a.Equal(&BatchRetrySettings{MaxRetries: 3, Timeout: 30 * time.Second}, deployment.RetrySettings)
+}
+
+func TestToWriteBatchDeploymentSchema(t *testing.T) {
+	a := assert.New(t)
+
+	schema := toWriteBatchDeploymentSchema(getMockedBatchDeployment())
+	a.Equal(&AssetReferenceSchema{ReferenceType: "Id", AssetId: getMockedBatchDeployment().ModelId}, schema.Properties.Model)
+	a.Equal(&ResourceConfigurationSchema{InstanceCount: 2}, schema.Properties.Resources)
+	a.Equal(&BatchRetrySettingsSchema{MaxRetries: 3, Timeout: "PT30S"}, schema.Properties.RetrySettings)
+	a.Equal(-1, schema.Properties.ErrorThreshold)
+
+	endpointSchema := toWriteBatchEndpointSchema(getMockedBatchEndpoint())
+	a.Equal(EndpointAuthModeAADToken, endpointSchema.Properties.AuthMode)
+	a.Equal(&BatchEndpointDefaultsSchema{DeploymentName: "classifier"}, endpointSchema.Properties.Defaults)
+	a.Equal(&IdentitySchema{Type: IdentityTypeSystemAssigned}, endpointSchema.Identity)
+}
+
+func TestValidateBatchDeployment(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateBatchDeployment(getMockedBatchDeployment()))
+
+	invalidDeployments := map[string]func(d *BatchDeployment){
+		"empty endpoint":        func(d *BatchDeployment) { d.EndpointName = "" },
+		"empty name":            func(d *BatchDeployment) { d.Name = "" },
+		"empty location":        func(d *BatchDeployment) { d.Location = "" },
+		"empty model":           func(d *BatchDeployment) { d.ModelId = "" },
+		"empty compute":         func(d *BatchDeployment) { d.ComputeId = "" },
+		"no scoring script":     func(d *BatchDeployment) { d.CodeConfiguration.ScoringScript = "" },
+		"negative batch size":   func(d *BatchDeployment) { d.MiniBatchSize = -1 },
+		"invalid threshold":     func(d *BatchDeployment) { d.ErrorThreshold = -2 },
+		"invalid logging level": func(d *BatchDeployment) { d.LoggingLevel = "Verbose" },
+		"invalid output action": func(d *BatchDeployment) { d.OutputAction = "foo" },
+		"negative retries":      func(d *BatchDeployment) { d.RetrySettings.MaxRetries = -1 },
+	}
+	for name, mutate := range invalidDeployments {
+		deployment := getMockedBatchDeployment()
+		mutate(deployment)
+		a.IsType(InvalidArgumentError{}, validateBatchDeployment(deployment), name)
+	}
+}
+
+func TestWorkspace_BatchEndpoints(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	endpointResp := string(loadExampleResp("example_resp_get_batch_endpoint.json"))
+	deploymentResp := string(loadExampleResp("example_resp_get_batch_deployment.json"))
+	asyncOperationHeaders := newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl, "Retry-After", "0")
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get batch endpoints and deployments",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "batchEndpoints").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", endpointResp), nil)
+				mockedHttpClient.On("doGet", "batchEndpoints/batch-endpoint/deployments").Return(
+					http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", deploymentResp), nil,
+				)
+				mockedHttpClient.On("doGet", "batchEndpoints/batch-endpoint/deployments/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				endpoints, err := ws.GetBatchEndpoints("rg", "ws")
+				a.Nil(err)
+				a.Len(endpoints, 1)
+				a.Equal("batch-endpoint", endpoints[0].Name)
+
+				deployments, err := ws.GetBatchDeployments("rg", "ws", "batch-endpoint")
+				a.Nil(err)
+				a.Len(deployments, 1)
+				a.Equal("batch-endpoint", deployments[0].EndpointName)
+
+				deployment, err := ws.GetBatchDeployment("rg", "ws", "batch-endpoint", "foo")
+				a.Nil(deployment)
+				a.Equal(&ResourceNotFoundError{"batch deployment", "batch-endpoint/foo"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create batch endpoint and deployment",
+			testCase: func() {
+				endpoint := getMockedBatchEndpoint()
+				deployment := getMockedBatchDeployment()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "batchEndpoints/batch-endpoint", toWriteBatchEndpointSchema(endpoint)).Return(http.StatusOK, endpointResp, nil)
+				mockedHttpClient.On("doPut", "batchEndpoints/batch-endpoint/deployments/classifier", toWriteBatchDeploymentSchema(deployment)).Return(
+					http.StatusCreated, "{\"properties\": {\"provisioningState\": \"Creating\"}}", nil, asyncOperationHeaders,
+				)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"Succeeded\"}", nil)
+				mockedHttpClient.On("doGet", "batchEndpoints/batch-endpoint/deployments/classifier").Return(http.StatusOK, deploymentResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				endpointPoller, err := ws.CreateOrUpdateBatchEndpoint("rg", "ws", endpoint)
+				a.Nil(err)
+				a.True(endpointPoller.Done())
+				createdEndpoint, err := endpointPoller.Result()
+				a.Nil(err)
+				a.Equal("classifier", createdEndpoint.DefaultDeployment)
+
+				deploymentPoller, err := ws.CreateOrUpdateBatchDeployment("rg", "ws", deployment)
+				a.Nil(err)
+				a.False(deploymentPoller.Done())
+				createdDeployment, err := deploymentPoller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				a.Equal(mockedComputeId, createdDeployment.ComputeId)
+				mockedHttpClient.AssertExpectations(t)
+
+				_, err = ws.CreateOrUpdateBatchEndpoint("rg", "ws", &BatchEndpoint{Name: "batch-endpoint"})
+				a.IsType(InvalidArgumentError{}, err)
+			},
+		},
+		{
+			testCaseName: "Test delete batch endpoint",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "batchEndpoints/batch-endpoint").Return(
+					http.StatusAccepted, "", nil, newMockedHeader(locationHeader, mockedLocationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusOK, "", nil)
+				mockedHttpClient.On("doDelete", "batchEndpoints/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.DeleteBatchEndpoint("rg", "ws", "batch-endpoint")
+				a.Nil(err)
+				_, err = poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+
+				_, err = ws.DeleteBatchEndpoint("rg", "ws", "foo")
+				a.Equal(&ResourceNotFoundError{"batch endpoint", "foo"}, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestWorkspace_InvokeBatchEndpoint(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+
+	var requests []*http.Request
+	var requestBodies []BatchInvocationSchema
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body BatchInvocationSchema
+		rawBody, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(rawBody, &body)
+		requests = append(requests, r)
+		requestBodies = append(requestBodies, body)
+		_, _ = w.Write([]byte("{\"id\": \"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/jobs/train-1\"}"))
+	}))
+	defer server.Close()
+	endpointResp := fmt.Sprintf("{\"name\": \"batch-endpoint\", \"properties\": {\"scoringUri\": \"%s/jobs\"}}", server.URL)
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test invoke batch endpoint with a datastore path",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "batchEndpoints/batch-endpoint").Return(http.StatusOK, endpointResp, nil)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, string(loadExampleResp("example_resp_get_command_job.json")), nil)
+
+				credential := &fakeTokenCredential{token: AccessToken{Token: "token"}}
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				ws.credential = credential
+				requests, requestBodies = nil, nil
+
+				input := NewDatastoreJobInput(JobInputTypeUriFolder, DatastorePath{DatastoreName: "datastore", Path: "transactions/2022-06"})
+				output := &DatastorePath{DatastoreName: "datastore", Path: "predictions"}
+				job, err := ws.InvokeBatchEndpoint("rg", "ws", "batch-endpoint", input, &BatchInvocationOptions{Deployment: "classifier", Output: output})
+				a.Nil(err)
+				a.Equal("train-1", job.Name)
+
+				a.Len(requests, 1)
+				a.Equal("/jobs", requests[0].URL.Path)
+				a.Equal("Bearer token", requests[0].Header.Get("Authorization"))
+				a.Equal("classifier", requests[0].Header.Get(deploymentHeader))
+				a.Equal([]string{AzurePublicCloud.MachineLearningScope}, credential.scopes)
+				a.Equal(BatchInvocationDataSchema{JobInputType: "UriFolder", Uri: "azureml://datastores/datastore/paths/transactions/2022-06"}, requestBodies[0].Properties.InputData["input"])
+				a.Equal(BatchInvocationDataSchema{JobOutputType: "UriFolder", Uri: "azureml://datastores/datastore/paths/predictions"}, requestBodies[0].Properties.OutputData["output"])
+			},
+		},
+		{
+			testCaseName: "Test invoke batch endpoint with a dataset",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "batchEndpoints/batch-endpoint").Return(http.StatusOK, endpointResp, nil)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, string(loadExampleResp("example_resp_get_command_job.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				ws.credential = &fakeTokenCredential{token: AccessToken{Token: "token"}}
+				requests, requestBodies = nil, nil
+
+				_, err := ws.InvokeBatchEndpoint("rg", "ws", "batch-endpoint", NewDatasetJobInput(&Dataset{Name: "transactions", Version: 3}), nil)
+				a.Nil(err)
+				a.Equal("", requests[0].Header.Get(deploymentHeader))
+				a.Equal(BatchInvocationDataSchema{JobInputType: "UriFolder", Uri: "azureml:transactions:3"}, requestBodies[0].Properties.InputData["input"])
+				a.Nil(requestBodies[0].Properties.OutputData)
+			},
+		},
+		{
+			testCaseName: "Test invoke batch endpoint with invalid input",
+			testCase: func() {
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				_, err := ws.InvokeBatchEndpoint("rg", "ws", "batch-endpoint", NewLiteralJobInput("foo"), nil)
+				a.IsType(InvalidArgumentError{}, err)
+				_, err = ws.InvokeBatchEndpoint("rg", "ws", "batch-endpoint", JobInput{InputType: JobInputTypeUriFile}, nil)
+				a.IsType(InvalidArgumentError{}, err)
+			},
+		},
+		{
+			testCaseName: "Test invoke batch endpoint without credential",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "batchEndpoints/batch-endpoint").Return(http.StatusOK, endpointResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				input := NewDatastoreJobInput(JobInputTypeUriFile, DatastorePath{DatastoreName: "datastore", Path: "data.csv"})
+				job, err := ws.InvokeBatchEndpoint("rg", "ws", "batch-endpoint", input, nil)
+				a.Nil(job)
+				a.NotNil(err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
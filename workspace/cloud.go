@@ -0,0 +1,72 @@
+package workspace
+
+import "strings"
+
+// Cloud The endpoints of an Azure cloud used by the SDK.
+type Cloud struct {
+	// ActiveDirectoryAuthorityHost The host of the Azure Active Directory authority, e.g. https://login.microsoftonline.com/
+	ActiveDirectoryAuthorityHost string
+
+	// ResourceManagerEndpoint The endpoint of the Azure Resource Manager APIs, e.g. https://management.azure.com
+	ResourceManagerEndpoint string
+
+	// ResourceManagerScope The OAuth scope of the tokens used for calling the Azure Resource Manager APIs.
+	// If empty, the scope is derived from the ResourceManagerEndpoint.
+	ResourceManagerScope string
+
+	// MachineLearningScope The OAuth scope of the tokens used for calling the data plane of the workspaces, such as
+	// the scoring URIs of the endpoints using the AADToken auth mode. If empty, the scope of the Azure public cloud
+	// is used.
+	MachineLearningScope string
+
+	// StorageEndpointSuffix The suffix of the storage accounts endpoints, e.g. core.windows.net
+	StorageEndpointSuffix string
+}
+
+var (
+	AzurePublicCloud = Cloud{
+		ActiveDirectoryAuthorityHost: "https://login.microsoftonline.com/",
+		ResourceManagerEndpoint:      "https://management.azure.com",
+		ResourceManagerScope:         DefaultAmlOauthScope,
+		MachineLearningScope:         "https://ml.azure.com/.default",
+		StorageEndpointSuffix:        "core.windows.net",
+	}
+
+	AzureChinaCloud = Cloud{
+		ActiveDirectoryAuthorityHost: "https://login.chinacloudapi.cn/",
+		ResourceManagerEndpoint:      "https://management.chinacloudapi.cn",
+		ResourceManagerScope:         "https://management.chinacloudapi.cn/.default",
+		MachineLearningScope:         "https://ml.azure.cn/.default",
+		StorageEndpointSuffix:        "core.chinacloudapi.cn",
+	}
+
+	AzureUSGovernmentCloud = Cloud{
+		ActiveDirectoryAuthorityHost: "https://login.microsoftonline.us/",
+		ResourceManagerEndpoint:      "https://management.usgovcloudapi.net",
+		ResourceManagerScope:         "https://management.usgovcloudapi.net/.default",
+		MachineLearningScope:         "https://ml.azure.us/.default",
+		StorageEndpointSuffix:        "core.usgovcloudapi.net",
+	}
+)
+
+// withDefaults Return a copy of the cloud in which the empty endpoints are replaced with the ones
+// of the Azure public cloud.
+func (c Cloud) withDefaults() Cloud {
+	if c.ActiveDirectoryAuthorityHost == "" {
+		c.ActiveDirectoryAuthorityHost = AzurePublicCloud.ActiveDirectoryAuthorityHost
+	}
+	if c.ResourceManagerEndpoint == "" {
+		c.ResourceManagerEndpoint = AzurePublicCloud.ResourceManagerEndpoint
+	}
+	c.ResourceManagerEndpoint = strings.TrimSuffix(c.ResourceManagerEndpoint, "/")
+	if c.ResourceManagerScope == "" {
+		c.ResourceManagerScope = c.ResourceManagerEndpoint + "/.default"
+	}
+	if c.MachineLearningScope == "" {
+		c.MachineLearningScope = AzurePublicCloud.MachineLearningScope
+	}
+	if c.StorageEndpointSuffix == "" {
+		c.StorageEndpointSuffix = AzurePublicCloud.StorageEndpointSuffix
+	}
+	return c
+}
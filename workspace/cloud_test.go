@@ -0,0 +1,94 @@
+package workspace
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCloud_WithDefaults(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(AzurePublicCloud, Cloud{}.withDefaults())
+	a.Equal(AzureChinaCloud, AzureChinaCloud.withDefaults())
+
+	custom := Cloud{ResourceManagerEndpoint: "http://localhost:8080/"}.withDefaults()
+	a.Equal("http://localhost:8080", custom.ResourceManagerEndpoint)
+	a.Equal("http://localhost:8080/.default", custom.ResourceManagerScope)
+	a.Equal(AzurePublicCloud.ActiveDirectoryAuthorityHost, custom.ActiveDirectoryAuthorityHost)
+	a.Equal(AzurePublicCloud.StorageEndpointSuffix, custom.StorageEndpointSuffix)
+	a.Equal(AzurePublicCloud.MachineLearningScope, custom.MachineLearningScope)
+}
+
+func TestWorkspace_CustomCloudEndpoints(t *testing.T) {
+	a := assert.New(t)
+	var requests []*http.Request
+	var requestBodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r)
+		requestBodies = append(requestBodies, string(body))
+		_, _ = w.Write(loadExampleResp("example_resp_get_datastore_list_page_2.json"))
+	}))
+	defer server.Close()
+
+	credential := &fakeTokenCredential{token: AccessToken{Token: "token"}}
+	config := Config{
+		SubscriptionId: "sub",
+		Credential:     credential,
+		Cloud: Cloud{
+			ResourceManagerEndpoint: server.URL,
+			ResourceManagerScope:    "api://fake-arm/.default",
+			StorageEndpointSuffix:   AzureChinaCloud.StorageEndpointSuffix,
+		},
+	}
+	ws, err := New(config, false)
+	a.Nil(err)
+
+	datastores, err := ws.GetDatastores("rg", "ws")
+	a.Nil(err)
+	a.Len(datastores, 2)
+	a.Equal([]string{"api://fake-arm/.default"}, credential.scopes)
+	a.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/datastores", requests[0].URL.Path)
+	a.Equal(amlApiVersion, requests[0].URL.Query().Get("api-version"))
+	a.Equal("Bearer token", requests[0].Header.Get("Authorization"))
+
+	_, err = ws.CreateOrUpdateDatastore("rg", "ws", &Datastore{Name: "datastore"})
+	a.Nil(err)
+	a.Equal(http.MethodPut, requests[1].Method)
+	a.Contains(requestBodies[1], "\"endpoint\":\"core.chinacloudapi.cn\"")
+}
+
+func TestWorkspace_ApiVersionByResourceType(t *testing.T) {
+	a := assert.New(t)
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		_, _ = w.Write(loadExampleResp("example_resp_get_data_version.json"))
+	}))
+	defer server.Close()
+
+	config := Config{
+		SubscriptionId: "sub",
+		Credential:     &fakeTokenCredential{token: AccessToken{Token: "token"}},
+		Cloud:          Cloud{ResourceManagerEndpoint: server.URL},
+		ApiVersions: ApiVersions{
+			Default:        "2021-10-01",
+			ByResourceType: map[ResourceType]string{ResourceTypeDatasets: ApiVersion20220501},
+		},
+	}
+	ws, err := New(config, false)
+	a.Nil(err)
+
+	dataset, err := ws.GetDataset("rg", "ws", "dataset", 3)
+	a.Nil(err)
+	a.Equal(3, dataset.Version)
+	a.Len(dataset.FilePaths, 1)
+	a.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/data/dataset/versions/3", requests[0].URL.Path)
+	a.Equal(ApiVersion20220501, requests[0].URL.Query().Get("api-version"))
+
+	_, _ = ws.GetDatastore("rg", "ws", "datastore")
+	a.Equal("2021-10-01", requests[1].URL.Query().Get("api-version"))
+}
@@ -0,0 +1,255 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// azureMLAssetPrefix The prefix of the references to the assets in the component specs
+	azureMLAssetPrefix = "azureml:"
+
+	commandComponentSchemaUrl = "https://azuremlschemas.azureedge.net/latest/commandComponent.schema.json"
+
+	inputsPlaceholderPrefix  = "inputs."
+	outputsPlaceholderPrefix = "outputs."
+)
+
+func (w *Workspace) GetComponents(resourceGroup, workspace string) ([]Component, error) {
+	return w.GetComponentsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetComponentsWithContext(ctx context.Context, resourceGroup, workspace string) ([]Component, error) {
+	pager := w.NewComponentPager(resourceGroup, workspace)
+	result := make([]Component, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewComponentPager Return a pager for iterating page by page over the components of the workspace.
+func (w *Workspace) NewComponentPager(resourceGroup, workspace string) *ComponentPager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "components"), unmarshalComponentArray)
+}
+
+func (w *Workspace) GetComponent(resourceGroup, workspace, name string) (*Component, error) {
+	return w.GetComponentWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetComponentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Component, error) {
+	path := fmt.Sprintf("components/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"component", name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalComponent(body), nil
+}
+
+func (w *Workspace) CreateOrUpdateComponent(resourceGroup, workspace string, component *Component) (*Component, error) {
+	return w.CreateOrUpdateComponentWithContext(context.Background(), resourceGroup, workspace, component)
+}
+
+func (w *Workspace) CreateOrUpdateComponentWithContext(ctx context.Context, resourceGroup, workspace string, component *Component) (*Component, error) {
+	if strings.TrimSpace(component.Name) == "" {
+		return nil, InvalidArgumentError{"the component name cannot be empty"}
+	}
+
+	path := fmt.Sprintf("components/%s", component.Name)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteComponentSchema(component))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalComponent(body), nil
+}
+
+// ArchiveComponent Archive the component with the name provided as argument. Archived components are hidden from
+// the default list operations, but can still be used.
+func (w *Workspace) ArchiveComponent(resourceGroup, workspace, name string) (*Component, error) {
+	return w.ArchiveComponentWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) ArchiveComponentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Component, error) {
+	component, err := w.GetComponentWithContext(ctx, resourceGroup, workspace, name)
+	if err != nil {
+		return nil, err
+	}
+	component.IsArchived = true
+	return w.CreateOrUpdateComponentWithContext(ctx, resourceGroup, workspace, component)
+}
+
+func (w *Workspace) DeleteComponent(resourceGroup, workspace, name string) error {
+	return w.DeleteComponentWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) DeleteComponentWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	path := fmt.Sprintf("components/%s", name)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"component", name})
+}
+
+func (w *Workspace) GetComponentVersions(resourceGroup, workspace, componentName string) ([]ComponentVersion, error) {
+	return w.GetComponentVersionsWithContext(context.Background(), resourceGroup, workspace, componentName)
+}
+
+func (w *Workspace) GetComponentVersionsWithContext(ctx context.Context, resourceGroup, workspace, componentName string) ([]ComponentVersion, error) {
+	pager := w.NewComponentVersionPager(resourceGroup, workspace, componentName)
+	result := make([]ComponentVersion, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewComponentVersionPager Return a pager for iterating page by page over the versions of the component
+// with the name provided as argument.
+func (w *Workspace) NewComponentVersionPager(resourceGroup, workspace, componentName string) *ComponentVersionPager {
+	path := fmt.Sprintf("components/%s/versions", componentName)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"component", componentName}
+	return newTypedPager(pager, func(body []byte) []ComponentVersion {
+		return unmarshalComponentVersionArray(componentName, body)
+	})
+}
+
+func (w *Workspace) GetComponentVersion(resourceGroup, workspace, componentName, version string) (*ComponentVersion, error) {
+	return w.GetComponentVersionWithContext(context.Background(), resourceGroup, workspace, componentName, version)
+}
+
+func (w *Workspace) GetComponentVersionWithContext(ctx context.Context, resourceGroup, workspace, componentName, version string) (*ComponentVersion, error) {
+	path := fmt.Sprintf("components/%s/versions/%s", componentName, version)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"component", assetVersionIdentifier(componentName, version)})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalComponentVersion(componentName, body), nil
+}
+
+// CreateOrUpdateComponentVersion Register the component version provided as argument. The command is validated
+// before sending the request: every placeholder must reference an input or an output declared by the component.
+func (w *Workspace) CreateOrUpdateComponentVersion(resourceGroup, workspace string, componentVersion *ComponentVersion) (*ComponentVersion, error) {
+	return w.CreateOrUpdateComponentVersionWithContext(context.Background(), resourceGroup, workspace, componentVersion)
+}
+
+func (w *Workspace) CreateOrUpdateComponentVersionWithContext(ctx context.Context, resourceGroup, workspace string, componentVersion *ComponentVersion) (*ComponentVersion, error) {
+	if err := validateComponentVersion(componentVersion); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("components/%s/versions/%s", componentVersion.Name, componentVersion.Version)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteComponentVersionSchema(componentVersion))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalComponentVersion(componentVersion.Name, body), nil
+}
+
+// ArchiveComponentVersion Archive the version provided as argument of the component with the specified name.
+func (w *Workspace) ArchiveComponentVersion(resourceGroup, workspace, componentName, version string) (*ComponentVersion, error) {
+	return w.ArchiveComponentVersionWithContext(context.Background(), resourceGroup, workspace, componentName, version)
+}
+
+func (w *Workspace) ArchiveComponentVersionWithContext(ctx context.Context, resourceGroup, workspace, componentName, version string) (*ComponentVersion, error) {
+	componentVersion, err := w.GetComponentVersionWithContext(ctx, resourceGroup, workspace, componentName, version)
+	if err != nil {
+		return nil, err
+	}
+	componentVersion.IsArchived = true
+	return w.CreateOrUpdateComponentVersionWithContext(ctx, resourceGroup, workspace, componentVersion)
+}
+
+func (w *Workspace) DeleteComponentVersion(resourceGroup, workspace, componentName, version string) error {
+	return w.DeleteComponentVersionWithContext(context.Background(), resourceGroup, workspace, componentName, version)
+}
+
+func (w *Workspace) DeleteComponentVersionWithContext(ctx context.Context, resourceGroup, workspace, componentName, version string) error {
+	path := fmt.Sprintf("components/%s/versions/%s", componentName, version)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"component", assetVersionIdentifier(componentName, version)})
+}
+
+func validateComponentVersion(componentVersion *ComponentVersion) error {
+	if strings.TrimSpace(componentVersion.Name) == "" {
+		return InvalidArgumentError{"the component name cannot be empty"}
+	}
+	if strings.TrimSpace(componentVersion.Version) == "" {
+		return InvalidArgumentError{"the component version cannot be empty"}
+	}
+	if componentVersion.ComponentType != "" && componentVersion.ComponentType != ComponentTypeCommand {
+		return InvalidArgumentError{fmt.Sprintf("unsupported component type %q", componentVersion.ComponentType)}
+	}
+	if strings.TrimSpace(componentVersion.Command) == "" {
+		return InvalidArgumentError{"the command of the component cannot be empty"}
+	}
+	if strings.TrimSpace(componentVersion.EnvironmentId) == "" {
+		return InvalidArgumentError{"the environment of the component cannot be empty"}
+	}
+
+	for name, input := range componentVersion.Inputs {
+		if err := validateComponentInput(name, input); err != nil {
+			return err
+		}
+	}
+	for name, output := range componentVersion.Outputs {
+		switch output.Type {
+		case ComponentIOTypeUriFile, ComponentIOTypeUriFolder, ComponentIOTypeMLTable,
+			ComponentIOTypeCustomModel, ComponentIOTypeMLflowModel, ComponentIOTypeTritonModel:
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid type %q of output %s", output.Type, name)}
+		}
+	}
+
+	for _, placeholder := range commandPlaceholders(componentVersion.Command) {
+		switch {
+		case strings.HasPrefix(placeholder, inputsPlaceholderPrefix):
+			if _, ok := componentVersion.Inputs[strings.TrimPrefix(placeholder, inputsPlaceholderPrefix)]; !ok {
+				return InvalidArgumentError{fmt.Sprintf("the command references the undeclared input %q", placeholder)}
+			}
+		case strings.HasPrefix(placeholder, outputsPlaceholderPrefix):
+			if _, ok := componentVersion.Outputs[strings.TrimPrefix(placeholder, outputsPlaceholderPrefix)]; !ok {
+				return InvalidArgumentError{fmt.Sprintf("the command references the undeclared output %q", placeholder)}
+			}
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid placeholder %q, the command can only reference inputs and outputs", placeholder)}
+		}
+	}
+	return nil
+}
+
+func validateComponentInput(name string, input ComponentInput) error {
+	var err error
+	switch input.Type {
+	case ComponentIOTypeUriFile, ComponentIOTypeUriFolder, ComponentIOTypeMLTable,
+		ComponentIOTypeCustomModel, ComponentIOTypeMLflowModel, ComponentIOTypeTritonModel:
+		if input.Default != "" {
+			return InvalidArgumentError{fmt.Sprintf("input %s of type %s cannot have a default value", name, input.Type)}
+		}
+	case ComponentIOTypeString:
+	case ComponentIOTypeNumber:
+		if input.Default != "" {
+			_, err = strconv.ParseFloat(input.Default, 64)
+		}
+	case ComponentIOTypeInteger:
+		if input.Default != "" {
+			_, err = strconv.ParseInt(input.Default, 10, 64)
+		}
+	case ComponentIOTypeBoolean:
+		if input.Default != "" {
+			_, err = strconv.ParseBool(input.Default)
+		}
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid type %q of input %s", input.Type, name)}
+	}
+	if err != nil {
+		return InvalidArgumentError{fmt.Sprintf("invalid default value %q of %s input %s", input.Default, input.Type, name)}
+	}
+	return nil
+}
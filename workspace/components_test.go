@@ -0,0 +1,228 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+const (
+	mockedCodeId        = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/codes/train/versions/1"
+	mockedEnvironmentId = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/environments/env/versions/1"
+)
+
+func getMockedComponentVersion() *ComponentVersion {
+	return &ComponentVersion{
+		Id:            "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/components/train/versions/2",
+		Name:          "train",
+		Version:       "2",
+		DisplayName:   "Train",
+		Command:       "python train.py --data ${{inputs.data}} --lr ${{inputs.lr}} $[[--epochs ${{inputs.epochs}}]] --model ${{outputs.model}}",
+		CodeId:        mockedCodeId,
+		EnvironmentId: mockedEnvironmentId,
+		Inputs: map[string]ComponentInput{
+			"data":   {Type: ComponentIOTypeUriFolder, Description: "The training data"},
+			"lr":     {Type: ComponentIOTypeNumber, Default: "0.01"},
+			"epochs": {Type: ComponentIOTypeInteger, Optional: true},
+		},
+		Outputs: map[string]ComponentOutput{
+			"model": {Type: ComponentIOTypeMLflowModel},
+		},
+	}
+}
+
+func TestUnmarshalComponentVersion(t *testing.T) {
+	a := assert.New(t)
+
+	componentVersion := unmarshalComponentVersion("train", loadExampleResp("example_resp_get_component_version.json"))
+	a.Equal("train", componentVersion.Name)
+	a.Equal("2", componentVersion.Version)
+	a.Equal("Train", componentVersion.DisplayName)
+	a.Equal("Train a model", componentVersion.Description)
+	a.Equal(ComponentTypeCommand, componentVersion.ComponentType)
+	a.Equal(getMockedComponentVersion().Command, componentVersion.Command)
+	a.Equal(mockedCodeId, componentVersion.CodeId)
+	a.Equal(mockedEnvironmentId, componentVersion.EnvironmentId)
+	a.Equal(getMockedComponentVersion().Inputs, componentVersion.Inputs)
+	a.Equal(map[string]ComponentOutput{"model": {Type: ComponentIOTypeMLflowModel}}, componentVersion.Outputs)
+	a.Equal(map[string]string{"team": "ml"}, componentVersion.Tags)
+	a.NotNil(componentVersion.SystemData)
+}
+
+func TestToWriteComponentVersionSchema(t *testing.T) {
+	a := assert.New(t)
+
+	componentVersion := getMockedComponentVersion()
+	componentVersion.Inputs["verbose"] = ComponentInput{Type: ComponentIOTypeBoolean, Default: "false"}
+	schema := toWriteComponentVersionSchema(componentVersion).Properties.(WriteComponentVersionSchema)
+	spec := schema.ComponentSpec
+	a.Equal(ComponentTypeCommand, spec.Type)
+	a.Equal("train", spec.Name)
+	a.Equal("2", spec.Version)
+	a.Equal("azureml:"+mockedCodeId, spec.Code)
+	a.Equal("azureml:"+mockedEnvironmentId, spec.Environment)
+	a.Equal(json.Number("0.01"), spec.Inputs["lr"].Default)
+	a.Equal(false, spec.Inputs["verbose"].Default)
+	a.Nil(spec.Inputs["data"].Default)
+	a.True(spec.Inputs["epochs"].Optional)
+
+	body, err := json.Marshal(spec.Inputs["lr"])
+	a.Nil(err)
+	a.JSONEq(`{"type": "number", "default": 0.01}`, string(body))
+
+	schema = toWriteComponentVersionSchema(&ComponentVersion{EnvironmentId: mockedEnvironmentId}).Properties.(WriteComponentVersionSchema)
+	a.Empty(schema.ComponentSpec.Code)
+	a.Nil(schema.ComponentSpec.Inputs)
+}
+
+func TestValidateComponentVersion(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateComponentVersion(getMockedComponentVersion()))
+
+	invalidComponentVersions := map[string]func(c *ComponentVersion){
+		"empty name":          func(c *ComponentVersion) { c.Name = "" },
+		"empty version":       func(c *ComponentVersion) { c.Version = " " },
+		"unsupported type":    func(c *ComponentVersion) { c.ComponentType = "parallel" },
+		"empty command":       func(c *ComponentVersion) { c.Command = "" },
+		"empty environment":   func(c *ComponentVersion) { c.EnvironmentId = "" },
+		"invalid input type":  func(c *ComponentVersion) { c.Inputs["data"] = ComponentInput{Type: "foo"} },
+		"literal output type": func(c *ComponentVersion) { c.Outputs["model"] = ComponentOutput{Type: ComponentIOTypeString} },
+		"invalid default": func(c *ComponentVersion) {
+			c.Inputs["lr"] = ComponentInput{Type: ComponentIOTypeNumber, Default: "foo"}
+		},
+		"data default": func(c *ComponentVersion) {
+			c.Inputs["data"] = ComponentInput{Type: ComponentIOTypeUriFile, Default: "foo"}
+		},
+		"undeclared input":    func(c *ComponentVersion) { delete(c.Inputs, "lr") },
+		"undeclared output":   func(c *ComponentVersion) { delete(c.Outputs, "model") },
+		"invalid placeholder": func(c *ComponentVersion) { c.Command += " ${{search_space.lr}}" },
+	}
+	for name, mutate := range invalidComponentVersions {
+		componentVersion := getMockedComponentVersion()
+		mutate(componentVersion)
+		a.IsType(InvalidArgumentError{}, validateComponentVersion(componentVersion), name)
+	}
+}
+
+func TestWorkspace_Components(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	componentResp := string(loadExampleResp("example_resp_get_component.json"))
+	componentVersionResp := string(loadExampleResp("example_resp_get_component_version.json"))
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get components",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "components").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", componentResp), nil)
+				mockedHttpClient.On("doGet", "components/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				components, err := ws.GetComponents("rg", "ws")
+				a.Nil(err)
+				a.Len(components, 1)
+				a.Equal("train", components[0].Name)
+				a.Equal("2", components[0].LatestVersion)
+
+				component, err := ws.GetComponent("rg", "ws", "foo")
+				a.Nil(component)
+				a.Equal(&ResourceNotFoundError{"component", "foo"}, err)
+			},
+		},
+		{
+			testCaseName: "Test get component versions",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "components/train/versions").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", componentVersionResp), nil)
+				mockedHttpClient.On("doGet", "components/train/versions/3").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				componentVersions, err := ws.GetComponentVersions("rg", "ws", "train")
+				a.Nil(err)
+				a.Len(componentVersions, 1)
+				a.Equal("2", componentVersions[0].Version)
+
+				componentVersion, err := ws.GetComponentVersion("rg", "ws", "train", "3")
+				a.Nil(componentVersion)
+				a.Equal(&ResourceNotFoundError{"component", "train:3"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create or update component version with undeclared placeholder",
+			testCase: func() {
+				componentVersion := getMockedComponentVersion()
+				componentVersion.Command += " --seed ${{inputs.seed}}"
+
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				result, err := ws.CreateOrUpdateComponentVersion("rg", "ws", componentVersion)
+				a.Nil(result)
+				a.Equal(InvalidArgumentError{"the command references the undeclared input \"inputs.seed\""}, err)
+			},
+		},
+		{
+			testCaseName: "Test create or update component version success",
+			testCase: func() {
+				componentVersion := getMockedComponentVersion()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "components/train/versions/2", toWriteComponentVersionSchema(componentVersion)).Return(http.StatusCreated, componentVersionResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateComponentVersion("rg", "ws", componentVersion)
+				a.Nil(err)
+				a.Equal("train", result.Name)
+				a.Equal(componentVersion.Inputs, result.Inputs)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test archive component and component version",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "components/train").Return(http.StatusOK, componentResp, nil)
+				mockedHttpClient.On("doPut", "components/train", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					properties, ok := schema.Properties.(WriteComponentSchema)
+					return ok && properties.IsArchived && properties.Description == "Train a model"
+				})).Return(http.StatusOK, componentResp, nil)
+				mockedHttpClient.On("doGet", "components/train/versions/2").Return(http.StatusOK, componentVersionResp, nil)
+				mockedHttpClient.On("doPut", "components/train/versions/2", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					properties, ok := schema.Properties.(WriteComponentVersionSchema)
+					return ok && properties.IsArchived
+				})).Return(http.StatusOK, componentVersionResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				_, err := ws.ArchiveComponent("rg", "ws", "train")
+				a.Nil(err)
+				_, err = ws.ArchiveComponentVersion("rg", "ws", "train", "2")
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test delete component and component version",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "components/train/versions/2").Return(http.StatusOK, "", nil)
+				mockedHttpClient.On("doDelete", "components/train").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				a.Nil(ws.DeleteComponentVersion("rg", "ws", "train", "2"))
+				a.Equal(&ResourceNotFoundError{"component", "train"}, ws.DeleteComponent("rg", "ws", "train"))
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
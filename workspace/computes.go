@@ -0,0 +1,190 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (w *Workspace) GetComputes(resourceGroup, workspace string) ([]Compute, error) {
+	return w.GetComputesWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetComputesWithContext(ctx context.Context, resourceGroup, workspace string) ([]Compute, error) {
+	pager := w.NewComputePager(resourceGroup, workspace)
+	result := make([]Compute, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewComputePager Return a pager for iterating page by page over the computes of the workspace.
+func (w *Workspace) NewComputePager(resourceGroup, workspace string) *ComputePager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "computes"), w.computeConverter.unmarshalComputeArray)
+}
+
+func (w *Workspace) GetCompute(resourceGroup, workspace, name string) (*Compute, error) {
+	return w.GetComputeWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetComputeWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Compute, error) {
+	path := fmt.Sprintf("computes/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"compute", name})
+	if err != nil {
+		return nil, err
+	}
+	return w.computeConverter.unmarshalCompute(body), nil
+}
+
+// CreateOrUpdateCompute Create or update the compute with the data provided as argument. Block until the compute is
+// provisioned, checking it every DefaultOperationPollInterval unless the APIs request otherwise. Use
+// BeginCreateOrUpdateCompute for polling it with a different interval.
+func (w *Workspace) CreateOrUpdateCompute(resourceGroup, workspace string, compute *Compute) (*Compute, error) {
+	return w.CreateOrUpdateComputeWithContext(context.Background(), resourceGroup, workspace, compute)
+}
+
+func (w *Workspace) CreateOrUpdateComputeWithContext(ctx context.Context, resourceGroup, workspace string, compute *Compute) (*Compute, error) {
+	poller, err := w.BeginCreateOrUpdateComputeWithContext(ctx, resourceGroup, workspace, compute)
+	if err != nil {
+		return nil, err
+	}
+	return poller.PollUntilDone(ctx, DefaultOperationPollInterval)
+}
+
+// BeginCreateOrUpdateCompute Start the creation or the update of the compute provided as argument, returning a
+// poller for waiting until the compute is provisioned.
+func (w *Workspace) BeginCreateOrUpdateCompute(resourceGroup, workspace string, compute *Compute) (*ComputePoller, error) {
+	return w.BeginCreateOrUpdateComputeWithContext(context.Background(), resourceGroup, workspace, compute)
+}
+
+func (w *Workspace) BeginCreateOrUpdateComputeWithContext(ctx context.Context, resourceGroup, workspace string, compute *Compute) (*ComputePoller, error) {
+	if err := validateCompute(compute); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("computes/%s", compute.Name)
+	operation, err := w.beginPutResource(ctx, resourceGroup, workspace, path, toWriteComputeSchema(compute))
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindCompute, w.computeConverter.unmarshalCompute), nil
+}
+
+// DeleteCompute Delete the compute with the name provided as argument. The underlying resource is either deleted
+// or only detached from the workspace, depending on the action provided as argument. Block until the compute is
+// deleted, checking it every DefaultOperationPollInterval unless the APIs request otherwise.
+func (w *Workspace) DeleteCompute(resourceGroup, workspace, name string, action UnderlyingResourceAction) error {
+	return w.DeleteComputeWithContext(context.Background(), resourceGroup, workspace, name, action)
+}
+
+func (w *Workspace) DeleteComputeWithContext(ctx context.Context, resourceGroup, workspace, name string, action UnderlyingResourceAction) error {
+	poller, err := w.BeginDeleteComputeWithContext(ctx, resourceGroup, workspace, name, action)
+	if err != nil {
+		return err
+	}
+	_, err = poller.PollUntilDone(ctx, DefaultOperationPollInterval)
+	return err
+}
+
+// BeginDeleteCompute Start the deletion of the compute with the name provided as argument, returning a poller for
+// waiting until the compute is deleted. The underlying resource is either deleted or only detached from the
+// workspace, depending on the action provided as argument.
+func (w *Workspace) BeginDeleteCompute(resourceGroup, workspace, name string, action UnderlyingResourceAction) (*DeletionPoller, error) {
+	return w.BeginDeleteComputeWithContext(context.Background(), resourceGroup, workspace, name, action)
+}
+
+func (w *Workspace) BeginDeleteComputeWithContext(ctx context.Context, resourceGroup, workspace, name string, action UnderlyingResourceAction) (*DeletionPoller, error) {
+	if action != UnderlyingResourceActionDelete && action != UnderlyingResourceActionDetach {
+		return nil, InvalidArgumentError{fmt.Sprintf("invalid underlying resource action %q", action)}
+	}
+	path := fmt.Sprintf("computes/%s?underlyingResourceAction=%s", name, action)
+	operation, err := w.beginDeleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"compute", name})
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindDeletion, unmarshalDeletion), nil
+}
+
+// StartComputeInstance Start the compute instance with the name provided as argument
+func (w *Workspace) StartComputeInstance(resourceGroup, workspace, name string) error {
+	return w.StartComputeInstanceWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) StartComputeInstanceWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	return w.computeInstanceAction(ctx, resourceGroup, workspace, name, "start")
+}
+
+// StopComputeInstance Stop the compute instance with the name provided as argument
+func (w *Workspace) StopComputeInstance(resourceGroup, workspace, name string) error {
+	return w.StopComputeInstanceWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) StopComputeInstanceWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	return w.computeInstanceAction(ctx, resourceGroup, workspace, name, "stop")
+}
+
+// RestartComputeInstance Restart the compute instance with the name provided as argument
+func (w *Workspace) RestartComputeInstance(resourceGroup, workspace, name string) error {
+	return w.RestartComputeInstanceWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) RestartComputeInstanceWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	return w.computeInstanceAction(ctx, resourceGroup, workspace, name, "restart")
+}
+
+// computeInstanceAction Perform the action provided as argument on a compute instance. The action is accepted by
+// the APIs and performed asynchronously.
+func (w *Workspace) computeInstanceAction(ctx context.Context, resourceGroup, workspace, name, action string) error {
+	path := fmt.Sprintf("computes/%s/%s", name, action)
+	_, err := w.postResource(ctx, resourceGroup, workspace, path, nil, &ResourceNotFoundError{"compute", name})
+	return err
+}
+
+// ListComputeNodes Return the nodes of the AmlCompute cluster with the name provided as argument
+func (w *Workspace) ListComputeNodes(resourceGroup, workspace, name string) ([]ComputeNode, error) {
+	return w.ListComputeNodesWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) ListComputeNodesWithContext(ctx context.Context, resourceGroup, workspace, name string) ([]ComputeNode, error) {
+	path := fmt.Sprintf("computes/%s/listNodes", name)
+	body, err := w.postResource(ctx, resourceGroup, workspace, path, nil, &ResourceNotFoundError{"compute", name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalComputeNodes(body), nil
+}
+
+func validateCompute(compute *Compute) error {
+	if strings.TrimSpace(compute.Name) == "" {
+		return InvalidArgumentError{"the compute name cannot be empty"}
+	}
+	if compute.AmlCompute != nil && compute.ComputeInstance != nil {
+		return InvalidArgumentError{"a compute cannot be both a cluster and an instance"}
+	}
+
+	switch compute.ComputeType {
+	case ComputeTypeAmlCompute:
+		if compute.AmlCompute == nil || strings.TrimSpace(compute.AmlCompute.VmSize) == "" {
+			return InvalidArgumentError{"the VM size of the cluster cannot be empty"}
+		}
+		if scaleSettings := compute.AmlCompute.ScaleSettings; scaleSettings != nil {
+			if scaleSettings.MinNodeCount < 0 || scaleSettings.MaxNodeCount < scaleSettings.MinNodeCount {
+				return InvalidArgumentError{fmt.Sprintf(
+					"invalid node count range [%d, %d]", scaleSettings.MinNodeCount, scaleSettings.MaxNodeCount,
+				)}
+			}
+		}
+	case ComputeTypeComputeInstance:
+		if compute.ComputeInstance == nil || strings.TrimSpace(compute.ComputeInstance.VmSize) == "" {
+			return InvalidArgumentError{"the VM size of the compute instance cannot be empty"}
+		}
+	default:
+		return InvalidArgumentError{fmt.Sprintf("unsupported compute type %q", compute.ComputeType)}
+	}
+	return nil
+}
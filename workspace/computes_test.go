@@ -0,0 +1,286 @@
+package workspace
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseIsoDuration(t *testing.T) {
+	a := assert.New(t)
+
+	testCases := map[string]time.Duration{
+		"PT120S":    120 * time.Second,
+		"PT2M":      2 * time.Minute,
+		"PT1H30M":   90 * time.Minute,
+		"P1DT1S":    24*time.Hour + time.Second,
+		"PT0.5S":    500 * time.Millisecond,
+		"PT1M30.5S": 90*time.Second + 500*time.Millisecond,
+	}
+	for duration, expected := range testCases {
+		parsed, err := parseIsoDuration(duration)
+		a.Nil(err)
+		a.Equal(expected, parsed, duration)
+	}
+
+	for _, duration := range []string{"", "P", "PT", "P1Y", "2M", "PT2X"} {
+		_, err := parseIsoDuration(duration)
+		a.NotNil(err, duration)
+	}
+
+	a.Equal("PT150S", formatIsoDuration(150*time.Second))
+}
+
+func TestUnmarshalCompute(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &ComputeConverter{l.Sugar()}
+
+	cluster := converter.unmarshalCompute(loadExampleResp("example_resp_get_compute_cluster.json"))
+	a.Equal("cluster", cluster.Name)
+	a.Equal("westeurope", cluster.Location)
+	a.Equal(ComputeTypeAmlCompute, cluster.ComputeType)
+	a.Equal("Succeeded", cluster.ProvisioningState)
+	a.True(cluster.DisableLocalAuth)
+	a.Nil(cluster.ComputeInstance)
+	a.Equal(IdentityTypeUserAssigned, cluster.Identity.Type)
+	a.Equal([]string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity"}, cluster.Identity.UserAssignedIdentities)
+	a.Equal(&AmlComputeProperties{
+		VmSize:                      "STANDARD_DS3_V2",
+		VmPriority:                  "LowPriority",
+		ScaleSettings:               &ScaleSettings{MinNodeCount: 0, MaxNodeCount: 4, NodeIdleTimeBeforeScaleDown: 2 * time.Minute},
+		SubnetId:                    "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default",
+		RemoteLoginPortPublicAccess: "Disabled",
+		AllocationState:             "Resizing",
+		CurrentNodeCount:            1,
+		TargetNodeCount:             2,
+	}, cluster.AmlCompute)
+
+	instance := converter.unmarshalCompute(loadExampleResp("example_resp_get_compute_instance.json"))
+	a.Equal(ComputeTypeComputeInstance, instance.ComputeType)
+	a.Nil(instance.AmlCompute)
+	a.Nil(instance.Identity)
+	a.Equal(&ComputeInstanceProperties{
+		VmSize:                   "STANDARD_DS11_V2",
+		SshPublicAccess:          true,
+		SshAdminPublicKey:        "ssh-rsa AAAA",
+		AssignedUser:             &AssignedUser{ObjectId: "object", TenantId: "tenant"},
+		ApplicationSharingPolicy: "Personal",
+		State:                    "Running",
+	}, instance.ComputeInstance)
+}
+
+func TestToWriteComputeSchema(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &ComputeConverter{l.Sugar()}
+
+	cluster := converter.unmarshalCompute(loadExampleResp("example_resp_get_compute_cluster.json"))
+	schema := toWriteComputeSchema(cluster)
+	a.Equal("westeurope", schema.Location)
+	a.Equal(&IdentitySchema{
+		Type:                   IdentityTypeUserAssigned,
+		UserAssignedIdentities: map[string]struct{}{cluster.Identity.UserAssignedIdentities[0]: {}},
+	}, schema.Identity)
+	a.Equal(ComputeTypeAmlCompute, schema.Properties.ComputeType)
+	a.Equal(AmlComputePropertiesSchema{
+		VmSize:                      "STANDARD_DS3_V2",
+		VmPriority:                  "LowPriority",
+		ScaleSettings:               &ScaleSettingsSchema{MinNodeCount: 0, MaxNodeCount: 4, NodeIdleTimeBeforeScaleDown: "PT120S"},
+		Subnet:                      &ResourceIdSchema{Id: cluster.AmlCompute.SubnetId},
+		RemoteLoginPortPublicAccess: "Disabled",
+	}, schema.Properties.Properties)
+
+	instance := &Compute{Name: "instance", ComputeType: ComputeTypeComputeInstance, ComputeInstance: &ComputeInstanceProperties{VmSize: "STANDARD_DS11_V2"}}
+	schema = toWriteComputeSchema(instance)
+	a.Nil(schema.Identity)
+	a.Equal(ComputeInstancePropertiesSchema{
+		VmSize:      "STANDARD_DS11_V2",
+		SshSettings: &SshSettingsSchema{SshPublicAccess: "Disabled"},
+	}, schema.Properties.Properties)
+}
+
+func TestValidateCompute(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateCompute(&Compute{Name: "cluster", ComputeType: ComputeTypeAmlCompute, AmlCompute: &AmlComputeProperties{VmSize: "size"}}))
+	a.Nil(validateCompute(&Compute{Name: "instance", ComputeType: ComputeTypeComputeInstance, ComputeInstance: &ComputeInstanceProperties{VmSize: "size"}}))
+
+	invalidComputes := []*Compute{
+		{ComputeType: ComputeTypeAmlCompute, AmlCompute: &AmlComputeProperties{VmSize: "size"}},
+		{Name: "cluster", ComputeType: ComputeTypeAmlCompute},
+		{Name: "cluster", ComputeType: ComputeTypeAmlCompute, AmlCompute: &AmlComputeProperties{VmSize: "size", ScaleSettings: &ScaleSettings{MinNodeCount: 2, MaxNodeCount: 1}}},
+		{Name: "cluster", ComputeType: ComputeTypeComputeInstance, AmlCompute: &AmlComputeProperties{VmSize: "size"}},
+		{Name: "cluster", ComputeType: "Kubernetes"},
+		{Name: "cluster", ComputeType: ComputeTypeAmlCompute, AmlCompute: &AmlComputeProperties{VmSize: "size"}, ComputeInstance: &ComputeInstanceProperties{VmSize: "size"}},
+	}
+	for _, compute := range invalidComputes {
+		a.IsType(InvalidArgumentError{}, validateCompute(compute))
+	}
+}
+
+func TestWorkspace_Computes(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get compute not found",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "computes/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				compute, err := ws.GetCompute("rg", "ws", "foo")
+				a.Nil(compute)
+				a.Equal(&ResourceNotFoundError{"compute", "foo"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create or update compute",
+			testCase: func() {
+				compute := &Compute{Name: "cluster", ComputeType: ComputeTypeAmlCompute, AmlCompute: &AmlComputeProperties{VmSize: "STANDARD_DS3_V2"}}
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "computes/cluster", toWriteComputeSchema(compute)).Return(http.StatusCreated, string(loadExampleResp("example_resp_get_compute_cluster.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateCompute("rg", "ws", compute)
+				a.Nil(err)
+				a.Equal("cluster", result.Name)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test begin create or update compute",
+			testCase: func() {
+				compute := &Compute{Name: "cluster", ComputeType: ComputeTypeAmlCompute, AmlCompute: &AmlComputeProperties{VmSize: "STANDARD_DS3_V2"}}
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "computes/cluster", toWriteComputeSchema(compute)).Return(
+					http.StatusCreated, "{\"name\": \"cluster\", \"properties\": {\"provisioningState\": \"Creating\"}}", nil,
+					newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"Succeeded\"}", nil)
+				mockedHttpClient.On("doGet", "computes/cluster").Return(http.StatusOK, "{\"name\": \"cluster\", \"properties\": {\"provisioningState\": \"Succeeded\"}}", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateCompute("rg", "ws", compute)
+				a.Nil(err)
+				a.Equal("Succeeded", result.ProvisioningState)
+				mockedHttpClient.AssertNumberOfCalls(t, "doGetUrl", 1)
+
+				poller, err := ws.BeginCreateOrUpdateCompute("rg", "ws", compute)
+				a.Nil(err)
+				result, err = poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				a.Equal("Succeeded", result.ProvisioningState)
+			},
+		},
+		{
+			testCaseName: "Test delete compute",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "computes/cluster?underlyingResourceAction=Detach").Return(http.StatusAccepted, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				a.Nil(ws.DeleteCompute("rg", "ws", "cluster", UnderlyingResourceActionDetach))
+				a.IsType(InvalidArgumentError{}, ws.DeleteCompute("rg", "ws", "cluster", "foo"))
+				mockedHttpClient.AssertNumberOfCalls(t, "doDelete", 1)
+			},
+		},
+		{
+			testCaseName: "Test delete compute waits until the compute is deleted",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "computes/cluster?underlyingResourceAction=Delete").Return(
+					http.StatusAccepted, "", nil, newMockedHeader(locationHeader, mockedLocationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(
+					http.StatusAccepted, "", nil, newMockedHeader("Retry-After", "0"),
+				).Once()
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusNoContent, "", nil).Once()
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				a.Nil(ws.DeleteCompute("rg", "ws", "cluster", UnderlyingResourceActionDelete))
+				mockedHttpClient.AssertNumberOfCalls(t, "doGetUrl", 2)
+			},
+		},
+		{
+			testCaseName: "Test compute instance actions",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPost", "computes/instance/start", mock.Anything).Return(http.StatusAccepted, "", nil)
+				mockedHttpClient.On("doPost", "computes/instance/stop", mock.Anything).Return(http.StatusAccepted, "", nil)
+				mockedHttpClient.On("doPost", "computes/instance/restart", mock.Anything).Return(http.StatusConflict, "error", nil)
+				mockedHttpClient.On("doPost", "computes/foo/start", mock.Anything).Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				a.Nil(ws.StartComputeInstance("rg", "ws", "instance"))
+				a.Nil(ws.StopComputeInstance("rg", "ws", "instance"))
+				a.Equal(&HttpResponseError{StatusCode: http.StatusConflict, ResponseContent: "error"}, ws.RestartComputeInstance("rg", "ws", "instance"))
+				a.Equal(&ResourceNotFoundError{"compute", "foo"}, ws.StartComputeInstance("rg", "ws", "foo"))
+			},
+		},
+		{
+			testCaseName: "Test list compute nodes",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPost", "computes/cluster/listNodes", mock.Anything).Return(http.StatusOK, string(loadExampleResp("example_resp_list_compute_nodes.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				nodes, err := ws.ListComputeNodes("rg", "ws", "cluster")
+				a.Nil(err)
+				a.Len(nodes, 2)
+				a.Equal(ComputeNode{
+					NodeId:           "tvmps_1",
+					PrivateIpAddress: "10.0.0.4",
+					PublicIpAddress:  "20.0.0.1",
+					Port:             50000,
+					NodeState:        "running",
+					RunId:            "run-1",
+				}, nodes[0])
+				a.Empty(nodes[1].RunId)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestHttpClient_DoPost(t *testing.T) {
+	a := assert.New(t)
+	var request *http.Request
+	var requestBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		requestBody, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	ws, err := New(Config{
+		SubscriptionId: "sub",
+		Credential:     &fakeTokenCredential{token: AccessToken{Token: "token"}},
+		Cloud:          Cloud{ResourceManagerEndpoint: server.URL},
+	}, false)
+	a.Nil(err)
+
+	a.Nil(ws.StartComputeInstance("rg", "ws", "instance"))
+	a.Equal(http.MethodPost, request.Method)
+	a.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/computes/instance/start", request.URL.Path)
+	a.Equal(amlApiVersion, request.URL.Query().Get("api-version"))
+	a.Empty(request.Header.Get("Content-Type"))
+	a.Empty(requestBody)
+}
@@ -0,0 +1,205 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// configFileName The name of the config file downloaded from the portal of the AML workspaces
+	configFileName = "config.json"
+
+	// DefaultProfile The profile loaded by LoadConfigProfile if no profile is specified
+	DefaultProfile = "default"
+)
+
+// ConfigError The error returned when a configuration cannot be loaded because it is invalid or incomplete
+type ConfigError struct {
+	// Source The source of the configuration, e.g. the path of a config file
+	Source string
+
+	// MissingFields The names of the required fields not set by the source
+	MissingFields []string
+
+	// Reason Why the configuration is invalid, if it is not because of missing fields
+	Reason string
+}
+
+func (e ConfigError) Error() string {
+	if len(e.MissingFields) > 0 {
+		return fmt.Sprintf("invalid config from %s: missing required fields %s", e.Source, strings.Join(e.MissingFields, ", "))
+	}
+	return fmt.Sprintf("invalid config from %s: %s", e.Source, e.Reason)
+}
+
+// configField A field of a configuration source, used for reporting the missing ones
+type configField struct {
+	name  string
+	value string
+}
+
+// checkRequiredFields Return a ConfigError listing the fields provided as argument which are empty, or nil if
+// they are all set
+func checkRequiredFields(source string, fields ...configField) error {
+	var missing []string
+	for _, field := range fields {
+		if strings.TrimSpace(field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		return ConfigError{Source: source, MissingFields: missing}
+	}
+	return nil
+}
+
+// workspaceConfigFile The content of the config files of the AML workspaces, and of each profile of a profiles file
+type workspaceConfigFile struct {
+	SubscriptionId string `json:"subscription_id"`
+	ResourceGroup  string `json:"resource_group"`
+	WorkspaceName  string `json:"workspace_name"`
+
+	// TenantId, ClientId, ClientSecret The optional Service Principal of the profiles
+	TenantId     string `json:"tenant_id"`
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// toConfig Return the config of the file, authenticating with its Service Principal if set, or else with the
+// DefaultAzureCredential
+func (f workspaceConfigFile) toConfig(source string) (Config, error) {
+	err := checkRequiredFields(
+		source,
+		configField{"subscription_id", f.SubscriptionId},
+		configField{"resource_group", f.ResourceGroup},
+		configField{"workspace_name", f.WorkspaceName},
+	)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config := Config{
+		SubscriptionId: f.SubscriptionId,
+		ResourceGroup:  f.ResourceGroup,
+		WorkspaceName:  f.WorkspaceName,
+	}
+	if f.TenantId == "" && f.ClientId == "" && f.ClientSecret == "" {
+		config.Credential = NewDefaultAzureCredential(nil)
+		return config, nil
+	}
+	err = checkRequiredFields(
+		source,
+		configField{"tenant_id", f.TenantId},
+		configField{"client_id", f.ClientId},
+		configField{"client_secret", f.ClientSecret},
+	)
+	if err != nil {
+		return Config{}, err
+	}
+	config.TenantId, config.ClientId, config.ClientSecret = f.TenantId, f.ClientId, f.ClientSecret
+	return config, nil
+}
+
+// LoadConfigFile Return the config of the workspace described by the config.json file at the path provided as
+// argument, as downloaded from the portal of the workspace. Unless the file also contains the tenant_id, client_id
+// and client_secret of a Service Principal, the config authenticates with the DefaultAzureCredential.
+func LoadConfigFile(path string) (Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var file workspaceConfigFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return Config{}, ConfigError{Source: path, Reason: err.Error()}
+	}
+	return file.toConfig(path)
+}
+
+// FindConfigFile Return the path of the first config.json file found in the directory provided as argument, or
+// in its .azureml subdirectory, walking up its parent directories. If dir is empty, the search starts from the
+// current working directory.
+func FindConfigFile(dir string) (string, error) {
+	if dir == "" {
+		var err error
+		if dir, err = os.Getwd(); err != nil {
+			return "", err
+		}
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		for _, candidate := range []string{filepath.Join(dir, configFileName), filepath.Join(dir, ".azureml", configFileName)} {
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				return candidate, nil
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("no %s found in %s or in its parent directories", configFileName, dir)
+		}
+		dir = parent
+	}
+}
+
+// LoadConfigFromEnv Return the config of the Service Principal described by the environment variables
+// AZURE_CLIENT_ID, AZURE_TENANT_ID, AZURE_CLIENT_SECRET and AZURE_SUBSCRIPTION_ID, which are all required. The
+// workspace of the config is read from the optional AZURE_RESOURCE_GROUP and AZUREML_WORKSPACE_NAME.
+func LoadConfigFromEnv() (Config, error) {
+	config := Config{
+		ClientId:       os.Getenv("AZURE_CLIENT_ID"),
+		TenantId:       os.Getenv("AZURE_TENANT_ID"),
+		ClientSecret:   os.Getenv("AZURE_CLIENT_SECRET"),
+		SubscriptionId: os.Getenv("AZURE_SUBSCRIPTION_ID"),
+		ResourceGroup:  os.Getenv("AZURE_RESOURCE_GROUP"),
+		WorkspaceName:  os.Getenv("AZUREML_WORKSPACE_NAME"),
+	}
+	err := checkRequiredFields(
+		"environment",
+		configField{"AZURE_CLIENT_ID", config.ClientId},
+		configField{"AZURE_TENANT_ID", config.TenantId},
+		configField{"AZURE_CLIENT_SECRET", config.ClientSecret},
+		configField{"AZURE_SUBSCRIPTION_ID", config.SubscriptionId},
+	)
+	if err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// LoadConfigProfile Return the config of the profile with the name provided as argument, read from a profiles
+// file. The file is a JSON object with a config for each profile, having the fields of the config.json files
+// and optionally the tenant_id, client_id and client_secret of a Service Principal. The profiles without a
+// Service Principal authenticate with the DefaultAzureCredential. If path is empty, the file is read from
+// ~/.azureml/profiles.json; if profile is empty, DefaultProfile is loaded.
+func LoadConfigProfile(path, profile string) (Config, error) {
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return Config{}, err
+		}
+		path = filepath.Join(home, ".azureml", "profiles.json")
+	}
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var profiles map[string]workspaceConfigFile
+	if err := json.Unmarshal(content, &profiles); err != nil {
+		return Config{}, ConfigError{Source: path, Reason: err.Error()}
+	}
+	file, ok := profiles[profile]
+	if !ok {
+		return Config{}, ConfigError{Source: path, Reason: fmt.Sprintf("profile %q not found", profile)}
+	}
+	return file.toConfig(fmt.Sprintf("profile %s of %s", profile, path))
+}
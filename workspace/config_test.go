@@ -0,0 +1,111 @@
+package workspace
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	a := assert.New(t)
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, ".azureml", "config.json")
+	writeTestFile(t, path, "{\"subscription_id\": \"sub\", \"resource_group\": \"rg\", \"workspace_name\": \"ws\"}")
+	found, err := FindConfigFile(filepath.Join(dir, "notebooks", "experiments"))
+	a.Nil(err)
+	a.Equal(path, found)
+
+	config, err := LoadConfigFile(found)
+	a.Nil(err)
+	a.Equal("sub", config.SubscriptionId)
+	a.Equal("rg", config.ResourceGroup)
+	a.Equal("ws", config.WorkspaceName)
+	a.IsType(&ChainedTokenCredential{}, config.Credential)
+
+	invalidPath := filepath.Join(dir, "invalid.json")
+	writeTestFile(t, invalidPath, "{\"subscription_id\": \"sub\", \"client_id\": \"client\"}")
+	_, err = LoadConfigFile(invalidPath)
+	a.Equal(ConfigError{Source: invalidPath, MissingFields: []string{"resource_group", "workspace_name"}}, err)
+	a.Equal("invalid config from "+invalidPath+": missing required fields resource_group, workspace_name", err.Error())
+
+	writeTestFile(t, invalidPath, "{\"subscription_id\": \"sub\", \"resource_group\": \"rg\", \"workspace_name\": \"ws\", \"client_id\": \"client\"}")
+	_, err = LoadConfigFile(invalidPath)
+	a.Equal(ConfigError{Source: invalidPath, MissingFields: []string{"tenant_id", "client_secret"}}, err)
+
+	writeTestFile(t, invalidPath, "[]")
+	_, err = LoadConfigFile(invalidPath)
+	a.IsType(ConfigError{}, err)
+
+	_, err = FindConfigFile(filepath.Dir(dir))
+	a.NotNil(err)
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	a := assert.New(t)
+
+	t.Setenv("AZURE_CLIENT_ID", "client")
+	t.Setenv("AZURE_TENANT_ID", "")
+	t.Setenv("AZURE_CLIENT_SECRET", "secret")
+	t.Setenv("AZURE_SUBSCRIPTION_ID", "")
+	t.Setenv("AZURE_RESOURCE_GROUP", "rg")
+	t.Setenv("AZUREML_WORKSPACE_NAME", "")
+	_, err := LoadConfigFromEnv()
+	a.Equal(ConfigError{Source: "environment", MissingFields: []string{"AZURE_TENANT_ID", "AZURE_SUBSCRIPTION_ID"}}, err)
+
+	t.Setenv("AZURE_TENANT_ID", "tenant")
+	t.Setenv("AZURE_SUBSCRIPTION_ID", "sub")
+	config, err := LoadConfigFromEnv()
+	a.Nil(err)
+	a.Equal(Config{ClientId: "client", TenantId: "tenant", ClientSecret: "secret", SubscriptionId: "sub", ResourceGroup: "rg"}, config)
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	a := assert.New(t)
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	writeTestFile(t, path, `{
+		"default": {"subscription_id": "sub", "resource_group": "rg", "workspace_name": "dev"},
+		"prod": {
+			"subscription_id": "prod-sub", "resource_group": "prod-rg", "workspace_name": "prod",
+			"tenant_id": "tenant", "client_id": "client", "client_secret": "secret"
+		},
+		"broken": {"subscription_id": "sub"}
+	}`)
+
+	config, err := LoadConfigProfile(path, "")
+	a.Nil(err)
+	a.Equal("dev", config.WorkspaceName)
+	a.NotNil(config.Credential)
+
+	config, err = LoadConfigProfile(path, "prod")
+	a.Nil(err)
+	a.Equal(Config{ClientId: "client", TenantId: "tenant", ClientSecret: "secret", SubscriptionId: "prod-sub", ResourceGroup: "prod-rg", WorkspaceName: "prod"}, config)
+
+	_, err = LoadConfigProfile(path, "broken")
+	a.Equal(ConfigError{Source: "profile broken of " + path, MissingFields: []string{"resource_group", "workspace_name"}}, err)
+
+	_, err = LoadConfigProfile(path, "staging")
+	a.Equal(ConfigError{Source: path, Reason: "profile \"staging\" not found"}, err)
+}
+
+func TestNewScoped(t *testing.T) {
+	a := assert.New(t)
+
+	_, err := NewScoped(Config{Credential: &fakeTokenCredential{}, ResourceGroup: "rg"}, false)
+	a.Equal(ConfigError{Source: "config", MissingFields: []string{"WorkspaceName"}}, err)
+
+	scoped, err := NewScoped(Config{Credential: &fakeTokenCredential{}, ResourceGroup: "rg", WorkspaceName: "ws"}, false)
+	a.Nil(err)
+	a.Equal("ws", scoped.Name())
+}
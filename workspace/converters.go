@@ -1,17 +1,81 @@
 package workspace
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// datastoreConverter Convert the datastores from and to the payloads of the API version used for the datastores.
+type datastoreConverter struct {
+	apiVersion string
+}
+
+func (c datastoreConverter) unmarshalDatastore(json []byte) *Datastore {
+	if isGaApiVersion(c.apiVersion) {
+		return unmarshalGaDatastore(json)
+	}
+	return unmarshalDatastore(json)
+}
+
+func (c datastoreConverter) unmarshalDatastoreArray(json []byte) []Datastore {
+	if isGaApiVersion(c.apiVersion) {
+		return unmarshalDatastoreArrayWith(json, unmarshalGaDatastore)
+	}
+	return unmarshalDatastoreArray(json)
+}
+
+// validateDatastore Return an InvalidArgumentError if the credentials or the contents of the datastore are invalid
+// or are not supported by the API version of the converter.
+func (c datastoreConverter) validateDatastore(datastore *Datastore) error {
+	if credentials := datastore.credentials(); credentials != nil {
+		if err := credentials.validate(); err != nil {
+			return err
+		}
+		if isGaApiVersion(c.apiVersion) && credentials.CredentialsType() == DatastoreCredentialsTypeSqlAdmin {
+			return InvalidArgumentError{fmt.Sprintf("%s credentials are not supported with API version %s", credentials.CredentialsType(), c.apiVersion)}
+		}
+	}
+	if datastore.StorageType != "" && !isKnownDatastoreType(DatastoreType(datastore.StorageType)) {
+		return InvalidArgumentError{fmt.Sprintf("unknown storage type %q of the datastore", datastore.StorageType)}
+	}
+	contents := datastore.contents()
+	if contents == nil {
+		if datastore.Contents != nil {
+			return InvalidArgumentError{fmt.Sprintf("the %s contents cannot be changed to the storage type %s, set Contents instead", datastore.Contents.DatastoreType(), datastore.StorageType)}
+		}
+		return nil
+	}
+	if err := contents.validate(); err != nil {
+		return err
+	}
+	if isGaApiVersion(c.apiVersion) && !isGaDatastoreType(contents.DatastoreType()) {
+		return InvalidArgumentError{fmt.Sprintf("%s datastores are not supported with API version %s", contents.DatastoreType(), c.apiVersion)}
+	}
+	return nil
+}
+
+func (c datastoreConverter) toWriteDatastoreSchema(datastore *Datastore, endpoint string) *SchemaWrapper {
+	if isGaApiVersion(c.apiVersion) {
+		return toWriteGaDatastoreSchema(datastore, endpoint)
+	}
+	return toWriteDatastoreSchemaWithEndpoint(datastore, endpoint)
+}
+
 func unmarshalDatastoreArray(json []byte) []Datastore {
+	return unmarshalDatastoreArrayWith(json, unmarshalDatastore)
+}
+
+func unmarshalDatastoreArrayWith(json []byte, unmarshal func(json []byte) *Datastore) []Datastore {
 	jsonDatastoreArray := gjson.GetBytes(json, "value").Array()
 	datastoreSlice := make([]Datastore, gjson.GetBytes(json, "value.#").Int())
 	for i, jsonDatastore := range jsonDatastoreArray {
-		datastore := unmarshalDatastore([]byte(jsonDatastore.Raw))
+		datastore := unmarshal([]byte(jsonDatastore.Raw))
 		datastoreSlice[i] = *datastore
 	}
 	return datastoreSlice
@@ -27,7 +91,7 @@ func unmarshalDatastore(json []byte) *Datastore {
 		SqlUserName:     gjson.GetBytes(json, "properties.contents.credentials.secret.userId").Str,
 		SqlUserPassword: gjson.GetBytes(json, "properties.contents.credentials.secret.password").Str,
 	}
-	return &Datastore{
+	datastore := &Datastore{
 		Id:                   gjson.GetBytes(json, "id").Str,
 		Name:                 gjson.GetBytes(json, "name").Str,
 		Description:          gjson.GetBytes(json, "properties.description").Str,
@@ -35,14 +99,221 @@ func unmarshalDatastore(json []byte) *Datastore {
 		StorageAccountName:   gjson.GetBytes(json, "properties.contents.accountName").Str,
 		StorageContainerName: gjson.GetBytes(json, "properties.contents.containerName").Str,
 		StorageType:          gjson.GetBytes(json, "properties.contents.contentsType").Str,
+		Contents:             unmarshalDatastoreContents(gjson.GetBytes(json, "properties.contents")),
+		Credentials:          unmarshalDatastoreCredentials(gjson.GetBytes(json, "properties.contents.credentials")),
+
+		SystemData: unmarshalSystemData(json),
+		Auth:       &auth,
+	}
+	datastore.setStorageFields()
+	return datastore
+}
+
+// unmarshalDatastoreContents Unmarshal the contents of a datastore returned by the preview APIs, in which the
+// container, the file share and the filesystem are all named containerName. Return nil if the contents type is
+// unknown.
+func unmarshalDatastoreContents(contents gjson.Result) DatastoreContents {
+	switch DatastoreType(contents.Get("contentsType").Str) {
+	case DatastoreTypeAzureBlob:
+		return AzureBlobContents{
+			AccountName:   contents.Get("accountName").Str,
+			ContainerName: contents.Get("containerName").Str,
+			Endpoint:      contents.Get("endpoint").Str,
+			Protocol:      contents.Get("protocol").Str,
+		}
+	case DatastoreTypeAzureDataLakeGen2:
+		return AzureDataLakeGen2Contents{
+			AccountName: contents.Get("accountName").Str,
+			Filesystem:  contents.Get("containerName").Str,
+			Endpoint:    contents.Get("endpoint").Str,
+			Protocol:    contents.Get("protocol").Str,
+		}
+	case DatastoreTypeAzureFile:
+		return AzureFileContents{
+			AccountName:   contents.Get("accountName").Str,
+			FileShareName: contents.Get("containerName").Str,
+			Endpoint:      contents.Get("endpoint").Str,
+			Protocol:      contents.Get("protocol").Str,
+		}
+	case DatastoreTypeAzureDataLakeGen1:
+		return AzureDataLakeGen1Contents{StoreName: contents.Get("storeName").Str}
+	case DatastoreTypeAzureSqlDatabase:
+		return AzureSqlDatabaseContents{
+			ServerName:   contents.Get("serverName").Str,
+			DatabaseName: contents.Get("databaseName").Str,
+			Endpoint:     contents.Get("endpoint").Str,
+			PortNumber:   int(contents.Get("portNumber").Int()),
+		}
+	case DatastoreTypeAzurePostgreSql:
+		return AzurePostgreSqlContents{
+			ServerName:   contents.Get("serverName").Str,
+			DatabaseName: contents.Get("databaseName").Str,
+			Endpoint:     contents.Get("endpoint").Str,
+			PortNumber:   int(contents.Get("portNumber").Int()),
+			EnableSsl:    contents.Get("enableSSL").Bool(),
+		}
+	case DatastoreTypeAzureMySql:
+		return AzureMySqlContents{
+			ServerName:   contents.Get("serverName").Str,
+			DatabaseName: contents.Get("databaseName").Str,
+			Endpoint:     contents.Get("endpoint").Str,
+			PortNumber:   int(contents.Get("portNumber").Int()),
+		}
+	}
+	return nil
+}
+
+// unmarshalGaDatastore Unmarshal a datastore returned by the GA APIs, in which the contents are flattened
+// into the properties and the name of the container depends on the datastore type.
+func unmarshalGaDatastore(json []byte) *Datastore {
+	properties := gjson.GetBytes(json, "properties")
+	auth := DatastoreAuth{
+		CredentialsType: properties.Get("credentials.credentialsType").Str,
+		TenantId:        properties.Get("credentials.tenantId").Str,
+		ClientId:        properties.Get("credentials.clientId").Str,
+		ClientSecret:    properties.Get("credentials.secrets.clientSecret").Str,
+		AccountKey:      properties.Get("credentials.secrets.key").Str,
+		SqlUserName:     properties.Get("credentials.userId").Str,
+		SqlUserPassword: properties.Get("credentials.secrets.password").Str,
+	}
+	datastore := &Datastore{
+		Id:                   gjson.GetBytes(json, "id").Str,
+		Name:                 gjson.GetBytes(json, "name").Str,
+		Description:          properties.Get("description").Str,
+		IsDefault:            properties.Get("isDefault").Bool(),
+		StorageAccountName:   firstNonEmpty(properties, "accountName", "storeName"),
+		StorageContainerName: firstNonEmpty(properties, "containerName", "fileShareName", "filesystem"),
+		StorageType:          properties.Get("datastoreType").Str,
+		Contents:             unmarshalGaDatastoreContents(properties),
+		Credentials:          unmarshalDatastoreCredentials(properties.Get("credentials")),
 
 		SystemData: unmarshalSystemData(json),
 		Auth:       &auth,
 	}
+	datastore.setStorageFields()
+	return datastore
+}
+
+// unmarshalDatastoreCredentials Unmarshal the credentials of a datastore, which have the same schema in the preview
+// and in the GA APIs. Return nil if the credentials type is unknown or if the API did not return their secrets, e.g.
+// when the datastore is not read with the listSecrets action, so that the incomplete credentials are not written
+// back in place of the ones described by Auth.
+func unmarshalDatastoreCredentials(credentials gjson.Result) DatastoreCredentials {
+	if !credentials.Get("secrets").IsObject() {
+		return nil
+	}
+	result := unmarshalDatastoreCredentialsType(credentials)
+	if result == nil || result.validate() != nil {
+		return nil
+	}
+	return result
+}
+
+func unmarshalDatastoreCredentialsType(credentials gjson.Result) DatastoreCredentials {
+	switch DatastoreCredentialsType(credentials.Get("credentialsType").Str) {
+	case DatastoreCredentialsTypeAccountKey:
+		return AccountKeyCredentials{AccountKey: credentials.Get("secrets.key").Str}
+	case DatastoreCredentialsTypeSas:
+		return SasCredentials{SasToken: credentials.Get("secrets.sasToken").Str}
+	case DatastoreCredentialsTypeServicePrincipal:
+		return ServicePrincipalCredentials{
+			TenantId:     credentials.Get("tenantId").Str,
+			ClientId:     credentials.Get("clientId").Str,
+			ClientSecret: credentials.Get("secrets.clientSecret").Str,
+			AuthorityUrl: credentials.Get("authorityUrl").Str,
+			ResourceUrl:  credentials.Get("resourceUrl").Str,
+		}
+	case DatastoreCredentialsTypeCertificate:
+		return CertificateCredentials{
+			TenantId:     credentials.Get("tenantId").Str,
+			ClientId:     credentials.Get("clientId").Str,
+			Thumbprint:   credentials.Get("thumbprint").Str,
+			Certificate:  credentials.Get("secrets.certificate").Str,
+			AuthorityUrl: credentials.Get("authorityUrl").Str,
+			ResourceUrl:  credentials.Get("resourceUrl").Str,
+		}
+	case DatastoreCredentialsTypeSqlAdmin:
+		return SqlAdminCredentials{UserId: credentials.Get("userId").Str, Password: credentials.Get("secrets.password").Str}
+	case DatastoreCredentialsTypeNone:
+		return NoCredentials{}
+	}
+	return nil
+}
+
+// unmarshalGaDatastoreContents Unmarshal the contents flattened into the properties of a datastore returned by the
+// GA APIs. Return nil if the datastore type is unknown.
+func unmarshalGaDatastoreContents(properties gjson.Result) DatastoreContents {
+	switch DatastoreType(properties.Get("datastoreType").Str) {
+	case DatastoreTypeAzureBlob:
+		return AzureBlobContents{
+			AccountName:   properties.Get("accountName").Str,
+			ContainerName: properties.Get("containerName").Str,
+			Endpoint:      properties.Get("endpoint").Str,
+			Protocol:      properties.Get("protocol").Str,
+		}
+	case DatastoreTypeAzureDataLakeGen2:
+		return AzureDataLakeGen2Contents{
+			AccountName: properties.Get("accountName").Str,
+			Filesystem:  properties.Get("filesystem").Str,
+			Endpoint:    properties.Get("endpoint").Str,
+			Protocol:    properties.Get("protocol").Str,
+		}
+	case DatastoreTypeAzureFile:
+		return AzureFileContents{
+			AccountName:   properties.Get("accountName").Str,
+			FileShareName: properties.Get("fileShareName").Str,
+			Endpoint:      properties.Get("endpoint").Str,
+			Protocol:      properties.Get("protocol").Str,
+		}
+	case DatastoreTypeAzureDataLakeGen1:
+		return AzureDataLakeGen1Contents{StoreName: properties.Get("storeName").Str}
+	}
+	return nil
+}
+
+// firstNonEmpty Return the first non-empty string among the values of the paths provided as argument
+func firstNonEmpty(json gjson.Result, paths ...string) string {
+	for _, path := range paths {
+		if value := json.Get(path).Str; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func unmarshalDatasetNames(json []byte) []string {
+	jsonDatasetArray := gjson.GetBytes(json, "value").Array()
+	result := make([]string, len(jsonDatasetArray))
+	for i, value := range jsonDatasetArray {
+		result[i] = value.Get("name").Str
+	}
+	return result
 }
 
 type DatasetConverter struct {
 	logger *zap.SugaredLogger
+
+	// apiVersion The API version used for the datasets. With the GA versions, datasets are stored as data containers.
+	apiVersion string
+}
+
+// resourcePath Return the path of the dataset resources identified by the path format provided as argument,
+// relative to the datasets collection.
+func (d DatasetConverter) resourcePath(format string, a ...interface{}) string {
+	if isGaApiVersion(d.apiVersion) {
+		// The requests to data containers have to use the version of the datasets
+		return withApiVersion("data"+fmt.Sprintf(format, a...), d.apiVersion)
+	}
+	return "datasets" + fmt.Sprintf(format, a...)
+}
+
+// nextVersionPath Return the path of the resource containing the next version of the dataset. With the GA
+// APIs, the next version is a property of the data container.
+func (d DatasetConverter) nextVersionPath(datasetName string) string {
+	if isGaApiVersion(d.apiVersion) {
+		return d.resourcePath("/%s", datasetName)
+	}
+	return d.resourcePath("/%s/versions", datasetName)
 }
 
 func (d DatasetConverter) unmarshalDatasetVersionArray(datasetName string, json []byte) []Dataset {
@@ -56,6 +327,9 @@ func (d DatasetConverter) unmarshalDatasetVersionArray(datasetName string, json
 }
 
 func (d DatasetConverter) unmarshalDatasetVersion(datasetName string, json []byte) *Dataset {
+	if isGaApiVersion(d.apiVersion) {
+		return d.unmarshalDataVersion(datasetName, json)
+	}
 	return &Dataset{
 		Id:             gjson.GetBytes(json, "id").Str,
 		Name:           datasetName,
@@ -68,6 +342,49 @@ func (d DatasetConverter) unmarshalDatasetVersion(datasetName string, json []byt
 	}
 }
 
+// unmarshalDataVersion Unmarshal a version of a data container returned by the GA APIs as a dataset. Data versions
+// of type uri_file are mapped to a file path, all the other types to a directory path.
+func (d DatasetConverter) unmarshalDataVersion(datasetName string, json []byte) *Dataset {
+	id := gjson.GetBytes(json, "id").Str
+	dataset := &Dataset{
+		Id:             id,
+		Name:           datasetName,
+		Description:    gjson.GetBytes(json, "properties.description").Str,
+		Version:        int(gjson.GetBytes(json, "name").Int()),
+		FilePaths:      make([]DatasetPath, 0),
+		DirectoryPaths: make([]DatasetPath, 0),
+		SystemData:     unmarshalSystemData(json),
+	}
+
+	dataUri := gjson.GetBytes(json, "properties.dataUri").Str
+	if strings.HasPrefix(dataUri, datastorePathPrefix) == false {
+		d.logger.Errorf("cannot unmarshal dataset path: %q is not a datastore path", dataUri)
+		return dataset
+	}
+	datastorePath, err := NewDatastorePath(dataUri)
+	if err != nil {
+		d.logger.Errorf("error unmarshalling dataset path: %s", err.Error())
+		return dataset
+	}
+	if DataType(gjson.GetBytes(json, "properties.dataType").Str) == DataTypeUriFile {
+		dataset.FilePaths = append(dataset.FilePaths, datastorePath)
+	} else {
+		dataset.DirectoryPaths = append(dataset.DirectoryPaths, datastorePath)
+	}
+	if i := strings.Index(id, "/data/"); i >= 0 {
+		dataset.DatastoreId = fmt.Sprintf("%s/datastores/%s", id[:i], datastorePath.DatastoreName)
+	}
+	return dataset
+}
+
+// toWriteDatasetSchema Convert the dataset to the schema used for writing it with the API version of the datasets
+func (d DatasetConverter) toWriteDatasetSchema(dataset *Dataset) (*SchemaWrapper, error) {
+	if isGaApiVersion(d.apiVersion) {
+		return toWriteDataVersionSchema(dataset)
+	}
+	return toWriteDatasetSchema(dataset), nil
+}
+
 func (d DatasetConverter) unmarshalDatasetNextVersion(json []byte) int {
 	return int(gjson.GetBytes(json, "properties.nextVersion").Int())
 }
@@ -96,66 +413,1777 @@ func (d DatasetConverter) unmarshalDatasetPaths(jsonDatasetPaths gjson.Result, p
 	return result
 }
 
-func unmarshalSystemData(json []byte) *SystemData {
-	return &SystemData{
-		CreationDate:         gjson.GetBytes(json, "systemData.createdAt").Time(),
-		CreationUserType:     gjson.GetBytes(json, "systemData.createdByType").Str,
-		CreationUser:         gjson.GetBytes(json, "systemData.createdBy").Str,
-		LastModifiedDate:     gjson.GetBytes(json, "systemData.lastModifiedAt").Time(),
-		LastModifiedUserType: gjson.GetBytes(json, "systemData.lastModifiedByType").Str,
-		LastModifiedUser:     gjson.GetBytes(json, "systemData.lastModifiedBy").Str,
+// assetContainer The container of the versions of an asset, such as a data asset
+type assetContainer struct {
+	name          string
+	latestVersion string
+}
+
+func unmarshalAssetContainers(json []byte) []assetContainer {
+	jsonContainerArray := gjson.GetBytes(json, "value").Array()
+	result := make([]assetContainer, len(jsonContainerArray))
+	for i, value := range jsonContainerArray {
+		result[i] = assetContainer{
+			name:          value.Get("name").Str,
+			latestVersion: value.Get("properties.latestVersion").String(),
+		}
 	}
+	return result
 }
 
-func toWriteDatastoreSchema(datastore *Datastore) *SchemaWrapper {
-	var secrets *WriteDatastoreSecretsSchema
-	var credentials *WriteDatastoreCredentialsSchema
+func unmarshalDataAssetArray(name string, json []byte) []DataAsset {
+	jsonDataAssetArray := gjson.GetBytes(json, "value").Array()
+	result := make([]DataAsset, len(jsonDataAssetArray))
+	for i, jsonDataAsset := range jsonDataAssetArray {
+		result[i] = *unmarshalDataAsset(name, []byte(jsonDataAsset.Raw))
+	}
+	return result
+}
+
+func unmarshalDataAsset(name string, json []byte) *DataAsset {
+	return &DataAsset{
+		Id:          gjson.GetBytes(json, "id").Str,
+		Name:        name,
+		Version:     gjson.GetBytes(json, "name").String(),
+		Description: gjson.GetBytes(json, "properties.description").Str,
+		DataType:    DataType(gjson.GetBytes(json, "properties.dataType").Str),
+		DataUri:     gjson.GetBytes(json, "properties.dataUri").Str,
+		Tags:        unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:  unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		IsArchived:  gjson.GetBytes(json, "properties.isArchived").Bool(),
+		SystemData:  unmarshalSystemData(json),
+	}
+}
+
+func unmarshalModelArray(json []byte) []Model {
+	jsonModelArray := gjson.GetBytes(json, "value").Array()
+	result := make([]Model, len(jsonModelArray))
+	for i, jsonModel := range jsonModelArray {
+		result[i] = *unmarshalModel([]byte(jsonModel.Raw))
+	}
+	return result
+}
+
+func unmarshalModel(json []byte) *Model {
+	return &Model{
+		Id:            gjson.GetBytes(json, "id").Str,
+		Name:          gjson.GetBytes(json, "name").Str,
+		Description:   gjson.GetBytes(json, "properties.description").Str,
+		Tags:          unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:    unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		IsArchived:    gjson.GetBytes(json, "properties.isArchived").Bool(),
+		LatestVersion: gjson.GetBytes(json, "properties.latestVersion").String(),
+		NextVersion:   gjson.GetBytes(json, "properties.nextVersion").String(),
+		SystemData:    unmarshalSystemData(json),
+	}
+}
+
+func unmarshalComponentArray(json []byte) []Component {
+	jsonComponentArray := gjson.GetBytes(json, "value").Array()
+	result := make([]Component, len(jsonComponentArray))
+	for i, jsonComponent := range jsonComponentArray {
+		result[i] = *unmarshalComponent([]byte(jsonComponent.Raw))
+	}
+	return result
+}
+
+func unmarshalComponent(json []byte) *Component {
+	return &Component{
+		Id:            gjson.GetBytes(json, "id").Str,
+		Name:          gjson.GetBytes(json, "name").Str,
+		Description:   gjson.GetBytes(json, "properties.description").Str,
+		Tags:          unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:    unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		IsArchived:    gjson.GetBytes(json, "properties.isArchived").Bool(),
+		LatestVersion: gjson.GetBytes(json, "properties.latestVersion").String(),
+		SystemData:    unmarshalSystemData(json),
+	}
+}
+
+func unmarshalComponentVersionArray(componentName string, json []byte) []ComponentVersion {
+	jsonComponentVersionArray := gjson.GetBytes(json, "value").Array()
+	result := make([]ComponentVersion, len(jsonComponentVersionArray))
+	for i, jsonComponentVersion := range jsonComponentVersionArray {
+		result[i] = *unmarshalComponentVersion(componentName, []byte(jsonComponentVersion.Raw))
+	}
+	return result
+}
+
+func unmarshalComponentVersion(componentName string, json []byte) *ComponentVersion {
+	spec := gjson.GetBytes(json, "properties.componentSpec")
 
-	if datastore.Auth != nil {
-		secrets = &WriteDatastoreSecretsSchema{
-			SecretsType:     datastore.Auth.CredentialsType,
-			AccountKey:      datastore.Auth.AccountKey,
-			ClientSecret:    datastore.Auth.ClientSecret,
-			SqlUserPassword: datastore.Auth.SqlUserPassword,
+	var inputs map[string]ComponentInput
+	if jsonInputs := spec.Get("inputs"); jsonInputs.IsObject() {
+		inputs = make(map[string]ComponentInput)
+		jsonInputs.ForEach(func(key, value gjson.Result) bool {
+			var defaultValue string
+			if jsonDefault := value.Get("default"); jsonDefault.Exists() {
+				defaultValue = jsonDefault.String()
+			}
+			inputs[key.Str] = ComponentInput{
+				Type:        ComponentIOType(value.Get("type").Str),
+				Description: value.Get("description").Str,
+				Optional:    value.Get("optional").Bool(),
+				Default:     defaultValue,
+			}
+			return true
+		})
+	}
+
+	var outputs map[string]ComponentOutput
+	if jsonOutputs := spec.Get("outputs"); jsonOutputs.IsObject() {
+		outputs = make(map[string]ComponentOutput)
+		jsonOutputs.ForEach(func(key, value gjson.Result) bool {
+			outputs[key.Str] = ComponentOutput{
+				Type:        ComponentIOType(value.Get("type").Str),
+				Description: value.Get("description").Str,
+			}
+			return true
+		})
+	}
+
+	return &ComponentVersion{
+		Id:            gjson.GetBytes(json, "id").Str,
+		Name:          componentName,
+		Version:       gjson.GetBytes(json, "name").String(),
+		DisplayName:   spec.Get("display_name").Str,
+		Description:   gjson.GetBytes(json, "properties.description").Str,
+		ComponentType: ComponentType(spec.Get("type").Str),
+		Command:       spec.Get("command").Str,
+		CodeId:        strings.TrimPrefix(spec.Get("code").Str, azureMLAssetPrefix),
+		EnvironmentId: strings.TrimPrefix(spec.Get("environment").Str, azureMLAssetPrefix),
+		Inputs:        inputs,
+		Outputs:       outputs,
+		Tags:          unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:    unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		IsArchived:    gjson.GetBytes(json, "properties.isArchived").Bool(),
+		SystemData:    unmarshalSystemData(json),
+	}
+}
+
+type ModelConverter struct {
+	logger *zap.SugaredLogger
+}
+
+func (c ModelConverter) unmarshalModelVersionArray(modelName string, json []byte) []ModelVersion {
+	jsonModelVersionArray := gjson.GetBytes(json, "value").Array()
+	result := make([]ModelVersion, len(jsonModelVersionArray))
+	for i, jsonModelVersion := range jsonModelVersionArray {
+		result[i] = *c.unmarshalModelVersion(modelName, []byte(jsonModelVersion.Raw))
+	}
+	return result
+}
+
+func (c ModelConverter) unmarshalModelVersion(modelName string, json []byte) *ModelVersion {
+	flavors := make(map[string]map[string]string)
+	gjson.GetBytes(json, "properties.flavors").ForEach(func(key, value gjson.Result) bool {
+		flavors[key.Str] = unmarshalStringMap(value.Get("data"))
+		return true
+	})
+
+	var path *DatastorePath
+	modelUri := gjson.GetBytes(json, "properties.modelUri").Str
+	if strings.HasPrefix(modelUri, datastorePathPrefix) {
+		datastorePath, err := NewDatastorePath(modelUri)
+		if err != nil {
+			c.logger.Errorf("error unmarshalling model path: %s", err.Error())
+		} else {
+			path = datastorePath
 		}
-		credentials = &WriteDatastoreCredentialsSchema{
-			CredentialsType: datastore.Auth.CredentialsType,
-			Secrets:         secrets,
-			ClientId:        datastore.Auth.ClientId,
-			TenantId:        datastore.Auth.TenantId,
-			SqlUserName:     datastore.Auth.SqlUserName,
+	} else if modelUri != "" {
+		c.logger.Debugf("model path %q is not a datastore path, keeping it only as URI", modelUri)
+	}
+
+	return &ModelVersion{
+		Id:          gjson.GetBytes(json, "id").Str,
+		Name:        modelName,
+		Version:     gjson.GetBytes(json, "name").String(),
+		Description: gjson.GetBytes(json, "properties.description").Str,
+		ModelType:   ModelType(gjson.GetBytes(json, "properties.modelType").Str),
+		Path:        path,
+		Uri:         modelUri,
+		JobName:     gjson.GetBytes(json, "properties.jobName").Str,
+		Tags:        unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:  unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		Flavors:     flavors,
+		IsArchived:  gjson.GetBytes(json, "properties.isArchived").Bool(),
+		SystemData:  unmarshalSystemData(json),
+	}
+}
+
+func unmarshalEnvironmentArray(json []byte) []Environment {
+	jsonEnvironmentArray := gjson.GetBytes(json, "value").Array()
+	result := make([]Environment, len(jsonEnvironmentArray))
+	for i, jsonEnvironment := range jsonEnvironmentArray {
+		result[i] = *unmarshalEnvironment([]byte(jsonEnvironment.Raw))
+	}
+	return result
+}
+
+func unmarshalEnvironment(json []byte) *Environment {
+	return &Environment{
+		Id:            gjson.GetBytes(json, "id").Str,
+		Name:          gjson.GetBytes(json, "name").Str,
+		Description:   gjson.GetBytes(json, "properties.description").Str,
+		Tags:          unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:    unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		IsArchived:    gjson.GetBytes(json, "properties.isArchived").Bool(),
+		LatestVersion: gjson.GetBytes(json, "properties.latestVersion").String(),
+		NextVersion:   gjson.GetBytes(json, "properties.nextVersion").String(),
+		SystemData:    unmarshalSystemData(json),
+	}
+}
+
+func unmarshalEnvironmentVersionArray(environmentName string, json []byte) []EnvironmentVersion {
+	jsonEnvironmentVersionArray := gjson.GetBytes(json, "value").Array()
+	result := make([]EnvironmentVersion, len(jsonEnvironmentVersionArray))
+	for i, jsonEnvironmentVersion := range jsonEnvironmentVersionArray {
+		result[i] = *unmarshalEnvironmentVersion(environmentName, []byte(jsonEnvironmentVersion.Raw))
+	}
+	return result
+}
+
+func unmarshalEnvironmentVersion(environmentName string, json []byte) *EnvironmentVersion {
+	var build *BuildContext
+	if jsonBuild := gjson.GetBytes(json, "properties.build"); jsonBuild.IsObject() {
+		build = &BuildContext{
+			ContextUri:     jsonBuild.Get("contextUri").Str,
+			DockerfilePath: jsonBuild.Get("dockerfilePath").Str,
 		}
 	}
 
-	return &SchemaWrapper{
-		Properties: WriteDatastoreSchemaProperties{
-			IsDefault:   datastore.IsDefault,
-			Description: datastore.Description,
-			Contents: WriteDatastoreSchema{
-				ContentsType:         datastore.StorageType,
-				StorageAccountName:   datastore.StorageAccountName,
-				StorageContainerName: datastore.StorageContainerName,
-				Credentials:          credentials,
-				Endpoint:             "core.windows.net",
-				Protocol:             "https",
-			},
-		},
+	var inferenceConfig *InferenceConfig
+	if jsonInferenceConfig := gjson.GetBytes(json, "properties.inferenceConfig"); jsonInferenceConfig.IsObject() {
+		inferenceConfig = &InferenceConfig{
+			LivenessRoute:  unmarshalRoute(jsonInferenceConfig.Get("livenessRoute")),
+			ReadinessRoute: unmarshalRoute(jsonInferenceConfig.Get("readinessRoute")),
+			ScoringRoute:   unmarshalRoute(jsonInferenceConfig.Get("scoringRoute")),
+		}
+	}
+
+	return &EnvironmentVersion{
+		Id:              gjson.GetBytes(json, "id").Str,
+		Name:            environmentName,
+		Version:         gjson.GetBytes(json, "name").String(),
+		Description:     gjson.GetBytes(json, "properties.description").Str,
+		Image:           gjson.GetBytes(json, "properties.image").Str,
+		Build:           build,
+		CondaFile:       gjson.GetBytes(json, "properties.condaFile").Str,
+		OsType:          OsType(gjson.GetBytes(json, "properties.osType").Str),
+		InferenceConfig: inferenceConfig,
+		EnvironmentType: gjson.GetBytes(json, "properties.environmentType").Str,
+		Tags:            unmarshalStringMap(gjson.GetBytes(json, "properties.tags")),
+		Properties:      unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		IsArchived:      gjson.GetBytes(json, "properties.isArchived").Bool(),
+		SystemData:      unmarshalSystemData(json),
 	}
 }
 
-func toWriteDatasetSchema(dataset *Dataset) *SchemaWrapper {
-	pathSchemas := make([]DatasetPathsSchema, len(dataset.FilePaths)+len(dataset.DirectoryPaths))
-	for i, filePath := range dataset.FilePaths {
-		pathSchemas[i] = DatasetPathsSchema{FilePath: filePath.String()}
+func unmarshalRoute(json gjson.Result) *Route {
+	if json.IsObject() == false {
+		return nil
 	}
-	for i, directoryPath := range dataset.DirectoryPaths {
-		pathSchemas[i] = DatasetPathsSchema{DirectoryPath: directoryPath.String()}
+	return &Route{
+		Path: json.Get("path").Str,
+		Port: int(json.Get("port").Int()),
 	}
+}
 
-	return &SchemaWrapper{
-		Properties: WriteDatasetSchema{
-			Description: dataset.Description,
-			Paths:       pathSchemas,
+var isoDurationRegex = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseIsoDuration Parse a ISO 8601 duration such as PT2M30S. Durations expressed in years, months or weeks are not
+// supported, since their length is not fixed.
+func parseIsoDuration(duration string) (time.Duration, error) {
+	matches := isoDurationRegex.FindStringSubmatch(duration)
+	if matches == nil || duration == "P" || duration == "PT" {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", duration)
+	}
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var result time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(matches[i+1], 64)
+		if err != nil {
+			return 0, err
+		}
+		result += time.Duration(value * float64(unit))
+	}
+	return result, nil
+}
+
+// formatIsoDuration Format the duration as a ISO 8601 duration expressed in seconds, such as PT150S
+func formatIsoDuration(duration time.Duration) string {
+	return fmt.Sprintf("PT%dS", int64(duration.Round(time.Second)/time.Second))
+}
+
+// formatOptionalIsoDuration Same as formatIsoDuration, returning an empty string if the duration is not positive
+func formatOptionalIsoDuration(duration time.Duration) string {
+	if duration <= 0 {
+		return ""
+	}
+	return formatIsoDuration(duration)
+}
+
+type ComputeConverter struct {
+	logger *zap.SugaredLogger
+}
+
+func (c ComputeConverter) unmarshalComputeArray(json []byte) []Compute {
+	jsonComputeArray := gjson.GetBytes(json, "value").Array()
+	result := make([]Compute, len(jsonComputeArray))
+	for i, jsonCompute := range jsonComputeArray {
+		result[i] = *c.unmarshalCompute([]byte(jsonCompute.Raw))
+	}
+	return result
+}
+
+func (c ComputeConverter) unmarshalCompute(json []byte) *Compute {
+	compute := &Compute{
+		Id:                gjson.GetBytes(json, "id").Str,
+		Name:              gjson.GetBytes(json, "name").Str,
+		Location:          gjson.GetBytes(json, "location").Str,
+		Description:       gjson.GetBytes(json, "properties.description").Str,
+		ComputeType:       ComputeType(gjson.GetBytes(json, "properties.computeType").Str),
+		ProvisioningState: gjson.GetBytes(json, "properties.provisioningState").Str,
+		Identity:          unmarshalManagedIdentity(gjson.GetBytes(json, "identity")),
+		DisableLocalAuth:  gjson.GetBytes(json, "properties.disableLocalAuth").Bool(),
+		SystemData:        unmarshalSystemData(json),
+	}
+
+	properties := gjson.GetBytes(json, "properties.properties")
+	switch compute.ComputeType {
+	case ComputeTypeAmlCompute:
+		compute.AmlCompute = &AmlComputeProperties{
+			VmSize:                      properties.Get("vmSize").Str,
+			VmPriority:                  properties.Get("vmPriority").Str,
+			SubnetId:                    properties.Get("subnet.id").Str,
+			RemoteLoginPortPublicAccess: properties.Get("remoteLoginPortPublicAccess").Str,
+			AllocationState:             properties.Get("allocationState").Str,
+			CurrentNodeCount:            int(properties.Get("currentNodeCount").Int()),
+			TargetNodeCount:             int(properties.Get("targetNodeCount").Int()),
+		}
+		if scaleSettings := properties.Get("scaleSettings"); scaleSettings.IsObject() {
+			compute.AmlCompute.ScaleSettings = &ScaleSettings{
+				MinNodeCount: int(scaleSettings.Get("minNodeCount").Int()),
+				MaxNodeCount: int(scaleSettings.Get("maxNodeCount").Int()),
+			}
+			if idleTime := scaleSettings.Get("nodeIdleTimeBeforeScaleDown").Str; idleTime != "" {
+				duration, err := parseIsoDuration(idleTime)
+				if err != nil {
+					c.logger.Errorf("error unmarshalling compute scale settings: %s", err.Error())
+				}
+				compute.AmlCompute.ScaleSettings.NodeIdleTimeBeforeScaleDown = duration
+			}
+		}
+	case ComputeTypeComputeInstance:
+		compute.ComputeInstance = &ComputeInstanceProperties{
+			VmSize:                   properties.Get("vmSize").Str,
+			SubnetId:                 properties.Get("subnet.id").Str,
+			SshPublicAccess:          properties.Get("sshSettings.sshPublicAccess").Str == "Enabled",
+			SshAdminPublicKey:        properties.Get("sshSettings.adminPublicKey").Str,
+			ApplicationSharingPolicy: properties.Get("applicationSharingPolicy").Str,
+			State:                    properties.Get("state").Str,
+		}
+		if assignedUser := properties.Get("personalComputeInstanceSettings.assignedUser"); assignedUser.IsObject() {
+			compute.ComputeInstance.AssignedUser = &AssignedUser{
+				ObjectId: assignedUser.Get("objectId").Str,
+				TenantId: assignedUser.Get("tenantId").Str,
+			}
+		}
+	}
+	return compute
+}
+
+func unmarshalManagedIdentity(json gjson.Result) *ManagedIdentity {
+	if json.IsObject() == false {
+		return nil
+	}
+	userAssignedIdentities := make([]string, 0)
+	json.Get("userAssignedIdentities").ForEach(func(key, value gjson.Result) bool {
+		userAssignedIdentities = append(userAssignedIdentities, key.Str)
+		return true
+	})
+	return &ManagedIdentity{
+		Type:                   IdentityType(json.Get("type").Str),
+		UserAssignedIdentities: userAssignedIdentities,
+		PrincipalId:            json.Get("principalId").Str,
+		TenantId:               json.Get("tenantId").Str,
+	}
+}
+
+func unmarshalComputeNodes(json []byte) []ComputeNode {
+	jsonNodeArray := gjson.GetBytes(json, "nodes").Array()
+	result := make([]ComputeNode, len(jsonNodeArray))
+	for i, jsonNode := range jsonNodeArray {
+		result[i] = ComputeNode{
+			NodeId:           jsonNode.Get("nodeId").Str,
+			PrivateIpAddress: jsonNode.Get("privateIpAddress").Str,
+			PublicIpAddress:  jsonNode.Get("publicIpAddress").Str,
+			Port:             int(jsonNode.Get("port").Int()),
+			NodeState:        jsonNode.Get("nodeState").Str,
+			RunId:            jsonNode.Get("runId").Str,
+		}
+	}
+	return result
+}
+
+type JobConverter struct {
+	logger *zap.SugaredLogger
+}
+
+func (c JobConverter) unmarshalJobArray(json []byte) []Job {
+	jsonJobArray := gjson.GetBytes(json, "value").Array()
+	result := make([]Job, len(jsonJobArray))
+	for i, jsonJob := range jsonJobArray {
+		result[i] = *c.unmarshalJob([]byte(jsonJob.Raw))
+	}
+	return result
+}
+
+func (c JobConverter) unmarshalJob(json []byte) *Job {
+	properties := gjson.GetBytes(json, "properties")
+	job := &Job{
+		Id:             gjson.GetBytes(json, "id").Str,
+		Name:           gjson.GetBytes(json, "name").Str,
+		DisplayName:    properties.Get("displayName").Str,
+		Description:    properties.Get("description").Str,
+		ExperimentName: properties.Get("experimentName").Str,
+		JobType:        JobType(properties.Get("jobType").Str),
+		ComputeId:      properties.Get("computeId").Str,
+		Status:         JobStatus(properties.Get("status").Str),
+		Tags:           unmarshalStringMap(properties.Get("tags")),
+		Properties:     unmarshalStringMap(properties.Get("properties")),
+		IsArchived:     properties.Get("isArchived").Bool(),
+		SystemData:     unmarshalSystemData(json),
+	}
+
+	switch job.JobType {
+	case JobTypeCommand:
+		job.Command = c.unmarshalCommand(properties, properties.Get("limits.timeout").Str)
+	case JobTypeSweep:
+		job.Sweep = c.unmarshalSweep(properties)
+	case JobTypePipeline:
+		job.Pipeline = unmarshalPipeline(properties)
+	}
+	return job
+}
+
+// unmarshalCommand Unmarshal the command of a command job or of the trials of a sweep job
+func (c JobConverter) unmarshalCommand(json gjson.Result, timeout string) *CommandJob {
+	return &CommandJob{
+		Command:              json.Get("command").Str,
+		CodeId:               json.Get("codeId").Str,
+		EnvironmentId:        json.Get("environmentId").Str,
+		EnvironmentVariables: unmarshalStringMap(json.Get("environmentVariables")),
+		Inputs:               unmarshalJobInputs(json.Get("inputs")),
+		Outputs:              unmarshalJobOutputs(json.Get("outputs")),
+		Distribution:         unmarshalDistribution(json.Get("distribution")),
+		Resources:            unmarshalJobResources(json.Get("resources")),
+		Timeout:              c.unmarshalDuration(timeout),
+	}
+}
+
+func (c JobConverter) unmarshalSweep(properties gjson.Result) *SweepJob {
+	trial := c.unmarshalCommand(properties.Get("trial"), properties.Get("limits.trialTimeout").Str)
+	trial.Inputs = unmarshalJobInputs(properties.Get("inputs"))
+	trial.Outputs = unmarshalJobOutputs(properties.Get("outputs"))
+
+	sweep := &SweepJob{
+		SearchSpace:       make(map[string]SearchDistribution),
+		SamplingAlgorithm: SamplingAlgorithm(properties.Get("samplingAlgorithm.samplingAlgorithmType").Str),
+		Objective: Objective{
+			PrimaryMetric: properties.Get("objective.primaryMetric").Str,
+			Goal:          Goal(properties.Get("objective.goal").Str),
+		},
+		Limits: SweepLimits{
+			MaxTotalTrials:      int(properties.Get("limits.maxTotalTrials").Int()),
+			MaxConcurrentTrials: int(properties.Get("limits.maxConcurrentTrials").Int()),
+			Timeout:             c.unmarshalDuration(properties.Get("limits.timeout").Str),
 		},
+		Trial: trial,
+	}
+	properties.Get("searchSpace").ForEach(func(key, value gjson.Result) bool {
+		distribution, err := unmarshalSearchDistribution(value)
+		if err != nil {
+			c.logger.Errorf("error unmarshalling hyperparameter %s: %s", key.Str, err.Error())
+			return true
+		}
+		sweep.SearchSpace[key.Str] = distribution
+		return true
+	})
+	if policy := properties.Get("earlyTermination"); policy.IsObject() {
+		sweep.EarlyTermination = &EarlyTerminationPolicy{
+			PolicyType:           EarlyTerminationPolicyType(policy.Get("policyType").Str),
+			EvaluationInterval:   int(policy.Get("evaluationInterval").Int()),
+			DelayEvaluation:      int(policy.Get("delayEvaluation").Int()),
+			SlackFactor:          policy.Get("slackFactor").Float(),
+			SlackAmount:          policy.Get("slackAmount").Float(),
+			TruncationPercentage: int(policy.Get("truncationPercentage").Int()),
+		}
+	}
+	return sweep
+}
+
+func unmarshalPipeline(properties gjson.Result) *PipelineJob {
+	pipeline := &PipelineJob{
+		Inputs:                unmarshalJobInputs(properties.Get("inputs")),
+		Outputs:               unmarshalJobOutputs(properties.Get("outputs")),
+		DefaultComputeId:      properties.Get("settings.default_compute").Str,
+		ContinueOnStepFailure: properties.Get("settings.continue_on_step_failure").Bool(),
+		Steps:                 make(map[string]PipelineStep),
+	}
+	properties.Get("jobs").ForEach(func(key, value gjson.Result) bool {
+		step := PipelineStep{
+			Name:        key.Str,
+			ComponentId: firstNonEmpty(value, "componentId", "component"),
+			ComputeId:   value.Get("computeId").Str,
+			Inputs:      make(map[string]PipelineBinding),
+			Outputs:     make(map[string]string),
+		}
+		value.Get("inputs").ForEach(func(input, binding gjson.Result) bool {
+			step.Inputs[input.Str] = parsePipelineBinding(binding.Get("value").String())
+			return true
+		})
+		value.Get("outputs").ForEach(func(output, binding gjson.Result) bool {
+			if matches := pipelineOutputExpressionRegex.FindStringSubmatch(binding.Get("value").Str); matches != nil {
+				step.Outputs[output.Str] = matches[1]
+			}
+			return true
+		})
+		pipeline.Steps[key.Str] = step
+		return true
+	})
+	return pipeline
+}
+
+// unmarshalDuration Unmarshal a ISO 8601 duration, logging the error and returning zero if it is malformed
+func (c JobConverter) unmarshalDuration(duration string) time.Duration {
+	if duration == "" {
+		return 0
+	}
+	result, err := parseIsoDuration(duration)
+	if err != nil {
+		c.logger.Errorf("error unmarshalling job limits: %s", err.Error())
+	}
+	return result
+}
+
+// unmarshalSearchDistribution Unmarshal the expression of a distribution of the search space of a sweep job,
+// in the format [<distribution>, [<parameters>...]]
+func unmarshalSearchDistribution(json gjson.Result) (SearchDistribution, error) {
+	distributionType := json.Get("0").Str
+	parameters := json.Get("1").Array()
+	expectedParameters := map[string]int{"choice": 1, "uniform": 2, "loguniform": 2, "normal": 2, "randint": 1}
+	if n, ok := expectedParameters[distributionType]; !ok || len(parameters) != n {
+		return nil, fmt.Errorf("unsupported search space expression %s", json.Raw)
+	}
+
+	switch distributionType {
+	case "choice":
+		jsonValues := parameters[0].Array()
+		values := make([]interface{}, len(jsonValues))
+		for i, value := range jsonValues {
+			values[i] = value.Value()
+		}
+		return ChoiceDistribution{Values: values}, nil
+	case "uniform":
+		return UniformDistribution{Min: parameters[0].Float(), Max: parameters[1].Float()}, nil
+	case "loguniform":
+		return LogUniformDistribution{Min: parameters[0].Float(), Max: parameters[1].Float()}, nil
+	case "normal":
+		return NormalDistribution{Mu: parameters[0].Float(), Sigma: parameters[1].Float()}, nil
+	default:
+		return RandIntDistribution{Upper: int(parameters[0].Int())}, nil
+	}
+}
+
+func unmarshalJobInputs(json gjson.Result) map[string]JobInput {
+	result := make(map[string]JobInput)
+	json.ForEach(func(key, value gjson.Result) bool {
+		result[key.Str] = JobInput{
+			InputType:   JobInputType(value.Get("jobInputType").Str),
+			Description: value.Get("description").Str,
+			Uri:         value.Get("uri").Str,
+			Mode:        DataMode(value.Get("mode").Str),
+			Value:       value.Get("value").String(),
+		}
+		return true
+	})
+	return result
+}
+
+func unmarshalJobOutputs(json gjson.Result) map[string]JobOutput {
+	result := make(map[string]JobOutput)
+	json.ForEach(func(key, value gjson.Result) bool {
+		result[key.Str] = JobOutput{
+			OutputType:  JobOutputType(value.Get("jobOutputType").Str),
+			Description: value.Get("description").Str,
+			Uri:         value.Get("uri").Str,
+			Mode:        DataMode(value.Get("mode").Str),
+		}
+		return true
+	})
+	return result
+}
+
+func unmarshalDistribution(json gjson.Result) *Distribution {
+	if json.IsObject() == false {
+		return nil
+	}
+	return &Distribution{
+		DistributionType:        DistributionType(json.Get("distributionType").Str),
+		ProcessCountPerInstance: int(json.Get("processCountPerInstance").Int()),
+		WorkerCount:             int(json.Get("workerCount").Int()),
+		ParameterServerCount:    int(json.Get("parameterServerCount").Int()),
+	}
+}
+
+func unmarshalJobResources(json gjson.Result) *JobResources {
+	if json.IsObject() == false {
+		return nil
+	}
+	return &JobResources{
+		InstanceCount: int(json.Get("instanceCount").Int()),
+		InstanceType:  json.Get("instanceType").Str,
+		Properties:    unmarshalStringMap(json.Get("properties")),
+	}
+}
+
+// unmarshalStringMap Unmarshal a JSON object whose values are strings, such as the tags of a resource
+func unmarshalStringMap(json gjson.Result) map[string]string {
+	result := make(map[string]string)
+	json.ForEach(func(key, value gjson.Result) bool {
+		result[key.Str] = value.String()
+		return true
+	})
+	return result
+}
+
+func unmarshalSystemData(json []byte) *SystemData {
+	return &SystemData{
+		CreationDate:         gjson.GetBytes(json, "systemData.createdAt").Time(),
+		CreationUserType:     gjson.GetBytes(json, "systemData.createdByType").Str,
+		CreationUser:         gjson.GetBytes(json, "systemData.createdBy").Str,
+		LastModifiedDate:     gjson.GetBytes(json, "systemData.lastModifiedAt").Time(),
+		LastModifiedUserType: gjson.GetBytes(json, "systemData.lastModifiedByType").Str,
+		LastModifiedUser:     gjson.GetBytes(json, "systemData.lastModifiedBy").Str,
+	}
+}
+
+func toWriteDatastoreSchema(datastore *Datastore) *SchemaWrapper {
+	return toWriteDatastoreSchemaWithEndpoint(datastore, AzurePublicCloud.StorageEndpointSuffix)
+}
+
+// toWriteDatastoreSchemaWithEndpoint Convert the datastore to the schema used for writing it, using the storage
+// endpoint suffix of the cloud hosting the workspace.
+func toWriteDatastoreSchemaWithEndpoint(datastore *Datastore, endpoint string) *SchemaWrapper {
+	credentials := toWriteDatastoreCredentialsSchema(datastore)
+	contents := WriteDatastoreSchema{
+		ContentsType:         datastore.StorageType,
+		StorageAccountName:   datastore.StorageAccountName,
+		StorageContainerName: datastore.StorageContainerName,
+		Endpoint:             endpoint,
+		Protocol:             defaultStorageProtocol,
+	}
+	if datastoreContents := datastore.contents(); datastoreContents != nil {
+		contents = toWriteDatastoreContentsSchema(datastoreContents, endpoint)
+	}
+	contents.Credentials = credentials
+
+	return &SchemaWrapper{
+		Properties: WriteDatastoreSchemaProperties{
+			IsDefault:   datastore.IsDefault,
+			Description: datastore.Description,
+			Contents:    contents,
+		},
+	}
+}
+
+// toWriteDatastoreCredentialsSchema Convert the credentials of the datastore to the schema shared by the preview
+// and the GA APIs. The fields of an Auth with an unknown credentials type are copied as they are.
+func toWriteDatastoreCredentialsSchema(datastore *Datastore) *WriteDatastoreCredentialsSchema {
+	credentials := datastore.credentials()
+	if credentials == nil {
+		if datastore.Auth == nil {
+			return nil
+		}
+		return &WriteDatastoreCredentialsSchema{
+			CredentialsType: datastore.Auth.CredentialsType,
+			Secrets: &WriteDatastoreSecretsSchema{
+				SecretsType:     datastore.Auth.CredentialsType,
+				AccountKey:      datastore.Auth.AccountKey,
+				ClientSecret:    datastore.Auth.ClientSecret,
+				SqlUserPassword: datastore.Auth.SqlUserPassword,
+			},
+			ClientId:    datastore.Auth.ClientId,
+			TenantId:    datastore.Auth.TenantId,
+			SqlUserName: datastore.Auth.SqlUserName,
+		}
+	}
+
+	credentialsType := string(credentials.CredentialsType())
+	schema := &WriteDatastoreCredentialsSchema{CredentialsType: credentialsType}
+	secrets := &WriteDatastoreSecretsSchema{SecretsType: credentialsType}
+	switch c := credentials.(type) {
+	case AccountKeyCredentials:
+		secrets.AccountKey = c.AccountKey
+	case SasCredentials:
+		secrets.SasToken = c.SasToken
+	case ServicePrincipalCredentials:
+		schema.TenantId, schema.ClientId = c.TenantId, c.ClientId
+		schema.AuthorityUrl, schema.ResourceUrl = c.AuthorityUrl, c.ResourceUrl
+		secrets.ClientSecret = c.ClientSecret
+	case CertificateCredentials:
+		schema.TenantId, schema.ClientId, schema.Thumbprint = c.TenantId, c.ClientId, c.Thumbprint
+		schema.AuthorityUrl, schema.ResourceUrl = c.AuthorityUrl, c.ResourceUrl
+		secrets.Certificate = c.Certificate
+	case SqlAdminCredentials:
+		schema.SqlUserName = c.UserId
+		secrets.SqlUserPassword = c.Password
+	case NoCredentials:
+		secrets = nil
+	}
+	schema.Secrets = secrets
+	return schema
+}
+
+// toWriteDatastoreContentsSchema Convert the contents of a datastore to the schema used by the preview APIs,
+// defaulting the endpoints of the storage accounts to the storage endpoint suffix provided as argument.
+func toWriteDatastoreContentsSchema(contents DatastoreContents, endpoint string) WriteDatastoreSchema {
+	schema := WriteDatastoreSchema{ContentsType: string(contents.DatastoreType())}
+	switch c := contents.(type) {
+	case AzureBlobContents:
+		schema.StorageAccountName, schema.StorageContainerName = c.AccountName, c.ContainerName
+		schema.Endpoint, schema.Protocol = storageEndpointAndProtocol(c.Endpoint, c.Protocol, endpoint)
+	case AzureDataLakeGen2Contents:
+		schema.StorageAccountName, schema.StorageContainerName = c.AccountName, c.Filesystem
+		schema.Endpoint, schema.Protocol = storageEndpointAndProtocol(c.Endpoint, c.Protocol, endpoint)
+	case AzureFileContents:
+		schema.StorageAccountName, schema.StorageContainerName = c.AccountName, c.FileShareName
+		schema.Endpoint, schema.Protocol = storageEndpointAndProtocol(c.Endpoint, c.Protocol, endpoint)
+	case AzureDataLakeGen1Contents:
+		schema.StoreName = c.StoreName
+	case AzureSqlDatabaseContents:
+		schema.ServerName, schema.DatabaseName = c.ServerName, c.DatabaseName
+		schema.Endpoint = valueOrDefault(c.Endpoint, defaultSqlDatabaseEndpoint)
+		schema.PortNumber = portOrDefault(c.PortNumber, defaultSqlDatabasePort)
+	case AzurePostgreSqlContents:
+		enableSsl := c.EnableSsl
+		schema.ServerName, schema.DatabaseName, schema.EnableSsl = c.ServerName, c.DatabaseName, &enableSsl
+		schema.Endpoint = valueOrDefault(c.Endpoint, defaultPostgreSqlEndpoint)
+		schema.PortNumber = portOrDefault(c.PortNumber, defaultPostgreSqlPort)
+	case AzureMySqlContents:
+		schema.ServerName, schema.DatabaseName = c.ServerName, c.DatabaseName
+		schema.Endpoint = valueOrDefault(c.Endpoint, defaultMySqlEndpoint)
+		schema.PortNumber = portOrDefault(c.PortNumber, defaultMySqlPort)
+	}
+	return schema
+}
+
+// storageEndpointAndProtocol Return the endpoint and the protocol of the contents of a storage account,
+// defaulting them to the storage endpoint suffix provided as argument and to https.
+func storageEndpointAndProtocol(endpoint, protocol, defaultEndpoint string) (string, string) {
+	return valueOrDefault(endpoint, defaultEndpoint), valueOrDefault(protocol, defaultStorageProtocol)
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+func portOrDefault(port, defaultPort int) int {
+	if port == 0 {
+		return defaultPort
+	}
+	return port
+}
+
+func toWriteDatasetSchema(dataset *Dataset) *SchemaWrapper {
+	pathSchemas := make([]DatasetPathsSchema, len(dataset.FilePaths)+len(dataset.DirectoryPaths))
+	for i, filePath := range dataset.FilePaths {
+		pathSchemas[i] = DatasetPathsSchema{FilePath: filePath.String()}
+	}
+	for i, directoryPath := range dataset.DirectoryPaths {
+		pathSchemas[i] = DatasetPathsSchema{DirectoryPath: directoryPath.String()}
+	}
+
+	return &SchemaWrapper{
+		Properties: WriteDatasetSchema{
+			Description: dataset.Description,
+			Paths:       pathSchemas,
+		},
+	}
+}
+
+// toWriteGaDatastoreSchema Convert the datastore to the schema used for writing it with the GA APIs
+func toWriteGaDatastoreSchema(datastore *Datastore, endpoint string) *SchemaWrapper {
+	schema := WriteGaDatastoreSchema{
+		DatastoreType:      datastore.StorageType,
+		Description:        datastore.Description,
+		StorageAccountName: datastore.StorageAccountName,
+		Credentials:        toWriteDatastoreCredentialsSchema(datastore),
+		Endpoint:           endpoint,
+		Protocol:           defaultStorageProtocol,
+	}
+	if contents := datastore.contents(); contents != nil {
+		schema.StorageAccountName, schema.Endpoint, schema.Protocol = "", "", ""
+		schema.DatastoreType = string(contents.DatastoreType())
+		switch c := contents.(type) {
+		case AzureBlobContents:
+			schema.StorageAccountName, schema.ContainerName = c.AccountName, c.ContainerName
+			schema.Endpoint, schema.Protocol = storageEndpointAndProtocol(c.Endpoint, c.Protocol, endpoint)
+		case AzureDataLakeGen2Contents:
+			schema.StorageAccountName, schema.Filesystem = c.AccountName, c.Filesystem
+			schema.Endpoint, schema.Protocol = storageEndpointAndProtocol(c.Endpoint, c.Protocol, endpoint)
+		case AzureFileContents:
+			schema.StorageAccountName, schema.FileShareName = c.AccountName, c.FileShareName
+			schema.Endpoint, schema.Protocol = storageEndpointAndProtocol(c.Endpoint, c.Protocol, endpoint)
+		case AzureDataLakeGen1Contents:
+			schema.StoreName = c.StoreName
+		}
+		return &SchemaWrapper{Properties: schema}
+	}
+	switch datastore.StorageType {
+	case "AzureFile":
+		schema.FileShareName = datastore.StorageContainerName
+	case "AzureDataLakeGen2":
+		schema.Filesystem = datastore.StorageContainerName
+	default:
+		schema.ContainerName = datastore.StorageContainerName
+	}
+	return &SchemaWrapper{Properties: schema}
+}
+
+// toWriteDataVersionSchema Convert the dataset to the schema of a data version used by the GA APIs. Data
+// versions have a single path, therefore datasets with multiple paths cannot be converted.
+func toWriteDataVersionSchema(dataset *Dataset) (*SchemaWrapper, error) {
+	if len(dataset.FilePaths)+len(dataset.DirectoryPaths) != 1 {
+		return nil, InvalidArgumentError{"datasets must have exactly one path with API version " + firstGaApiVersion + " or later"}
+	}
+
+	schema := WriteDataVersionSchema{Description: dataset.Description}
+	if len(dataset.FilePaths) == 1 {
+		schema.DataType = DataTypeUriFile
+		schema.DataUri = dataset.FilePaths[0].String()
+	} else {
+		schema.DataType = DataTypeUriFolder
+		schema.DataUri = dataset.DirectoryPaths[0].String()
+	}
+	return &SchemaWrapper{Properties: schema}, nil
+}
+
+func toWriteDataAssetSchema(dataAsset *DataAsset) *SchemaWrapper {
+	return &SchemaWrapper{
+		Properties: WriteDataVersionSchema{
+			Description: dataAsset.Description,
+			DataType:    dataAsset.DataType,
+			DataUri:     dataAsset.DataUri,
+			Tags:        dataAsset.Tags,
+			Properties:  dataAsset.Properties,
+			IsArchived:  dataAsset.IsArchived,
+		},
+	}
+}
+
+func toWriteModelSchema(model *Model) *SchemaWrapper {
+	return &SchemaWrapper{
+		Properties: WriteModelSchema{
+			Description: model.Description,
+			Tags:        model.Tags,
+			Properties:  model.Properties,
+			IsArchived:  model.IsArchived,
+		},
+	}
+}
+
+func toWriteComponentSchema(component *Component) *SchemaWrapper {
+	return &SchemaWrapper{
+		Properties: WriteComponentSchema{
+			Description: component.Description,
+			Tags:        component.Tags,
+			Properties:  component.Properties,
+			IsArchived:  component.IsArchived,
+		},
+	}
+}
+
+func toWriteComponentVersionSchema(componentVersion *ComponentVersion) *SchemaWrapper {
+	var inputs map[string]ComponentInputSchema
+	if len(componentVersion.Inputs) > 0 {
+		inputs = make(map[string]ComponentInputSchema, len(componentVersion.Inputs))
+		for name, input := range componentVersion.Inputs {
+			inputs[name] = ComponentInputSchema{
+				Type:        input.Type,
+				Description: input.Description,
+				Optional:    input.Optional,
+				Default:     toComponentDefaultValue(input),
+			}
+		}
+	}
+
+	var outputs map[string]ComponentOutputSchema
+	if len(componentVersion.Outputs) > 0 {
+		outputs = make(map[string]ComponentOutputSchema, len(componentVersion.Outputs))
+		for name, output := range componentVersion.Outputs {
+			outputs[name] = ComponentOutputSchema{Type: output.Type, Description: output.Description}
+		}
+	}
+
+	var code string
+	if componentVersion.CodeId != "" {
+		code = azureMLAssetPrefix + componentVersion.CodeId
+	}
+
+	componentType := componentVersion.ComponentType
+	if componentType == "" {
+		componentType = ComponentTypeCommand
+	}
+
+	return &SchemaWrapper{
+		Properties: WriteComponentVersionSchema{
+			Description: componentVersion.Description,
+			ComponentSpec: CommandComponentSpecSchema{
+				Schema:      commandComponentSchemaUrl,
+				Name:        componentVersion.Name,
+				Version:     componentVersion.Version,
+				Type:        componentType,
+				DisplayName: componentVersion.DisplayName,
+				Description: componentVersion.Description,
+				Command:     componentVersion.Command,
+				Code:        code,
+				Environment: azureMLAssetPrefix + componentVersion.EnvironmentId,
+				Inputs:      inputs,
+				Outputs:     outputs,
+			},
+			Tags:       componentVersion.Tags,
+			Properties: componentVersion.Properties,
+			IsArchived: componentVersion.IsArchived,
+		},
+	}
+}
+
+// toComponentDefaultValue Return the default value of the input provided as argument with the JSON type matching
+// the type of the input, or nil if the input has no default value
+func toComponentDefaultValue(input ComponentInput) interface{} {
+	if input.Default == "" {
+		return nil
+	}
+	switch input.Type {
+	case ComponentIOTypeNumber, ComponentIOTypeInteger:
+		return json.Number(input.Default)
+	case ComponentIOTypeBoolean:
+		value, _ := strconv.ParseBool(input.Default)
+		return value
+	default:
+		return input.Default
+	}
+}
+
+func toWriteModelVersionSchema(modelVersion *ModelVersion) *SchemaWrapper {
+	var flavors map[string]FlavorDataSchema
+	if len(modelVersion.Flavors) > 0 {
+		flavors = make(map[string]FlavorDataSchema, len(modelVersion.Flavors))
+		for flavor, data := range modelVersion.Flavors {
+			flavors[flavor] = FlavorDataSchema{Data: data}
+		}
+	}
+
+	modelType := modelVersion.ModelType
+	if modelType == "" {
+		modelType = ModelTypeCustom
+	}
+	modelUri := modelVersion.Uri
+	if modelVersion.Path != nil {
+		modelUri = modelVersion.Path.String()
+	}
+
+	return &SchemaWrapper{
+		Properties: WriteModelVersionSchema{
+			Description: modelVersion.Description,
+			ModelType:   modelType,
+			ModelUri:    modelUri,
+			JobName:     modelVersion.JobName,
+			Tags:        modelVersion.Tags,
+			Properties:  modelVersion.Properties,
+			Flavors:     flavors,
+			IsArchived:  modelVersion.IsArchived,
+		},
+	}
+}
+
+func toWriteEnvironmentSchema(environment *Environment) *SchemaWrapper {
+	return &SchemaWrapper{
+		Properties: WriteEnvironmentSchema{
+			Description: environment.Description,
+			Tags:        environment.Tags,
+			Properties:  environment.Properties,
+			IsArchived:  environment.IsArchived,
+		},
+	}
+}
+
+func toWriteEnvironmentVersionSchema(environmentVersion *EnvironmentVersion) *SchemaWrapper {
+	var build *BuildContextSchema
+	if environmentVersion.Build != nil {
+		build = &BuildContextSchema{
+			ContextUri:     environmentVersion.Build.ContextUri,
+			DockerfilePath: environmentVersion.Build.DockerfilePath,
+		}
+	}
+
+	var inferenceConfig *InferenceConfigSchema
+	if environmentVersion.InferenceConfig != nil {
+		inferenceConfig = &InferenceConfigSchema{
+			LivenessRoute:  toRouteSchema(environmentVersion.InferenceConfig.LivenessRoute),
+			ReadinessRoute: toRouteSchema(environmentVersion.InferenceConfig.ReadinessRoute),
+			ScoringRoute:   toRouteSchema(environmentVersion.InferenceConfig.ScoringRoute),
+		}
+	}
+
+	return &SchemaWrapper{
+		Properties: WriteEnvironmentVersionSchema{
+			Description:     environmentVersion.Description,
+			Image:           environmentVersion.Image,
+			Build:           build,
+			CondaFile:       environmentVersion.CondaFile,
+			OsType:          environmentVersion.OsType,
+			InferenceConfig: inferenceConfig,
+			Tags:            environmentVersion.Tags,
+			Properties:      environmentVersion.Properties,
+			IsArchived:      environmentVersion.IsArchived,
+		},
+	}
+}
+
+func toRouteSchema(route *Route) *RouteSchema {
+	if route == nil {
+		return nil
+	}
+	return &RouteSchema{Path: route.Path, Port: route.Port}
+}
+
+func toIdentitySchema(managedIdentity *ManagedIdentity) *IdentitySchema {
+	if managedIdentity == nil {
+		return nil
+	}
+	identity := &IdentitySchema{Type: managedIdentity.Type}
+	if len(managedIdentity.UserAssignedIdentities) > 0 {
+		identity.UserAssignedIdentities = make(map[string]struct{}, len(managedIdentity.UserAssignedIdentities))
+		for _, id := range managedIdentity.UserAssignedIdentities {
+			identity.UserAssignedIdentities[id] = struct{}{}
+		}
+	}
+	return identity
+}
+
+func toWriteComputeSchema(compute *Compute) *WriteComputeSchema {
+	identity := toIdentitySchema(compute.Identity)
+
+	var properties interface{}
+	switch {
+	case compute.AmlCompute != nil:
+		amlCompute := AmlComputePropertiesSchema{
+			VmSize:                      compute.AmlCompute.VmSize,
+			VmPriority:                  compute.AmlCompute.VmPriority,
+			Subnet:                      toResourceIdSchema(compute.AmlCompute.SubnetId),
+			RemoteLoginPortPublicAccess: compute.AmlCompute.RemoteLoginPortPublicAccess,
+		}
+		if scaleSettings := compute.AmlCompute.ScaleSettings; scaleSettings != nil {
+			amlCompute.ScaleSettings = &ScaleSettingsSchema{
+				MinNodeCount: scaleSettings.MinNodeCount,
+				MaxNodeCount: scaleSettings.MaxNodeCount,
+			}
+			if scaleSettings.NodeIdleTimeBeforeScaleDown > 0 {
+				amlCompute.ScaleSettings.NodeIdleTimeBeforeScaleDown = formatIsoDuration(scaleSettings.NodeIdleTimeBeforeScaleDown)
+			}
+		}
+		properties = amlCompute
+	case compute.ComputeInstance != nil:
+		sshPublicAccess := "Disabled"
+		if compute.ComputeInstance.SshPublicAccess {
+			sshPublicAccess = "Enabled"
+		}
+		computeInstance := ComputeInstancePropertiesSchema{
+			VmSize: compute.ComputeInstance.VmSize,
+			Subnet: toResourceIdSchema(compute.ComputeInstance.SubnetId),
+			SshSettings: &SshSettingsSchema{
+				SshPublicAccess: sshPublicAccess,
+				AdminPublicKey:  compute.ComputeInstance.SshAdminPublicKey,
+			},
+			ApplicationSharingPolicy: compute.ComputeInstance.ApplicationSharingPolicy,
+		}
+		if assignedUser := compute.ComputeInstance.AssignedUser; assignedUser != nil {
+			computeInstance.PersonalComputeInstanceSettings = &PersonalComputeInstanceSettingsSchema{
+				AssignedUser: AssignedUserSchema{ObjectId: assignedUser.ObjectId, TenantId: assignedUser.TenantId},
+			}
+		}
+		properties = computeInstance
+	}
+
+	return &WriteComputeSchema{
+		Location: compute.Location,
+		Identity: identity,
+		Properties: WriteComputePropertiesSchema{
+			ComputeType:      compute.ComputeType,
+			Description:      compute.Description,
+			DisableLocalAuth: compute.DisableLocalAuth,
+			Properties:       properties,
+		},
+	}
+}
+
+func toResourceIdSchema(id string) *ResourceIdSchema {
+	if id == "" {
+		return nil
+	}
+	return &ResourceIdSchema{Id: id}
+}
+
+// toWriteJobSchema Convert the job to the schema used for writing it, depending on its type
+func toWriteJobSchema(job *Job) *SchemaWrapper {
+	switch job.JobType {
+	case JobTypeSweep:
+		return &SchemaWrapper{Properties: toWriteSweepJobSchema(job)}
+	case JobTypePipeline:
+		return &SchemaWrapper{Properties: toWritePipelineJobSchema(job)}
+	}
+
+	command := job.Command
+	schema := WriteCommandJobSchema{
+		WriteJobBaseSchema:   toWriteJobBaseSchema(job),
+		Command:              command.Command,
+		CodeId:               command.CodeId,
+		EnvironmentId:        command.EnvironmentId,
+		EnvironmentVariables: command.EnvironmentVariables,
+		Inputs:               toJobInputSchemas(command.Inputs),
+		Outputs:              toJobOutputSchemas(command.Outputs),
+		Distribution:         toDistributionSchema(command.Distribution),
+		Resources:            toJobResourcesSchema(command.Resources),
+	}
+	if command.Timeout > 0 {
+		schema.Limits = &JobLimitsSchema{JobLimitsType: string(JobTypeCommand), Timeout: formatIsoDuration(command.Timeout)}
+	}
+	return &SchemaWrapper{Properties: schema}
+}
+
+func toWriteJobBaseSchema(job *Job) WriteJobBaseSchema {
+	return WriteJobBaseSchema{
+		JobType:        job.JobType,
+		DisplayName:    job.DisplayName,
+		Description:    job.Description,
+		ExperimentName: job.ExperimentName,
+		ComputeId:      job.ComputeId,
+		Tags:           job.Tags,
+		Properties:     job.Properties,
+		IsArchived:     job.IsArchived,
+	}
+}
+
+func toWriteSweepJobSchema(job *Job) WriteSweepJobSchema {
+	sweep := job.Sweep
+	searchSpace := make(map[string][]interface{}, len(sweep.SearchSpace))
+	for name, distribution := range sweep.SearchSpace {
+		searchSpace[name] = distribution.searchSpaceExpression()
+	}
+
+	schema := WriteSweepJobSchema{
+		WriteJobBaseSchema: toWriteJobBaseSchema(job),
+		SearchSpace:        searchSpace,
+		SamplingAlgorithm:  SamplingAlgorithmSchema{SamplingAlgorithmType: sweep.SamplingAlgorithm},
+		Objective:          ObjectiveSchema{PrimaryMetric: sweep.Objective.PrimaryMetric, Goal: sweep.Objective.Goal},
+		Limits: SweepLimitsSchema{
+			JobLimitsType:       string(JobTypeSweep),
+			MaxTotalTrials:      sweep.Limits.MaxTotalTrials,
+			MaxConcurrentTrials: sweep.Limits.MaxConcurrentTrials,
+		},
+		Trial: TrialComponentSchema{
+			Command:              sweep.Trial.Command,
+			CodeId:               sweep.Trial.CodeId,
+			EnvironmentId:        sweep.Trial.EnvironmentId,
+			EnvironmentVariables: sweep.Trial.EnvironmentVariables,
+			Distribution:         toDistributionSchema(sweep.Trial.Distribution),
+			Resources:            toJobResourcesSchema(sweep.Trial.Resources),
+		},
+		Inputs:  toJobInputSchemas(sweep.Trial.Inputs),
+		Outputs: toJobOutputSchemas(sweep.Trial.Outputs),
+	}
+	if sweep.Limits.Timeout > 0 {
+		schema.Limits.Timeout = formatIsoDuration(sweep.Limits.Timeout)
+	}
+	if sweep.Trial.Timeout > 0 {
+		schema.Limits.TrialTimeout = formatIsoDuration(sweep.Trial.Timeout)
+	}
+	if policy := sweep.EarlyTermination; policy != nil {
+		schema.EarlyTermination = &EarlyTerminationPolicySchema{
+			PolicyType:           policy.PolicyType,
+			EvaluationInterval:   policy.EvaluationInterval,
+			DelayEvaluation:      policy.DelayEvaluation,
+			SlackFactor:          policy.SlackFactor,
+			SlackAmount:          policy.SlackAmount,
+			TruncationPercentage: policy.TruncationPercentage,
+		}
+	}
+	return schema
+}
+
+func toWritePipelineJobSchema(job *Job) WritePipelineJobSchema {
+	pipeline := job.Pipeline
+	steps := make(map[string]PipelineStepSchema, len(pipeline.Steps))
+	for name, step := range pipeline.Steps {
+		stepSchema := PipelineStepSchema{
+			Name:        name,
+			Type:        "command",
+			ComponentId: step.ComponentId,
+			ComputeId:   step.ComputeId,
+		}
+		if len(step.Inputs) > 0 {
+			stepSchema.Inputs = make(map[string]PipelineStepInputSchema, len(step.Inputs))
+			for input, binding := range step.Inputs {
+				stepSchema.Inputs[input] = PipelineStepInputSchema{JobInputType: JobInputTypeLiteral, Value: binding.expression()}
+			}
+		}
+		if len(step.Outputs) > 0 {
+			stepSchema.Outputs = make(map[string]PipelineStepOutputSchema, len(step.Outputs))
+			for output, pipelineOutput := range step.Outputs {
+				stepSchema.Outputs[output] = PipelineStepOutputSchema{
+					Type:  string(JobInputTypeLiteral),
+					Value: fmt.Sprintf("${{parent.outputs.%s}}", pipelineOutput),
+				}
+			}
+		}
+		steps[name] = stepSchema
+	}
+
+	return WritePipelineJobSchema{
+		WriteJobBaseSchema: toWriteJobBaseSchema(job),
+		Inputs:             toJobInputSchemas(pipeline.Inputs),
+		Outputs:            toJobOutputSchemas(pipeline.Outputs),
+		Settings: PipelineSettingsSchema{
+			DefaultCompute:        pipeline.DefaultComputeId,
+			ContinueOnStepFailure: pipeline.ContinueOnStepFailure,
+		},
+		Jobs: steps,
+	}
+}
+
+func toDistributionSchema(distribution *Distribution) *DistributionSchema {
+	if distribution == nil {
+		return nil
+	}
+	return &DistributionSchema{
+		DistributionType:        distribution.DistributionType,
+		ProcessCountPerInstance: distribution.ProcessCountPerInstance,
+		WorkerCount:             distribution.WorkerCount,
+		ParameterServerCount:    distribution.ParameterServerCount,
+	}
+}
+
+func toJobResourcesSchema(resources *JobResources) *JobResourcesSchema {
+	if resources == nil {
+		return nil
+	}
+	return &JobResourcesSchema{
+		InstanceCount: resources.InstanceCount,
+		InstanceType:  resources.InstanceType,
+		Properties:    resources.Properties,
+	}
+}
+
+func toJobInputSchemas(inputs map[string]JobInput) map[string]JobInputSchema {
+	if len(inputs) == 0 {
+		return nil
+	}
+	result := make(map[string]JobInputSchema, len(inputs))
+	for name, input := range inputs {
+		result[name] = JobInputSchema{
+			JobInputType: input.InputType,
+			Description:  input.Description,
+			Uri:          input.Uri,
+			Mode:         input.Mode,
+			Value:        input.Value,
+		}
+	}
+	return result
+}
+
+func toJobOutputSchemas(outputs map[string]JobOutput) map[string]JobOutputSchema {
+	if len(outputs) == 0 {
+		return nil
+	}
+	result := make(map[string]JobOutputSchema, len(outputs))
+	for name, output := range outputs {
+		result[name] = JobOutputSchema{
+			JobOutputType: output.OutputType,
+			Description:   output.Description,
+			Uri:           output.Uri,
+			Mode:          output.Mode,
+		}
+	}
+	return result
+}
+
+type EndpointConverter struct {
+	logger *zap.SugaredLogger
+}
+
+func (c EndpointConverter) unmarshalOnlineEndpointArray(json []byte) []OnlineEndpoint {
+	jsonEndpointArray := gjson.GetBytes(json, "value").Array()
+	result := make([]OnlineEndpoint, len(jsonEndpointArray))
+	for i, jsonEndpoint := range jsonEndpointArray {
+		result[i] = *c.unmarshalOnlineEndpoint([]byte(jsonEndpoint.Raw))
+	}
+	return result
+}
+
+func (c EndpointConverter) unmarshalOnlineEndpoint(json []byte) *OnlineEndpoint {
+	return &OnlineEndpoint{
+		Id:                  gjson.GetBytes(json, "id").Str,
+		Name:                gjson.GetBytes(json, "name").Str,
+		Location:            gjson.GetBytes(json, "location").Str,
+		Kind:                gjson.GetBytes(json, "kind").Str,
+		Description:         gjson.GetBytes(json, "properties.description").Str,
+		AuthMode:            EndpointAuthMode(gjson.GetBytes(json, "properties.authMode").Str),
+		Traffic:             unmarshalTraffic(gjson.GetBytes(json, "properties.traffic")),
+		MirrorTraffic:       unmarshalTraffic(gjson.GetBytes(json, "properties.mirrorTraffic")),
+		Identity:            unmarshalManagedIdentity(gjson.GetBytes(json, "identity")),
+		PublicNetworkAccess: PublicNetworkAccess(gjson.GetBytes(json, "properties.publicNetworkAccess").Str),
+		Compute:             gjson.GetBytes(json, "properties.compute").Str,
+		ScoringUri:          gjson.GetBytes(json, "properties.scoringUri").Str,
+		SwaggerUri:          gjson.GetBytes(json, "properties.swaggerUri").Str,
+		ProvisioningState:   gjson.GetBytes(json, "properties.provisioningState").Str,
+		Tags:                unmarshalStringMap(gjson.GetBytes(json, "tags")),
+		Properties:          unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		SystemData:          unmarshalSystemData(json),
+	}
+}
+
+// unmarshalTraffic Unmarshal the percentages of the requests routed to each deployment, returning nil if the
+// endpoint does not specify them
+func unmarshalTraffic(jsonTraffic gjson.Result) map[string]int {
+	if !jsonTraffic.IsObject() {
+		return nil
+	}
+	traffic := make(map[string]int)
+	jsonTraffic.ForEach(func(key, value gjson.Result) bool {
+		traffic[key.Str] = int(value.Int())
+		return true
+	})
+	return traffic
+}
+
+func (c EndpointConverter) unmarshalOnlineDeploymentArray(endpointName string, json []byte) []OnlineDeployment {
+	jsonDeploymentArray := gjson.GetBytes(json, "value").Array()
+	result := make([]OnlineDeployment, len(jsonDeploymentArray))
+	for i, jsonDeployment := range jsonDeploymentArray {
+		result[i] = *c.unmarshalOnlineDeployment(endpointName, []byte(jsonDeployment.Raw))
+	}
+	return result
+}
+
+func (c EndpointConverter) unmarshalOnlineDeployment(endpointName string, json []byte) *OnlineDeployment {
+	properties := gjson.GetBytes(json, "properties")
+	deployment := &OnlineDeployment{
+		Id:                   gjson.GetBytes(json, "id").Str,
+		Name:                 gjson.GetBytes(json, "name").Str,
+		EndpointName:         endpointName,
+		Location:             gjson.GetBytes(json, "location").Str,
+		Description:          properties.Get("description").Str,
+		ModelId:              properties.Get("model").Str,
+		EnvironmentId:        properties.Get("environmentId").Str,
+		EnvironmentVariables: unmarshalStringMap(properties.Get("environmentVariables")),
+		InstanceType:         properties.Get("instanceType").Str,
+		InstanceCount:        int(gjson.GetBytes(json, "sku.capacity").Int()),
+		LivenessProbe:        c.unmarshalProbeSettings(properties.Get("livenessProbe")),
+		ReadinessProbe:       c.unmarshalProbeSettings(properties.Get("readinessProbe")),
+		AppInsightsEnabled:   properties.Get("appInsightsEnabled").Bool(),
+		ProvisioningState:    properties.Get("provisioningState").Str,
+		Tags:                 unmarshalStringMap(gjson.GetBytes(json, "tags")),
+		Properties:           unmarshalStringMap(properties.Get("properties")),
+		SystemData:           unmarshalSystemData(json),
+	}
+	if codeConfiguration := properties.Get("codeConfiguration"); codeConfiguration.IsObject() {
+		deployment.CodeConfiguration = &CodeConfiguration{
+			CodeId:        codeConfiguration.Get("codeId").Str,
+			ScoringScript: codeConfiguration.Get("scoringScript").Str,
+		}
+	}
+	if scaleSettings := properties.Get("scaleSettings"); scaleSettings.IsObject() {
+		deployment.ScaleSettings = &OnlineScaleSettings{
+			ScaleType:                   ScaleType(scaleSettings.Get("scaleType").Str),
+			MinInstances:                int(scaleSettings.Get("minInstances").Int()),
+			MaxInstances:                int(scaleSettings.Get("maxInstances").Int()),
+			PollingInterval:             c.unmarshalDuration(scaleSettings.Get("pollingInterval").Str),
+			TargetUtilizationPercentage: int(scaleSettings.Get("targetUtilizationPercentage").Int()),
+		}
+	}
+	if requestSettings := properties.Get("requestSettings"); requestSettings.IsObject() {
+		deployment.RequestSettings = &OnlineRequestSettings{
+			MaxConcurrentRequestsPerInstance: int(requestSettings.Get("maxConcurrentRequestsPerInstance").Int()),
+			RequestTimeout:                   c.unmarshalDuration(requestSettings.Get("requestTimeout").Str),
+			MaxQueueWait:                     c.unmarshalDuration(requestSettings.Get("maxQueueWait").Str),
+		}
+	}
+	return deployment
+}
+
+func (c EndpointConverter) unmarshalProbeSettings(json gjson.Result) *ProbeSettings {
+	if json.IsObject() == false {
+		return nil
+	}
+	return &ProbeSettings{
+		FailureThreshold: int(json.Get("failureThreshold").Int()),
+		SuccessThreshold: int(json.Get("successThreshold").Int()),
+		InitialDelay:     c.unmarshalDuration(json.Get("initialDelay").Str),
+		Period:           c.unmarshalDuration(json.Get("period").Str),
+		Timeout:          c.unmarshalDuration(json.Get("timeout").Str),
+	}
+}
+
+// unmarshalDuration Unmarshal a ISO 8601 duration, logging the error and returning zero if it is malformed
+func (c EndpointConverter) unmarshalDuration(duration string) time.Duration {
+	if duration == "" {
+		return 0
+	}
+	result, err := parseIsoDuration(duration)
+	if err != nil {
+		c.logger.Errorf("error unmarshalling deployment settings: %s", err.Error())
+	}
+	return result
+}
+
+func toWriteOnlineEndpointSchema(endpoint *OnlineEndpoint) *WriteOnlineEndpointSchema {
+	identity := toIdentitySchema(endpoint.Identity)
+	if identity == nil {
+		identity = &IdentitySchema{Type: IdentityTypeSystemAssigned}
+	}
+	return &WriteOnlineEndpointSchema{
+		Location: endpoint.Location,
+		Kind:     endpoint.Kind,
+		Identity: identity,
+		Tags:     endpoint.Tags,
+		Properties: OnlineEndpointPropertiesSchema{
+			AuthMode:            endpoint.AuthMode,
+			Description:         endpoint.Description,
+			Properties:          endpoint.Properties,
+			Traffic:             endpoint.Traffic,
+			MirrorTraffic:       endpoint.MirrorTraffic,
+			PublicNetworkAccess: endpoint.PublicNetworkAccess,
+			Compute:             endpoint.Compute,
+		},
+	}
+}
+
+func toWriteOnlineDeploymentSchema(deployment *OnlineDeployment) *WriteOnlineDeploymentSchema {
+	schema := &WriteOnlineDeploymentSchema{
+		Location: deployment.Location,
+		Tags:     deployment.Tags,
+		Properties: OnlineDeploymentPropertiesSchema{
+			EndpointComputeType:  "Managed",
+			Description:          deployment.Description,
+			Properties:           deployment.Properties,
+			Model:                deployment.ModelId,
+			EnvironmentId:        deployment.EnvironmentId,
+			EnvironmentVariables: deployment.EnvironmentVariables,
+			InstanceType:         deployment.InstanceType,
+			LivenessProbe:        toProbeSettingsSchema(deployment.LivenessProbe),
+			ReadinessProbe:       toProbeSettingsSchema(deployment.ReadinessProbe),
+			AppInsightsEnabled:   deployment.AppInsightsEnabled,
+		},
+	}
+	if deployment.InstanceCount > 0 {
+		schema.Sku = &SkuSchema{Name: string(ScaleTypeDefault), Capacity: deployment.InstanceCount}
+	}
+	if codeConfiguration := deployment.CodeConfiguration; codeConfiguration != nil {
+		schema.Properties.CodeConfiguration = &CodeConfigurationSchema{
+			CodeId:        codeConfiguration.CodeId,
+			ScoringScript: codeConfiguration.ScoringScript,
+		}
+	}
+	if scaleSettings := deployment.ScaleSettings; scaleSettings != nil {
+		schema.Properties.ScaleSettings = &OnlineScaleSettingsSchema{
+			ScaleType:                   scaleSettings.ScaleType,
+			MinInstances:                scaleSettings.MinInstances,
+			MaxInstances:                scaleSettings.MaxInstances,
+			PollingInterval:             formatOptionalIsoDuration(scaleSettings.PollingInterval),
+			TargetUtilizationPercentage: scaleSettings.TargetUtilizationPercentage,
+		}
+	}
+	if requestSettings := deployment.RequestSettings; requestSettings != nil {
+		schema.Properties.RequestSettings = &OnlineRequestSettingsSchema{
+			MaxConcurrentRequestsPerInstance: requestSettings.MaxConcurrentRequestsPerInstance,
+			RequestTimeout:                   formatOptionalIsoDuration(requestSettings.RequestTimeout),
+			MaxQueueWait:                     formatOptionalIsoDuration(requestSettings.MaxQueueWait),
+		}
+	}
+	return schema
+}
+
+func toProbeSettingsSchema(probe *ProbeSettings) *ProbeSettingsSchema {
+	if probe == nil {
+		return nil
+	}
+	return &ProbeSettingsSchema{
+		FailureThreshold: probe.FailureThreshold,
+		SuccessThreshold: probe.SuccessThreshold,
+		InitialDelay:     formatOptionalIsoDuration(probe.InitialDelay),
+		Period:           formatOptionalIsoDuration(probe.Period),
+		Timeout:          formatOptionalIsoDuration(probe.Timeout),
+	}
+}
+
+func (c EndpointConverter) unmarshalBatchEndpointArray(json []byte) []BatchEndpoint {
+	jsonEndpointArray := gjson.GetBytes(json, "value").Array()
+	result := make([]BatchEndpoint, len(jsonEndpointArray))
+	for i, jsonEndpoint := range jsonEndpointArray {
+		result[i] = *c.unmarshalBatchEndpoint([]byte(jsonEndpoint.Raw))
+	}
+	return result
+}
+
+func (c EndpointConverter) unmarshalBatchEndpoint(json []byte) *BatchEndpoint {
+	return &BatchEndpoint{
+		Id:                gjson.GetBytes(json, "id").Str,
+		Name:              gjson.GetBytes(json, "name").Str,
+		Location:          gjson.GetBytes(json, "location").Str,
+		Description:       gjson.GetBytes(json, "properties.description").Str,
+		DefaultDeployment: gjson.GetBytes(json, "properties.defaults.deploymentName").Str,
+		Identity:          unmarshalManagedIdentity(gjson.GetBytes(json, "identity")),
+		ScoringUri:        gjson.GetBytes(json, "properties.scoringUri").Str,
+		SwaggerUri:        gjson.GetBytes(json, "properties.swaggerUri").Str,
+		ProvisioningState: gjson.GetBytes(json, "properties.provisioningState").Str,
+		Tags:              unmarshalStringMap(gjson.GetBytes(json, "tags")),
+		Properties:        unmarshalStringMap(gjson.GetBytes(json, "properties.properties")),
+		SystemData:        unmarshalSystemData(json),
+	}
+}
+
+func (c EndpointConverter) unmarshalBatchDeploymentArray(endpointName string, json []byte) []BatchDeployment {
+	jsonDeploymentArray := gjson.GetBytes(json, "value").Array()
+	result := make([]BatchDeployment, len(jsonDeploymentArray))
+	for i, jsonDeployment := range jsonDeploymentArray {
+		result[i] = *c.unmarshalBatchDeployment(endpointName, []byte(jsonDeployment.Raw))
+	}
+	return result
+}
+
+func (c EndpointConverter) unmarshalBatchDeployment(endpointName string, json []byte) *BatchDeployment {
+	properties := gjson.GetBytes(json, "properties")
+	deployment := &BatchDeployment{
+		Id:                        gjson.GetBytes(json, "id").Str,
+		Name:                      gjson.GetBytes(json, "name").Str,
+		EndpointName:              endpointName,
+		Location:                  gjson.GetBytes(json, "location").Str,
+		Description:               properties.Get("description").Str,
+		ModelId:                   properties.Get("model.assetId").Str,
+		EnvironmentId:             properties.Get("environmentId").Str,
+		EnvironmentVariables:      unmarshalStringMap(properties.Get("environmentVariables")),
+		ComputeId:                 properties.Get("compute").Str,
+		InstanceCount:             int(properties.Get("resources.instanceCount").Int()),
+		MaxConcurrencyPerInstance: int(properties.Get("maxConcurrencyPerInstance").Int()),
+		MiniBatchSize:             int(properties.Get("miniBatchSize").Int()),
+		ErrorThreshold:            int(properties.Get("errorThreshold").Int()),
+		LoggingLevel:              BatchLoggingLevel(properties.Get("loggingLevel").Str),
+		OutputAction:              BatchOutputAction(properties.Get("outputAction").Str),
+		OutputFileName:            properties.Get("outputFileName").Str,
+		ProvisioningState:         properties.Get("provisioningState").Str,
+		Tags:                      unmarshalStringMap(gjson.GetBytes(json, "tags")),
+		Properties:                unmarshalStringMap(properties.Get("properties")),
+		SystemData:                unmarshalSystemData(json),
+	}
+	if codeConfiguration := properties.Get("codeConfiguration"); codeConfiguration.IsObject() {
+		deployment.CodeConfiguration = &CodeConfiguration{
+			CodeId:        codeConfiguration.Get("codeId").Str,
+			ScoringScript: codeConfiguration.Get("scoringScript").Str,
+		}
+	}
+	if retrySettings := properties.Get("retrySettings"); retrySettings.IsObject() {
+		deployment.RetrySettings = &BatchRetrySettings{
+			MaxRetries: int(retrySettings.Get("maxRetries").Int()),
+			Timeout:    c.unmarshalDuration(retrySettings.Get("timeout").Str),
+		}
+	}
+	return deployment
+}
+
+func toWriteBatchEndpointSchema(endpoint *BatchEndpoint) *WriteBatchEndpointSchema {
+	identity := toIdentitySchema(endpoint.Identity)
+	if identity == nil {
+		identity = &IdentitySchema{Type: IdentityTypeSystemAssigned}
+	}
+	schema := &WriteBatchEndpointSchema{
+		Location: endpoint.Location,
+		Identity: identity,
+		Tags:     endpoint.Tags,
+		Properties: BatchEndpointPropertiesSchema{
+			AuthMode:    EndpointAuthModeAADToken,
+			Description: endpoint.Description,
+			Properties:  endpoint.Properties,
+		},
+	}
+	if endpoint.DefaultDeployment != "" {
+		schema.Properties.Defaults = &BatchEndpointDefaultsSchema{DeploymentName: endpoint.DefaultDeployment}
+	}
+	return schema
+}
+
+func toWriteBatchDeploymentSchema(deployment *BatchDeployment) *WriteBatchDeploymentSchema {
+	schema := &WriteBatchDeploymentSchema{
+		Location: deployment.Location,
+		Tags:     deployment.Tags,
+		Properties: BatchDeploymentPropertiesSchema{
+			Description:               deployment.Description,
+			Properties:                deployment.Properties,
+			Model:                     &AssetReferenceSchema{ReferenceType: "Id", AssetId: deployment.ModelId},
+			EnvironmentId:             deployment.EnvironmentId,
+			EnvironmentVariables:      deployment.EnvironmentVariables,
+			Compute:                   deployment.ComputeId,
+			MaxConcurrencyPerInstance: deployment.MaxConcurrencyPerInstance,
+			MiniBatchSize:             deployment.MiniBatchSize,
+			ErrorThreshold:            deployment.ErrorThreshold,
+			LoggingLevel:              deployment.LoggingLevel,
+			OutputAction:              deployment.OutputAction,
+			OutputFileName:            deployment.OutputFileName,
+		},
+	}
+	if deployment.InstanceCount > 0 {
+		schema.Properties.Resources = &ResourceConfigurationSchema{InstanceCount: deployment.InstanceCount}
+	}
+	if codeConfiguration := deployment.CodeConfiguration; codeConfiguration != nil {
+		schema.Properties.CodeConfiguration = &CodeConfigurationSchema{
+			CodeId:        codeConfiguration.CodeId,
+			ScoringScript: codeConfiguration.ScoringScript,
+		}
+	}
+	if retrySettings := deployment.RetrySettings; retrySettings != nil {
+		schema.Properties.RetrySettings = &BatchRetrySettingsSchema{
+			MaxRetries: retrySettings.MaxRetries,
+			Timeout:    formatOptionalIsoDuration(retrySettings.Timeout),
+		}
+	}
+	return schema
+}
+
+// toBatchInvocationSchema Convert the input and the output of a batch endpoint invocation to the schema of the
+// request, in which the types of the data are in pascal case, e.g. UriFolder
+func toBatchInvocationSchema(input JobInput, output *DatastorePath) *BatchInvocationSchema {
+	schema := &BatchInvocationSchema{
+		Properties: BatchInvocationPropertiesSchema{
+			InputData: map[string]BatchInvocationDataSchema{
+				"input": {JobInputType: toBatchDataType(string(input.InputType)), Uri: input.Uri},
+			},
+		},
+	}
+	if output != nil {
+		schema.Properties.OutputData = map[string]BatchInvocationDataSchema{
+			"output": {JobOutputType: toBatchDataType(string(JobOutputTypeUriFolder)), Uri: output.String()},
+		}
+	}
+	return schema
+}
+
+func toBatchDataType(dataType string) string {
+	switch dataType {
+	case string(JobInputTypeUriFile):
+		return "UriFile"
+	case string(JobInputTypeUriFolder):
+		return "UriFolder"
+	case string(JobInputTypeMLTable):
+		return "MLTable"
+	default:
+		return dataType
+	}
+}
+
+func unmarshalAmlWorkspaceArray(json []byte) []AmlWorkspace {
+	jsonWorkspaceArray := gjson.GetBytes(json, "value").Array()
+	result := make([]AmlWorkspace, len(jsonWorkspaceArray))
+	for i, jsonWorkspace := range jsonWorkspaceArray {
+		result[i] = *unmarshalAmlWorkspace([]byte(jsonWorkspace.Raw))
+	}
+	return result
+}
+
+func unmarshalAmlWorkspace(json []byte) *AmlWorkspace {
+	workspace := &AmlWorkspace{
+		Id:                    gjson.GetBytes(json, "id").Str,
+		Name:                  gjson.GetBytes(json, "name").Str,
+		Location:              gjson.GetBytes(json, "location").Str,
+		FriendlyName:          gjson.GetBytes(json, "properties.friendlyName").Str,
+		Description:           gjson.GetBytes(json, "properties.description").Str,
+		Identity:              unmarshalManagedIdentity(gjson.GetBytes(json, "identity")),
+		StorageAccountId:      gjson.GetBytes(json, "properties.storageAccount").Str,
+		KeyVaultId:            gjson.GetBytes(json, "properties.keyVault").Str,
+		ContainerRegistryId:   gjson.GetBytes(json, "properties.containerRegistry").Str,
+		ApplicationInsightsId: gjson.GetBytes(json, "properties.applicationInsights").Str,
+		HbiWorkspace:          gjson.GetBytes(json, "properties.hbiWorkspace").Bool(),
+		PublicNetworkAccess:   PublicNetworkAccess(gjson.GetBytes(json, "properties.publicNetworkAccess").Str),
+		WorkspaceId:           gjson.GetBytes(json, "properties.workspaceId").Str,
+		DiscoveryUrl:          gjson.GetBytes(json, "properties.discoveryUrl").Str,
+		ProvisioningState:     gjson.GetBytes(json, "properties.provisioningState").Str,
+		Tags:                  unmarshalStringMap(gjson.GetBytes(json, "tags")),
+		SystemData:            unmarshalSystemData(json),
+	}
+	if managedNetwork := gjson.GetBytes(json, "properties.managedNetwork"); managedNetwork.IsObject() {
+		workspace.ManagedNetwork = &ManagedNetwork{
+			IsolationMode: ManagedNetworkIsolationMode(managedNetwork.Get("isolationMode").Str),
+			Status:        managedNetwork.Get("status.status").Str,
+		}
+	}
+	return workspace
+}
+
+func unmarshalAmlWorkspaceKeys(json []byte) *AmlWorkspaceKeys {
+	keys := &AmlWorkspaceKeys{
+		UserStorageKey:                gjson.GetBytes(json, "userStorageKey").Str,
+		UserStorageResourceId:         gjson.GetBytes(json, "userStorageResourceId").Str,
+		AppInsightsInstrumentationKey: gjson.GetBytes(json, "appInsightsInstrumentationKey").Str,
+	}
+	if registry := gjson.GetBytes(json, "containerRegistryCredentials"); registry.IsObject() {
+		passwords := make(map[string]string)
+		for _, password := range registry.Get("passwords").Array() {
+			passwords[password.Get("name").Str] = password.Get("value").Str
+		}
+		keys.ContainerRegistryCredentials = &ContainerRegistryCredentials{
+			Location:  registry.Get("location").Str,
+			Username:  registry.Get("username").Str,
+			Passwords: passwords,
+		}
+	}
+	if notebook := gjson.GetBytes(json, "notebookAccessKeys"); notebook.IsObject() {
+		keys.NotebookAccessKeys = &NotebookAccessKeys{
+			PrimaryAccessKey:   notebook.Get("primaryAccessKey").Str,
+			SecondaryAccessKey: notebook.Get("secondaryAccessKey").Str,
+		}
+	}
+	return keys
+}
+
+func toWriteAmlWorkspaceSchema(workspace *AmlWorkspace) *WriteAmlWorkspaceSchema {
+	identity := toIdentitySchema(workspace.Identity)
+	if identity == nil {
+		identity = &IdentitySchema{Type: IdentityTypeSystemAssigned}
+	}
+	schema := &WriteAmlWorkspaceSchema{
+		Location: workspace.Location,
+		Identity: identity,
+		Tags:     workspace.Tags,
+		Properties: AmlWorkspacePropertiesSchema{
+			FriendlyName:        workspace.FriendlyName,
+			Description:         workspace.Description,
+			StorageAccount:      workspace.StorageAccountId,
+			KeyVault:            workspace.KeyVaultId,
+			ContainerRegistry:   workspace.ContainerRegistryId,
+			ApplicationInsights: workspace.ApplicationInsightsId,
+			HbiWorkspace:        workspace.HbiWorkspace,
+			PublicNetworkAccess: workspace.PublicNetworkAccess,
+		},
+	}
+	if workspace.ManagedNetwork != nil {
+		schema.Properties.ManagedNetwork = &ManagedNetworkSchema{IsolationMode: workspace.ManagedNetwork.IsolationMode}
 	}
+	return schema
 }
@@ -1,6 +1,7 @@
 package workspace
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
@@ -337,3 +338,297 @@ func TestUnmarshalDatasetPaths(t *testing.T) {
 		test.testCase()
 	}
 }
+
+func TestUnmarshalGaDatastore(t *testing.T) {
+	a := assert.New(t)
+
+	resp := loadExampleResp("example_resp_get_datastore_ga.json")
+	datastore := datastoreConverter{ApiVersion20220501}.unmarshalDatastore(resp)
+	a.Equal("id-1", datastore.Id)
+	a.Equal("datastore-1", datastore.Name)
+	a.Equal("test", datastore.Description)
+	a.Equal("AzureDataLakeGen2", datastore.StorageType)
+	a.Equal("account-1", datastore.StorageAccountName)
+	a.Equal("filesystem-1", datastore.StorageContainerName)
+	a.True(datastore.IsDefault)
+	a.Equal("ServicePrincipal", datastore.Auth.CredentialsType)
+	a.Equal("tenant-1", datastore.Auth.TenantId)
+	a.Equal("client-1", datastore.Auth.ClientId)
+	a.Equal("creationUser", datastore.SystemData.CreationUser)
+}
+
+func TestToWriteGaDatastoreSchema(t *testing.T) {
+	a := assert.New(t)
+	datastore := &Datastore{
+		Name:                 "datastore",
+		Description:          "description",
+		IsDefault:            true,
+		StorageType:          "AzureFile",
+		StorageAccountName:   "account",
+		StorageContainerName: "share",
+		Auth: &DatastoreAuth{
+			CredentialsType: "AccountKey",
+			AccountKey:      "key",
+		},
+	}
+
+	schema := datastoreConverter{ApiVersion20220501}.toWriteDatastoreSchema(datastore, "core.windows.net")
+	properties := schema.Properties.(WriteGaDatastoreSchema)
+	a.Equal("AzureFile", properties.DatastoreType)
+	a.Equal("share", properties.FileShareName)
+	a.Empty(properties.ContainerName)
+	a.Equal("account", properties.StorageAccountName)
+	a.Equal("key", properties.Credentials.Secrets.AccountKey)
+	a.Equal("core.windows.net", properties.Endpoint)
+
+	schema = datastoreConverter{ApiVersion20210301Preview}.toWriteDatastoreSchema(datastore, "core.windows.net")
+	a.IsType(WriteDatastoreSchemaProperties{}, schema.Properties)
+}
+
+func TestDatasetConverter_GaApiVersion(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &DatasetConverter{logger: l.Sugar(), apiVersion: ApiVersion20220501}
+
+	dataset := converter.unmarshalDatasetVersion("dataset", loadExampleResp("example_resp_get_data_version.json"))
+	a.Equal("dataset", dataset.Name)
+	a.Equal(3, dataset.Version)
+	a.Equal("test", dataset.Description)
+	a.Empty(dataset.DirectoryPaths)
+	a.Len(dataset.FilePaths, 1)
+	a.Equal("azureml://datastores/datastore-1/paths/foo/bar.csv", dataset.FilePaths[0].String())
+	a.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/datastores/datastore-1", dataset.DatastoreId)
+
+	schema, err := converter.toWriteDatasetSchema(dataset)
+	a.Nil(err)
+	a.Equal(WriteDataVersionSchema{
+		Description: "test",
+		DataType:    "uri_file",
+		DataUri:     "azureml://datastores/datastore-1/paths/foo/bar.csv",
+	}, schema.Properties)
+
+	dataset.DirectoryPaths = dataset.FilePaths
+	_, err = converter.toWriteDatasetSchema(dataset)
+	a.IsType(InvalidArgumentError{}, err)
+
+	a.Equal("data/dataset/versions/3?api-version=2022-05-01", converter.resourcePath("/%s/versions/%d", "dataset", 3))
+	a.Equal("data/dataset?api-version=2022-05-01", converter.nextVersionPath("dataset"))
+	a.Equal("datasets/dataset/versions", (&DatasetConverter{apiVersion: ApiVersion20210301Preview}).nextVersionPath("dataset"))
+}
+
+func TestDatastoreContents_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	storageContents := []DatastoreContents{
+		AzureBlobContents{AccountName: "account", ContainerName: "container", Endpoint: "core.windows.net", Protocol: "https"},
+		AzureDataLakeGen2Contents{AccountName: "account", Filesystem: "filesystem", Endpoint: "core.windows.net", Protocol: "https"},
+		AzureFileContents{AccountName: "account", FileShareName: "share", Endpoint: "core.windows.net", Protocol: "https"},
+		AzureDataLakeGen1Contents{StoreName: "store"},
+	}
+	databaseContents := []DatastoreContents{
+		AzureSqlDatabaseContents{ServerName: "server", DatabaseName: "db", Endpoint: "database.windows.net", PortNumber: 1433},
+		AzurePostgreSqlContents{ServerName: "server", DatabaseName: "db", Endpoint: "postgres.database.azure.com", PortNumber: 5432, EnableSsl: true},
+		AzureMySqlContents{ServerName: "server", DatabaseName: "db", Endpoint: "mysql.database.azure.com", PortNumber: 3306},
+	}
+
+	roundTrip := func(apiVersion string, contents DatastoreContents) *Datastore {
+		converter := datastoreConverter{apiVersion}
+		datastore := &Datastore{Name: "datastore", Contents: contents}
+		a.Nil(converter.validateDatastore(datastore))
+		properties, err := json.Marshal(converter.toWriteDatastoreSchema(datastore, "core.windows.net").Properties)
+		a.Nil(err)
+		return converter.unmarshalDatastore([]byte(fmt.Sprintf(`{"name": "datastore", "properties": %s}`, properties)))
+	}
+	for _, contents := range append(storageContents, databaseContents...) {
+		a.Equal(contents, roundTrip(ApiVersion20210301Preview, contents).Contents, contents.DatastoreType())
+	}
+	for _, contents := range storageContents {
+		a.Equal(contents, roundTrip(ApiVersion20220501, contents).Contents, contents.DatastoreType())
+	}
+
+	// The legacy fields are still set when reading the datastores
+	datastore := roundTrip(ApiVersion20220501, storageContents[1])
+	a.Equal("AzureDataLakeGen2", datastore.StorageType)
+	a.Equal("account", datastore.StorageAccountName)
+	a.Equal("filesystem", datastore.StorageContainerName)
+}
+
+func TestToWriteDatastoreSchema_ContentsDefaults(t *testing.T) {
+	a := assert.New(t)
+
+	schema := toWriteDatastoreSchemaWithEndpoint(&Datastore{Contents: AzureFileContents{AccountName: "account", FileShareName: "share"}}, "core.chinacloudapi.cn")
+	contents := schema.Properties.(WriteDatastoreSchemaProperties).Contents
+	a.Equal(WriteDatastoreSchema{
+		ContentsType:         "AzureFile",
+		StorageAccountName:   "account",
+		StorageContainerName: "share",
+		Endpoint:             "core.chinacloudapi.cn",
+		Protocol:             "https",
+	}, contents)
+
+	schema = toWriteDatastoreSchemaWithEndpoint(&Datastore{Contents: AzurePostgreSqlContents{ServerName: "server", DatabaseName: "db"}}, "core.windows.net")
+	contents = schema.Properties.(WriteDatastoreSchemaProperties).Contents
+	a.Equal("postgres.database.azure.com", contents.Endpoint)
+	a.Equal(5432, contents.PortNumber)
+	a.False(*contents.EnableSsl)
+	a.Empty(contents.Protocol)
+	a.Empty(contents.StorageAccountName)
+}
+
+func TestDatastoreConverter_ValidateDatastore(t *testing.T) {
+	a := assert.New(t)
+	preview, ga := datastoreConverter{ApiVersion20210301Preview}, datastoreConverter{ApiVersion20220501}
+
+	a.Nil(ga.validateDatastore(&Datastore{StorageType: "AzureSqlDatabase"}))
+	a.Equal(
+		InvalidArgumentError{"the filesystem of the AzureDataLakeGen2 datastore cannot be empty"},
+		preview.validateDatastore(&Datastore{Contents: AzureDataLakeGen2Contents{AccountName: "account"}}),
+	)
+	a.Equal(
+		InvalidArgumentError{"the store name of the AzureDataLakeGen1 datastore cannot be empty"},
+		preview.validateDatastore(&Datastore{Contents: AzureDataLakeGen1Contents{StoreName: " "}}),
+	)
+	a.Equal(
+		InvalidArgumentError{"unknown storage type \"Foo\" of the datastore"},
+		preview.validateDatastore(&Datastore{StorageType: "Foo"}),
+	)
+	a.Equal(
+		InvalidArgumentError{"the AzureBlob contents cannot be changed to the storage type AzureSqlDatabase, set Contents instead"},
+		preview.validateDatastore(&Datastore{Contents: AzureBlobContents{AccountName: "account", ContainerName: "container"}, StorageType: "AzureSqlDatabase"}),
+	)
+	a.Equal(
+		InvalidArgumentError{"invalid port number 70000 of the AzureMySql datastore"},
+		preview.validateDatastore(&Datastore{Contents: AzureMySqlContents{ServerName: "server", DatabaseName: "db", PortNumber: 70000}}),
+	)
+
+	sqlDatastore := &Datastore{Contents: AzureSqlDatabaseContents{ServerName: "server", DatabaseName: "db"}}
+	a.Nil(preview.validateDatastore(sqlDatastore))
+	a.Equal(
+		InvalidArgumentError{"AzureSqlDatabase datastores are not supported with API version 2022-05-01"},
+		ga.validateDatastore(sqlDatastore),
+	)
+}
+
+func TestDatastoreCredentials_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	credentials := []DatastoreCredentials{
+		AccountKeyCredentials{AccountKey: "key"},
+		SasCredentials{SasToken: "token"},
+		ServicePrincipalCredentials{TenantId: "tenant", ClientId: "client", ClientSecret: "secret", AuthorityUrl: "https://login.microsoftonline.com"},
+		CertificateCredentials{TenantId: "tenant", ClientId: "client", Thumbprint: "thumbprint", Certificate: "certificate", ResourceUrl: "https://storage.azure.com/"},
+		SqlAdminCredentials{UserId: "admin", Password: "password"},
+		NoCredentials{},
+	}
+
+	for _, apiVersion := range []string{ApiVersion20210301Preview, ApiVersion20220501} {
+		converter := datastoreConverter{apiVersion}
+		for _, c := range credentials {
+			datastore := &Datastore{Name: "datastore", Contents: AzureBlobContents{AccountName: "account", ContainerName: "container"}, Credentials: c}
+			properties, err := json.Marshal(converter.toWriteDatastoreSchema(datastore, "core.windows.net").Properties)
+			a.Nil(err)
+			result := converter.unmarshalDatastore([]byte(fmt.Sprintf(`{"name": "datastore", "properties": %s}`, properties)))
+			expected := c
+			if c == (NoCredentials{}) {
+				// No secrets to read back, the credentials are described by Auth
+				expected = nil
+			}
+			a.Equal(expected, result.Credentials, "%s %s", apiVersion, c.CredentialsType())
+		}
+	}
+}
+
+func TestToWriteDatastoreCredentialsSchema(t *testing.T) {
+	a := assert.New(t)
+
+	// The secrets type matches the credentials, whatever other field is set
+	schema := toWriteDatastoreCredentialsSchema(&Datastore{Credentials: SasCredentials{SasToken: "token"}})
+	a.Equal(&WriteDatastoreCredentialsSchema{
+		CredentialsType: "Sas",
+		Secrets:         &WriteDatastoreSecretsSchema{SecretsType: "Sas", SasToken: "token"},
+	}, schema)
+
+	schema = toWriteDatastoreCredentialsSchema(&Datastore{Credentials: NoCredentials{}})
+	a.Equal(&WriteDatastoreCredentialsSchema{CredentialsType: "None"}, schema)
+
+	// The typed credentials take precedence over the legacy auth
+	schema = toWriteDatastoreCredentialsSchema(&Datastore{
+		Credentials: AccountKeyCredentials{AccountKey: "key"},
+		Auth:        &DatastoreAuth{CredentialsType: "ServicePrincipal", ClientId: "client"},
+	})
+	a.Equal("AccountKey", schema.CredentialsType)
+	a.Empty(schema.ClientId)
+
+	// The legacy auth is converted according to its credentials type
+	schema = toWriteDatastoreCredentialsSchema(&Datastore{Auth: &DatastoreAuth{
+		CredentialsType: "SqlAdmin",
+		SqlUserName:     "admin",
+		SqlUserPassword: "password",
+		AccountKey:      "key",
+	}})
+	a.Equal(&WriteDatastoreCredentialsSchema{
+		CredentialsType: "SqlAdmin",
+		Secrets:         &WriteDatastoreSecretsSchema{SecretsType: "SqlAdmin", SqlUserPassword: "password"},
+		SqlUserName:     "admin",
+	}, schema)
+
+	a.Nil(toWriteDatastoreCredentialsSchema(&Datastore{}))
+}
+
+func TestDatastoreConverter_ValidateCredentials(t *testing.T) {
+	a := assert.New(t)
+	preview, ga := datastoreConverter{ApiVersion20210301Preview}, datastoreConverter{ApiVersion20220501}
+
+	testCases := map[string]struct {
+		credentials DatastoreCredentials
+		expected    error
+	}{
+		"account key":       {AccountKeyCredentials{}, InvalidArgumentError{"the account key of the AccountKey credentials cannot be empty"}},
+		"SAS token":         {SasCredentials{SasToken: " "}, InvalidArgumentError{"the SAS token of the Sas credentials cannot be empty"}},
+		"service principal": {ServicePrincipalCredentials{ClientId: "client", ClientSecret: "secret"}, InvalidArgumentError{"the tenant ID of the ServicePrincipal credentials cannot be empty"}},
+		"certificate":       {CertificateCredentials{TenantId: "tenant", ClientId: "client", Thumbprint: "thumbprint"}, InvalidArgumentError{"the certificate of the Certificate credentials cannot be empty"}},
+		"SQL admin":         {SqlAdminCredentials{UserId: "admin"}, InvalidArgumentError{"the password of the SqlAdmin credentials cannot be empty"}},
+		"none":              {NoCredentials{}, nil},
+	}
+	for name, tc := range testCases {
+		a.Equal(tc.expected, preview.validateDatastore(&Datastore{Credentials: tc.credentials}), name)
+	}
+
+	a.Equal(
+		InvalidArgumentError{"the account key of the AccountKey credentials cannot be empty"},
+		preview.validateDatastore(&Datastore{Auth: &DatastoreAuth{CredentialsType: "AccountKey"}}),
+	)
+	a.Nil(preview.validateDatastore(&Datastore{Auth: &DatastoreAuth{}}))
+
+	sqlAdmin := &Datastore{Credentials: SqlAdminCredentials{UserId: "admin", Password: "password"}}
+	a.Nil(preview.validateDatastore(sqlAdmin))
+	a.Equal(InvalidArgumentError{"SqlAdmin credentials are not supported with API version 2022-05-01"}, ga.validateDatastore(sqlAdmin))
+}
+
+func TestDatastore_Contents(t *testing.T) {
+	a := assert.New(t)
+	blob := AzureBlobContents{AccountName: "account", ContainerName: "container", Endpoint: "core.windows.net"}
+
+	a.Nil((&Datastore{StorageType: "AzureBlob"}).contents())
+	a.Equal(blob, (&Datastore{Contents: blob}).contents())
+	a.Equal(blob, (&Datastore{Contents: blob, StorageType: "AzureBlob", StorageAccountName: "account"}).contents())
+	a.Equal(
+		AzureBlobContents{AccountName: "other", ContainerName: "container", Endpoint: "core.windows.net"},
+		(&Datastore{Contents: blob, StorageAccountName: "other"}).contents(),
+	)
+	a.Equal(
+		AzureDataLakeGen2Contents{AccountName: "account", Filesystem: "container", Endpoint: "core.windows.net"},
+		(&Datastore{Contents: blob, StorageType: "AzureDataLakeGen2"}).contents(),
+	)
+	a.Equal(AzureDataLakeGen1Contents{StoreName: "other"}, (&Datastore{Contents: AzureDataLakeGen1Contents{StoreName: "store"}, StorageAccountName: "other"}).contents())
+
+	sql := AzureSqlDatabaseContents{ServerName: "server", DatabaseName: "db"}
+	a.Equal(sql, (&Datastore{Contents: sql, StorageAccountName: "account"}).contents())
+	a.Nil((&Datastore{Contents: blob, StorageType: "Unknown"}).contents())
+
+	// Only the fields changed since the datastore was read take precedence over the contents
+	read := &Datastore{Contents: blob}
+	read.setStorageFields()
+	read.Contents = AzureFileContents{AccountName: "other", FileShareName: "share"}
+	a.Equal(read.Contents, read.contents())
+	read.StorageContainerName = "other-share"
+	a.Equal(AzureFileContents{AccountName: "other", FileShareName: "other-share"}, read.contents())
+}
@@ -0,0 +1,463 @@
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/confidential"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAuthorityHost    = "https://login.microsoftonline.com/"
+	defaultImdsEndpoint     = "http://169.254.169.254/metadata/identity/oauth2/token"
+	imdsApiVersion          = "2018-02-01"
+	tokenRefreshOffset      = 5 * time.Minute
+	azureCliCommandTimeout  = 30 * time.Second
+	azureCliExpiresOnFormat = "2006-01-02 15:04:05.999999"
+)
+
+// AccessToken An access token used for authenticating the requests to the Azure APIs.
+type AccessToken struct {
+	Token     string
+	ExpiresOn time.Time
+}
+
+// TokenCredential Provide the access tokens used for authenticating the requests to the Azure APIs.
+type TokenCredential interface {
+	// GetToken Return an access token valid for the scopes provided as argument
+	GetToken(ctx context.Context, scopes []string) (AccessToken, error)
+}
+
+// CredentialOptions Options common to the credentials authenticating with Azure Active Directory.
+type CredentialOptions struct {
+	// AuthorityHost The host of the Azure Active Directory authority. If empty, the authority of the Azure public
+	// cloud is used.
+	AuthorityHost string
+}
+
+func (o *CredentialOptions) authority(tenantId string) string {
+	host := defaultAuthorityHost
+	if o != nil && o.AuthorityHost != "" {
+		host = o.AuthorityHost
+	}
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(host, "/"), tenantId)
+}
+
+// CredentialUnavailableError Returned by a credential that cannot be used in the current environment,
+// for instance because the configuration it requires is missing.
+type CredentialUnavailableError struct {
+	credentialType string
+	message        string
+}
+
+func (e CredentialUnavailableError) Error() string {
+	return fmt.Sprintf("%s unavailable: %s", e.credentialType, e.message)
+}
+
+// msalCredential Acquire tokens with the client credential flow using an MSAL confidential client.
+type msalCredential struct {
+	client confidential.Client
+}
+
+func newMsalCredential(tenantId, clientId string, credential confidential.Credential, options *CredentialOptions) (*msalCredential, error) {
+	client, err := confidential.New(clientId, credential, confidential.WithAuthority(options.authority(tenantId)))
+	if err != nil {
+		return nil, err
+	}
+	return &msalCredential{client: client}, nil
+}
+
+func (c *msalCredential) GetToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	authResult, err := c.client.AcquireTokenSilent(ctx, scopes)
+	if err != nil {
+		authResult, err = c.client.AcquireTokenByCredential(ctx, scopes)
+	}
+	if err != nil {
+		return AccessToken{}, err
+	}
+	return AccessToken{Token: authResult.AccessToken, ExpiresOn: authResult.ExpiresOn}, nil
+}
+
+// ClientSecretCredential Authenticate a Service Principal using a client secret.
+type ClientSecretCredential struct {
+	*msalCredential
+}
+
+func NewClientSecretCredential(tenantId, clientId, clientSecret string, options *CredentialOptions) (*ClientSecretCredential, error) {
+	credential, err := confidential.NewCredFromSecret(clientSecret)
+	if err != nil {
+		return nil, err
+	}
+	c, err := newMsalCredential(tenantId, clientId, credential, options)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientSecretCredential{c}, nil
+}
+
+// ClientCertificateCredential Authenticate a Service Principal using a certificate.
+type ClientCertificateCredential struct {
+	*msalCredential
+}
+
+// NewClientCertificateCredential Create a credential from a PEM encoded certificate, which must contain both the
+// certificate and its private key. The password is used for decrypting the private key, if encrypted.
+func NewClientCertificateCredential(tenantId, clientId string, certificate []byte, password string, options *CredentialOptions) (*ClientCertificateCredential, error) {
+	certs, key, err := confidential.CertFromPEM(certificate, password)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found in the PEM data")
+	}
+	c, err := newMsalCredential(tenantId, clientId, confidential.NewCredFromCert(certs[0], key), options)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientCertificateCredential{c}, nil
+}
+
+// ClientAssertionCredential Authenticate a Service Principal using a signed client assertion, such as a token
+// issued by an external identity provider trusted through a federated identity credential.
+type ClientAssertionCredential struct {
+	tenantId     string
+	clientId     string
+	getAssertion func(ctx context.Context) (string, error)
+	options      *CredentialOptions
+	cache        *tokenCache
+}
+
+// NewClientAssertionCredential Create a credential calling getAssertion every time a new token needs to be
+// acquired, so that short-lived assertions can be refreshed.
+func NewClientAssertionCredential(tenantId, clientId string, getAssertion func(ctx context.Context) (string, error), options *CredentialOptions) *ClientAssertionCredential {
+	c := &ClientAssertionCredential{
+		tenantId:     tenantId,
+		clientId:     clientId,
+		getAssertion: getAssertion,
+		options:      options,
+	}
+	c.cache = newTokenCache(c.acquireToken)
+	return c
+}
+
+func (c *ClientAssertionCredential) GetToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	return c.cache.getToken(ctx, scopes)
+}
+
+func (c *ClientAssertionCredential) acquireToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	assertion, err := c.getAssertion(ctx)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	credential, err := confidential.NewCredFromAssertion(assertion)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	msal, err := newMsalCredential(c.tenantId, c.clientId, credential, c.options)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	return msal.GetToken(ctx, scopes)
+}
+
+// NewWorkloadIdentityCredential Create a credential using the federated token written in the file provided as
+// argument, as done for instance by the Azure Workload Identity webhook on Kubernetes. The file is read again every
+// time a new token needs to be acquired.
+func NewWorkloadIdentityCredential(tenantId, clientId, tokenFilePath string, options *CredentialOptions) *ClientAssertionCredential {
+	return NewClientAssertionCredential(tenantId, clientId, func(_ context.Context) (string, error) {
+		content, err := ioutil.ReadFile(tokenFilePath)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	}, options)
+}
+
+// ManagedIdentityCredentialOptions Options of the ManagedIdentityCredential.
+type ManagedIdentityCredentialOptions struct {
+	// ClientId The client ID of the user-assigned managed identity. If empty, the system-assigned identity is used.
+	ClientId string
+
+	// Endpoint The token endpoint of the Instance Metadata Service. If empty, the default IMDS endpoint is used.
+	Endpoint string
+
+	// HttpClient The client used for contacting the endpoint. If nil, a client with a short timeout is used.
+	HttpClient *http.Client
+}
+
+// ManagedIdentityCredential Authenticate with the managed identity of the Azure resource the code is running on,
+// using the Instance Metadata Service (IMDS).
+type ManagedIdentityCredential struct {
+	clientId   string
+	endpoint   string
+	httpClient *http.Client
+	cache      *tokenCache
+}
+
+func NewManagedIdentityCredential(options *ManagedIdentityCredentialOptions) *ManagedIdentityCredential {
+	if options == nil {
+		options = &ManagedIdentityCredentialOptions{}
+	}
+	c := &ManagedIdentityCredential{
+		clientId:   options.ClientId,
+		endpoint:   options.Endpoint,
+		httpClient: options.HttpClient,
+	}
+	if c.endpoint == "" {
+		c.endpoint = defaultImdsEndpoint
+	}
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	c.cache = newTokenCache(c.acquireToken)
+	return c
+}
+
+func (c *ManagedIdentityCredential) GetToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	return c.cache.getToken(ctx, scopes)
+}
+
+func (c *ManagedIdentityCredential) acquireToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	resource, err := scopesToResource(scopes)
+	if err != nil {
+		return AccessToken{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.endpoint, nil)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	q := req.URL.Query()
+	q.Set("api-version", imdsApiVersion)
+	q.Set("resource", resource)
+	if c.clientId != "" {
+		q.Set("client_id", c.clientId)
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Metadata", "true")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return AccessToken{}, CredentialUnavailableError{"ManagedIdentityCredential", err.Error()}
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return AccessToken{}, newHttpResponseError(resp, body)
+	}
+
+	var tokenResp struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresOn   json.Number `json:"expires_on"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return AccessToken{}, err
+	}
+	expiresOn, err := tokenResp.ExpiresOn.Int64()
+	if err != nil {
+		return AccessToken{}, fmt.Errorf("invalid token expiration %q: %w", tokenResp.ExpiresOn, err)
+	}
+	return AccessToken{Token: tokenResp.AccessToken, ExpiresOn: time.Unix(expiresOn, 0)}, nil
+}
+
+// AzureCLICredential Authenticate with the account currently logged in the Azure CLI, using its token cache.
+type AzureCLICredential struct {
+	tenantId   string
+	runCommand func(ctx context.Context, name string, args ...string) ([]byte, error)
+	cache      *tokenCache
+}
+
+// NewAzureCLICredential Create a credential requesting the tokens to the Azure CLI. If tenantId is empty, the
+// tenant of the CLI default subscription is used.
+func NewAzureCLICredential(tenantId string) *AzureCLICredential {
+	c := &AzureCLICredential{
+		tenantId:   tenantId,
+		runCommand: runCommand,
+	}
+	c.cache = newTokenCache(c.acquireToken)
+	return c
+}
+
+func (c *AzureCLICredential) GetToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	return c.cache.getToken(ctx, scopes)
+}
+
+func (c *AzureCLICredential) acquireToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	resource, err := scopesToResource(scopes)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	args := []string{"account", "get-access-token", "--output", "json", "--resource", resource}
+	if c.tenantId != "" {
+		args = append(args, "--tenant", c.tenantId)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, azureCliCommandTimeout)
+	defer cancel()
+	output, err := c.runCommand(ctx, "az", args...)
+	if err != nil {
+		return AccessToken{}, CredentialUnavailableError{"AzureCLICredential", err.Error()}
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"accessToken"`
+		ExpiresOn   string `json:"expiresOn"`
+		ExpiresOnTs int64  `json:"expires_on"`
+	}
+	if err := json.Unmarshal(output, &tokenResp); err != nil {
+		return AccessToken{}, err
+	}
+
+	var expiresOn time.Time
+	if tokenResp.ExpiresOnTs > 0 {
+		expiresOn = time.Unix(tokenResp.ExpiresOnTs, 0)
+	} else {
+		// Older versions of the CLI only return the expiration in local time
+		expiresOn, err = time.ParseInLocation(azureCliExpiresOnFormat, tokenResp.ExpiresOn, time.Local)
+		if err != nil {
+			return AccessToken{}, fmt.Errorf("invalid token expiration %q: %w", tokenResp.ExpiresOn, err)
+		}
+	}
+	return AccessToken{Token: tokenResp.AccessToken, ExpiresOn: expiresOn}, nil
+}
+
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	output, err := cmd.Output()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return nil, fmt.Errorf("%s: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return output, err
+}
+
+// NewEnvironmentCredential Create a Service Principal credential from the environment variables AZURE_TENANT_ID,
+// AZURE_CLIENT_ID and either AZURE_CLIENT_SECRET or AZURE_CLIENT_CERTIFICATE_PATH (with the optional
+// AZURE_CLIENT_CERTIFICATE_PASSWORD).
+func NewEnvironmentCredential(options *CredentialOptions) (TokenCredential, error) {
+	tenantId := os.Getenv("AZURE_TENANT_ID")
+	clientId := os.Getenv("AZURE_CLIENT_ID")
+	if tenantId == "" || clientId == "" {
+		return nil, CredentialUnavailableError{"EnvironmentCredential", "AZURE_TENANT_ID and AZURE_CLIENT_ID must be set"}
+	}
+	if secret := os.Getenv("AZURE_CLIENT_SECRET"); secret != "" {
+		return NewClientSecretCredential(tenantId, clientId, secret, options)
+	}
+	if certPath := os.Getenv("AZURE_CLIENT_CERTIFICATE_PATH"); certPath != "" {
+		cert, err := ioutil.ReadFile(certPath)
+		if err != nil {
+			return nil, err
+		}
+		return NewClientCertificateCredential(tenantId, clientId, cert, os.Getenv("AZURE_CLIENT_CERTIFICATE_PASSWORD"), options)
+	}
+	return nil, CredentialUnavailableError{"EnvironmentCredential", "neither AZURE_CLIENT_SECRET nor AZURE_CLIENT_CERTIFICATE_PATH is set"}
+}
+
+// ChainedTokenCredential Try in order a list of credentials, until one of them provides a token. The first
+// credential providing a token is then always used.
+type ChainedTokenCredential struct {
+	sources []TokenCredential
+
+	mu         sync.Mutex
+	successful TokenCredential
+}
+
+func NewChainedTokenCredential(sources ...TokenCredential) *ChainedTokenCredential {
+	return &ChainedTokenCredential{sources: sources}
+}
+
+func (c *ChainedTokenCredential) GetToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	c.mu.Lock()
+	successful := c.successful
+	c.mu.Unlock()
+	if successful != nil {
+		return successful.GetToken(ctx, scopes)
+	}
+
+	var errorMessages []string
+	for _, source := range c.sources {
+		token, err := source.GetToken(ctx, scopes)
+		if err == nil {
+			c.mu.Lock()
+			c.successful = source
+			c.mu.Unlock()
+			return token, nil
+		}
+		if ctx.Err() != nil {
+			return AccessToken{}, ctx.Err()
+		}
+		errorMessages = append(errorMessages, err.Error())
+	}
+	return AccessToken{}, CredentialUnavailableError{
+		"ChainedTokenCredential",
+		fmt.Sprintf("no credential provided a token: [%s]", strings.Join(errorMessages, "; ")),
+	}
+}
+
+// NewDefaultAzureCredential Create a credential trying in order: the Service Principal configured with the
+// environment variables, the workload identity (AZURE_FEDERATED_TOKEN_FILE), the managed identity and the Azure CLI.
+func NewDefaultAzureCredential(options *CredentialOptions) *ChainedTokenCredential {
+	var sources []TokenCredential
+
+	if envCredential, err := NewEnvironmentCredential(options); err == nil {
+		sources = append(sources, envCredential)
+	}
+
+	tenantId := os.Getenv("AZURE_TENANT_ID")
+	clientId := os.Getenv("AZURE_CLIENT_ID")
+	if tokenFile := os.Getenv("AZURE_FEDERATED_TOKEN_FILE"); tokenFile != "" && tenantId != "" && clientId != "" {
+		sources = append(sources, NewWorkloadIdentityCredential(tenantId, clientId, tokenFile, options))
+	}
+
+	sources = append(sources,
+		NewManagedIdentityCredential(&ManagedIdentityCredentialOptions{ClientId: clientId}),
+		NewAzureCLICredential(tenantId),
+	)
+	return NewChainedTokenCredential(sources...)
+}
+
+// tokenCache Cache the tokens acquired by a credential until they are about to expire.
+type tokenCache struct {
+	acquire func(ctx context.Context, scopes []string) (AccessToken, error)
+
+	mu     sync.Mutex
+	tokens map[string]AccessToken
+}
+
+func newTokenCache(acquire func(ctx context.Context, scopes []string) (AccessToken, error)) *tokenCache {
+	return &tokenCache{acquire: acquire, tokens: make(map[string]AccessToken)}
+}
+
+func (c *tokenCache) getToken(ctx context.Context, scopes []string) (AccessToken, error) {
+	key := strings.Join(scopes, " ")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if token, ok := c.tokens[key]; ok && time.Until(token.ExpiresOn) > tokenRefreshOffset {
+		return token, nil
+	}
+	token, err := c.acquire(ctx, scopes)
+	if err != nil {
+		return AccessToken{}, err
+	}
+	c.tokens[key] = token
+	return token, nil
+}
+
+// scopesToResource Convert a scope such as "https://management.azure.com/.default" to the respective resource,
+// as required by the endpoints implementing the v1 token protocol.
+func scopesToResource(scopes []string) (string, error) {
+	if len(scopes) != 1 {
+		return "", fmt.Errorf("exactly one scope is required, got %d", len(scopes))
+	}
+	return strings.TrimSuffix(scopes[0], "/.default"), nil
+}
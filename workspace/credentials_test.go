@@ -0,0 +1,196 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeTokenCredential struct {
+	token  AccessToken
+	err    error
+	calls  int
+	scopes []string
+}
+
+func (c *fakeTokenCredential) GetToken(_ context.Context, scopes []string) (AccessToken, error) {
+	c.calls++
+	c.scopes = scopes
+	return c.token, c.err
+}
+
+func TestManagedIdentityCredential(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	scopes := []string{DefaultAmlOauthScope}
+	expiresOn := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get token from IMDS, cached across calls",
+			testCase: func() {
+				var requests []*http.Request
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests = append(requests, r)
+					_, _ = fmt.Fprintf(w, "{\"access_token\": \"token\", \"expires_on\": \"%d\", \"token_type\": \"Bearer\"}", expiresOn)
+				}))
+				defer server.Close()
+
+				credential := NewManagedIdentityCredential(&ManagedIdentityCredentialOptions{
+					ClientId: "client-id",
+					Endpoint: server.URL,
+				})
+				token, err := credential.GetToken(context.Background(), scopes)
+				a.Nil(err)
+				a.Equal("token", token.Token)
+				a.Equal(expiresOn, token.ExpiresOn.Unix())
+
+				_, err = credential.GetToken(context.Background(), scopes)
+				a.Nil(err)
+				a.Len(requests, 1)
+				a.Equal("true", requests[0].Header.Get("Metadata"))
+				a.Equal("https://management.azure.com", requests[0].URL.Query().Get("resource"))
+				a.Equal("client-id", requests[0].URL.Query().Get("client_id"))
+				a.Equal(imdsApiVersion, requests[0].URL.Query().Get("api-version"))
+			},
+		},
+		{
+			testCaseName: "Test get token from IMDS, error response",
+			testCase: func() {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte("{\"error\": \"invalid_request\"}"))
+				}))
+				defer server.Close()
+
+				credential := NewManagedIdentityCredential(&ManagedIdentityCredentialOptions{Endpoint: server.URL})
+				_, err := credential.GetToken(context.Background(), scopes)
+				var respErr *HttpResponseError
+				a.True(errors.As(err, &respErr))
+				a.Equal(http.StatusBadRequest, respErr.StatusCode)
+			},
+		},
+		{
+			testCaseName: "Test get token from IMDS, endpoint unreachable",
+			testCase: func() {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+				server.Close()
+
+				credential := NewManagedIdentityCredential(&ManagedIdentityCredentialOptions{Endpoint: server.URL})
+				_, err := credential.GetToken(context.Background(), scopes)
+				a.IsType(CredentialUnavailableError{}, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestAzureCLICredential(t *testing.T) {
+	a := assert.New(t)
+	scopes := []string{DefaultAmlOauthScope}
+
+	credential := NewAzureCLICredential("tenant")
+	var receivedArgs []string
+	credential.runCommand = func(_ context.Context, name string, args ...string) ([]byte, error) {
+		receivedArgs = append([]string{name}, args...)
+		return []byte("{\"accessToken\": \"token\", \"expiresOn\": \"2100-10-25 11:53:40.000000\"}"), nil
+	}
+	token, err := credential.GetToken(context.Background(), scopes)
+	a.Nil(err)
+	a.Equal("token", token.Token)
+	a.Equal(time.Date(2100, 10, 25, 11, 53, 40, 0, time.Local), token.ExpiresOn)
+	a.Equal([]string{"az", "account", "get-access-token", "--output", "json", "--resource", "https://management.azure.com", "--tenant", "tenant"}, receivedArgs)
+
+	credential = NewAzureCLICredential("")
+	credential.runCommand = func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+		return []byte("{\"accessToken\": \"token\", \"expiresOn\": \"invalid\", \"expires_on\": 4127000000}"), nil
+	}
+	token, err = credential.GetToken(context.Background(), scopes)
+	a.Nil(err)
+	a.Equal(int64(4127000000), token.ExpiresOn.Unix())
+
+	credential = NewAzureCLICredential("")
+	credential.runCommand = func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+		return nil, fmt.Errorf("az: command not found")
+	}
+	_, err = credential.GetToken(context.Background(), scopes)
+	a.IsType(CredentialUnavailableError{}, err)
+}
+
+func TestChainedTokenCredential(t *testing.T) {
+	a := assert.New(t)
+	failing := &fakeTokenCredential{err: fmt.Errorf("failing")}
+	working := &fakeTokenCredential{token: AccessToken{Token: "token"}}
+	notUsed := &fakeTokenCredential{token: AccessToken{Token: "other"}}
+
+	credential := NewChainedTokenCredential(failing, working, notUsed)
+	token, err := credential.GetToken(context.Background(), nil)
+	a.Nil(err)
+	a.Equal("token", token.Token)
+
+	token, err = credential.GetToken(context.Background(), nil)
+	a.Nil(err)
+	a.Equal("token", token.Token)
+	a.Equal(1, failing.calls)
+	a.Equal(2, working.calls)
+	a.Equal(0, notUsed.calls)
+
+	credential = NewChainedTokenCredential(failing)
+	_, err = credential.GetToken(context.Background(), nil)
+	a.IsType(CredentialUnavailableError{}, err)
+	a.Contains(err.Error(), "failing")
+}
+
+func TestNewEnvironmentCredential(t *testing.T) {
+	a := assert.New(t)
+	t.Setenv("AZURE_TENANT_ID", "")
+	t.Setenv("AZURE_CLIENT_ID", "")
+	_, err := NewEnvironmentCredential(nil)
+	a.IsType(CredentialUnavailableError{}, err)
+
+	t.Setenv("AZURE_TENANT_ID", "tenant")
+	t.Setenv("AZURE_CLIENT_ID", "client")
+	t.Setenv("AZURE_CLIENT_SECRET", "")
+	t.Setenv("AZURE_CLIENT_CERTIFICATE_PATH", "")
+	_, err = NewEnvironmentCredential(nil)
+	a.IsType(CredentialUnavailableError{}, err)
+
+	t.Setenv("AZURE_CLIENT_SECRET", "secret")
+	credential, err := NewEnvironmentCredential(nil)
+	a.Nil(err)
+	a.IsType(&ClientSecretCredential{}, credential)
+}
+
+func TestNewClientCertificateCredential_InvalidPem(t *testing.T) {
+	credential, err := NewClientCertificateCredential("tenant", "client", []byte("invalid"), "", nil)
+	assert.Nil(t, credential)
+	assert.NotNil(t, err)
+}
+
+func TestNewClientWithCredential(t *testing.T) {
+	a := assert.New(t)
+	credential := &fakeTokenCredential{token: AccessToken{Token: "token"}}
+
+	client, err := New(Config{Credential: credential}, false)
+	a.Nil(err)
+	a.NotEmpty(client)
+
+	httpClient := client.httpClientBuilder.newClient("rg", "ws").(*HttpClient)
+	jwt, err := httpClient.getJwt(context.Background())
+	a.Nil(err)
+	a.Equal("token", jwt)
+}
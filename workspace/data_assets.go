@@ -0,0 +1,167 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func (w *Workspace) GetDataAssets(resourceGroup, workspace string) ([]DataAsset, error) {
+	return w.GetDataAssetsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetDataAssetsWithContext(ctx context.Context, resourceGroup, workspace string) ([]DataAsset, error) {
+	pager := w.NewDataAssetPager(resourceGroup, workspace)
+	result := make([]DataAsset, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewDataAssetPager Return a pager for iterating page by page over the data assets of the workspace. For each
+// data asset, only its latest version is returned.
+func (w *Workspace) NewDataAssetPager(resourceGroup, workspace string) *DataAssetPager {
+	return &DataAssetPager{
+		pager: newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "data"),
+		unmarshal: func(ctx context.Context, body []byte) ([]DataAsset, error) {
+			return w.getLatestDataAssets(ctx, resourceGroup, workspace, unmarshalAssetContainers(body))
+		},
+	}
+}
+
+func (w *Workspace) GetDataAssetVersions(resourceGroup, workspace, name string) ([]DataAsset, error) {
+	return w.GetDataAssetVersionsWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetDataAssetVersionsWithContext(ctx context.Context, resourceGroup, workspace, name string) ([]DataAsset, error) {
+	pager := w.NewDataAssetVersionPager(resourceGroup, workspace, name)
+	result := make([]DataAsset, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewDataAssetVersionPager Return a pager for iterating page by page over the versions of the data asset
+// with the name provided as argument.
+func (w *Workspace) NewDataAssetVersionPager(resourceGroup, workspace, name string) *DataAssetVersionPager {
+	path := fmt.Sprintf("data/%s/versions", name)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"data asset", name}
+	return newTypedPager(pager, func(body []byte) []DataAsset {
+		return unmarshalDataAssetArray(name, body)
+	})
+}
+
+func (w *Workspace) GetDataAsset(resourceGroup, workspace, name, version string) (*DataAsset, error) {
+	return w.GetDataAssetWithContext(context.Background(), resourceGroup, workspace, name, version)
+}
+
+func (w *Workspace) GetDataAssetWithContext(ctx context.Context, resourceGroup, workspace, name, version string) (*DataAsset, error) {
+	path := fmt.Sprintf("data/%s/versions/%s", name, version)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"data asset", assetVersionIdentifier(name, version)})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalDataAsset(name, body), nil
+}
+
+func (w *Workspace) CreateOrUpdateDataAsset(resourceGroup, workspace string, dataAsset *DataAsset) (*DataAsset, error) {
+	return w.CreateOrUpdateDataAssetWithContext(context.Background(), resourceGroup, workspace, dataAsset)
+}
+
+func (w *Workspace) CreateOrUpdateDataAssetWithContext(ctx context.Context, resourceGroup, workspace string, dataAsset *DataAsset) (*DataAsset, error) {
+	if err := validateDataAsset(dataAsset); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("data/%s/versions/%s", dataAsset.Name, dataAsset.Version)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteDataAssetSchema(dataAsset))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalDataAsset(dataAsset.Name, body), nil
+}
+
+func (w *Workspace) DeleteDataAsset(resourceGroup, workspace, name string) error {
+	return w.DeleteDataAssetWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) DeleteDataAssetWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	path := fmt.Sprintf("data/%s", name)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"data asset", name})
+}
+
+func (w *Workspace) DeleteDataAssetVersion(resourceGroup, workspace, name, version string) error {
+	return w.DeleteDataAssetVersionWithContext(context.Background(), resourceGroup, workspace, name, version)
+}
+
+func (w *Workspace) DeleteDataAssetVersionWithContext(ctx context.Context, resourceGroup, workspace, name, version string) error {
+	path := fmt.Sprintf("data/%s/versions/%s", name, version)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"data asset", assetVersionIdentifier(name, version)})
+}
+
+// getLatestDataAssets Return the latest versions of the data assets contained in the containers provided as argument.
+// The containers without a latest version, and the ones whose latest version is deleted while they are retrieved,
+// are skipped.
+func (w *Workspace) getLatestDataAssets(ctx context.Context, resourceGroup, workspace string, containers []assetContainer) ([]DataAsset, error) {
+	dataAssets := make([]*DataAsset, len(containers))
+	err := forEachConcurrently(ctx, len(containers), func(ctx context.Context, i int) error {
+		if containers[i].latestVersion == "" {
+			return nil
+		}
+		dataAsset, err := w.GetDataAssetWithContext(ctx, resourceGroup, workspace, containers[i].name, containers[i].latestVersion)
+		if errors.Is(err, ErrNotFound) {
+			w.logger.Warnf("skipping data asset %q: latest version %q not found", containers[i].name, containers[i].latestVersion)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dataAssets[i] = dataAsset
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]DataAsset, 0, len(dataAssets))
+	for _, dataAsset := range dataAssets {
+		if dataAsset != nil {
+			result = append(result, *dataAsset)
+		}
+	}
+	return result, nil
+}
+
+func validateDataAsset(dataAsset *DataAsset) error {
+	if strings.TrimSpace(dataAsset.Name) == "" {
+		return InvalidArgumentError{"the data asset name cannot be empty"}
+	}
+	if strings.TrimSpace(dataAsset.Version) == "" {
+		return InvalidArgumentError{"the data asset version cannot be empty"}
+	}
+	if strings.TrimSpace(dataAsset.DataUri) == "" {
+		return InvalidArgumentError{"the data asset URI cannot be empty"}
+	}
+	switch dataAsset.DataType {
+	case DataTypeUriFile, DataTypeUriFolder, DataTypeMLTable:
+		return nil
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid data type %q", dataAsset.DataType)}
+	}
+}
+
+// assetVersionIdentifier Return the identifier of a specific version of an asset, in the format <name>:<version>
+func assetVersionIdentifier(name, version string) string {
+	return fmt.Sprintf("%s:%s", name, version)
+}
@@ -0,0 +1,248 @@
+package workspace
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+func getMockedDataAsset() *DataAsset {
+	return &DataAsset{
+		Name:     "dataset",
+		Version:  "3",
+		DataType: DataTypeUriFile,
+		DataUri:  "azureml://datastores/datastore-1/paths/foo/bar.csv",
+		Tags:     map[string]string{"team": "ml"},
+	}
+}
+
+func TestUnmarshalDataAssetArray(t *testing.T) {
+	a := assert.New(t)
+
+	dataAssets := unmarshalDataAssetArray("table", loadExampleResp("example_resp_get_data_versions.json"))
+	a.Len(dataAssets, 1)
+	a.Equal("table", dataAssets[0].Name)
+	a.Equal("1", dataAssets[0].Version)
+	a.Equal("table", dataAssets[0].Description)
+	a.Equal(DataTypeMLTable, dataAssets[0].DataType)
+	a.Equal("azureml://datastores/datastore-1/paths/table/", dataAssets[0].DataUri)
+	a.Equal(map[string]string{"team": "ml"}, dataAssets[0].Tags)
+	a.Equal(map[string]string{"format": "parquet"}, dataAssets[0].Properties)
+	a.True(dataAssets[0].IsArchived)
+	a.Equal("creationUser", dataAssets[0].SystemData.CreationUser)
+}
+
+func TestWorkspace_GetDataAssets(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get data assets returns latest versions",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "data").Return(http.StatusOK, string(loadExampleResp("example_resp_get_data_containers.json")), nil)
+				mockedHttpClient.On("doGet", "data/dataset/versions/3").Return(http.StatusOK, string(loadExampleResp("example_resp_get_data_version.json")), nil)
+				mockedHttpClient.On("doGet", "data/table/versions/1").Return(http.StatusOK, string(loadExampleResp("example_resp_get_data_version.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAssets, err := ws.GetDataAssets("rg", "ws")
+				a.Nil(err)
+				a.Len(dataAssets, 2)
+				a.Equal("dataset", dataAssets[0].Name)
+				a.Equal("table", dataAssets[1].Name)
+				a.Equal(DataTypeUriFile, dataAssets[0].DataType)
+			},
+		},
+		{
+			testCaseName: "Test get data assets error retrieving a version",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "data").Return(http.StatusOK, string(loadExampleResp("example_resp_get_data_containers.json")), nil)
+				mockedHttpClient.On("doGet", mock.Anything).Return(http.StatusInternalServerError, "error", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAssets, err := ws.GetDataAssets("rg", "ws")
+				a.Nil(dataAssets)
+				a.Equal(&HttpResponseError{StatusCode: http.StatusInternalServerError, ResponseContent: "error"}, err)
+			},
+		},
+		{
+			testCaseName: "Test get data assets skips containers without an available latest version",
+			testCase: func() {
+				containersResp := `{"value": [
+					{"name": "dataset", "properties": {"latestVersion": "3"}},
+					{"name": "empty", "properties": {}},
+					{"name": "deleted", "properties": {"latestVersion": "2"}}
+				]}`
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "data").Return(http.StatusOK, containersResp, nil)
+				mockedHttpClient.On("doGet", "data/dataset/versions/3").Return(http.StatusOK, string(loadExampleResp("example_resp_get_data_version.json")), nil)
+				mockedHttpClient.On("doGet", "data/deleted/versions/2").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAssets, err := ws.GetDataAssets("rg", "ws")
+				a.Nil(err)
+				a.Len(dataAssets, 1)
+				a.Equal("dataset", dataAssets[0].Name)
+				mockedHttpClient.AssertNotCalled(t, "doGet", "data/empty/versions/")
+			},
+		},
+		{
+			testCaseName: "Test get data asset versions not found",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "data/foo/versions").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAssets, err := ws.GetDataAssetVersions("rg", "ws", "foo")
+				a.Nil(dataAssets)
+				a.Equal(&ResourceNotFoundError{"data asset", "foo"}, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestWorkspace_GetDataAsset(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get data asset success",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "data/dataset/versions/3").Return(http.StatusOK, string(loadExampleResp("example_resp_get_data_version.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAsset, err := ws.GetDataAsset("rg", "ws", "dataset", "3")
+				a.Nil(err)
+				a.Equal("dataset", dataAsset.Name)
+				a.Equal("3", dataAsset.Version)
+				a.Equal("azureml://datastores/datastore-1/paths/foo/bar.csv", dataAsset.DataUri)
+				a.False(dataAsset.IsArchived)
+			},
+		},
+		{
+			testCaseName: "Test get data asset not found",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", mock.Anything).Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAsset, err := ws.GetDataAsset("rg", "ws", "dataset", "3")
+				a.Nil(dataAsset)
+				a.Equal(&ResourceNotFoundError{"data asset", "dataset:3"}, err)
+			},
+		},
+		{
+			testCaseName: "Test get data asset http client returns error",
+			testCase: func() {
+				mockedError := fmt.Errorf("error")
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", mock.Anything).Return(http.StatusOK, "", mockedError)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				dataAsset, err := ws.GetDataAsset("rg", "ws", "dataset", "3")
+				a.Nil(dataAsset)
+				a.Equal(mockedError, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestWorkspace_CreateOrUpdateDataAsset(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test create or update data asset with invalid fields",
+			testCase: func() {
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				invalidDataAssets := []*DataAsset{
+					{Name: " ", Version: "1", DataType: DataTypeUriFile, DataUri: "uri"},
+					{Name: "foo", DataType: DataTypeUriFile, DataUri: "uri"},
+					{Name: "foo", Version: "1", DataType: DataTypeUriFile},
+					{Name: "foo", Version: "1", DataType: "foo", DataUri: "uri"},
+				}
+				for _, dataAsset := range invalidDataAssets {
+					result, err := ws.CreateOrUpdateDataAsset("rg", "ws", dataAsset)
+					a.Nil(result)
+					a.IsType(InvalidArgumentError{}, err)
+				}
+			},
+		},
+		{
+			testCaseName: "Test create or update data asset success",
+			testCase: func() {
+				dataAsset := getMockedDataAsset()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "data/dataset/versions/3", toWriteDataAssetSchema(dataAsset)).Return(http.StatusCreated, string(loadExampleResp("example_resp_get_data_version.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateDataAsset("rg", "ws", dataAsset)
+				a.Nil(err)
+				a.Equal("dataset", result.Name)
+				a.Equal("3", result.Version)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test create or update data asset http response 400 bad request",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", mock.Anything, mock.Anything).Return(http.StatusBadRequest, "error", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateDataAsset("rg", "ws", getMockedDataAsset())
+				a.Nil(result)
+				a.Equal(&HttpResponseError{StatusCode: http.StatusBadRequest, ResponseContent: "error"}, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestWorkspace_DeleteDataAsset(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+
+	mockedHttpClient := new(MockedHttpClient)
+	mockedHttpClient.On("doDelete", "data/dataset").Return(http.StatusOK, "", nil)
+	mockedHttpClient.On("doDelete", "data/dataset/versions/1").Return(http.StatusNoContent, "", nil)
+	mockedHttpClient.On("doDelete", "data/dataset/versions/2").Return(http.StatusNotFound, "", nil)
+	mockedHttpClient.On("doDelete", "data/foo").Return(http.StatusInternalServerError, "error", nil)
+
+	ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+	a.Nil(ws.DeleteDataAsset("rg", "ws", "dataset"))
+	a.Nil(ws.DeleteDataAssetVersion("rg", "ws", "dataset", "1"))
+	a.Equal(&ResourceNotFoundError{"data asset", "dataset:2"}, ws.DeleteDataAssetVersion("rg", "ws", "dataset", "2"))
+	a.Equal(&HttpResponseError{StatusCode: http.StatusInternalServerError, ResponseContent: "error"}, ws.DeleteDataAsset("rg", "ws", "foo"))
+}
@@ -0,0 +1,285 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DatastoreType The type of the storage referenced by a datastore
+type DatastoreType string
+
+const (
+	DatastoreTypeAzureBlob         DatastoreType = "AzureBlob"
+	DatastoreTypeAzureDataLakeGen1 DatastoreType = "AzureDataLakeGen1"
+	DatastoreTypeAzureDataLakeGen2 DatastoreType = "AzureDataLakeGen2"
+	DatastoreTypeAzureFile         DatastoreType = "AzureFile"
+	DatastoreTypeAzureSqlDatabase  DatastoreType = "AzureSqlDatabase"
+	DatastoreTypeAzurePostgreSql   DatastoreType = "AzurePostgreSql"
+	DatastoreTypeAzureMySql        DatastoreType = "AzureMySql"
+)
+
+const (
+	defaultStorageProtocol = "https"
+
+	// defaultSqlDatabaseEndpoint, defaultPostgreSqlEndpoint, defaultMySqlEndpoint The endpoints of the database
+	// servers of the Azure public cloud, used if the contents of the datastores do not specify them
+	defaultSqlDatabaseEndpoint = "database.windows.net"
+	defaultPostgreSqlEndpoint  = "postgres.database.azure.com"
+	defaultMySqlEndpoint       = "mysql.database.azure.com"
+
+	defaultSqlDatabasePort = 1433
+	defaultPostgreSqlPort  = 5432
+	defaultMySqlPort       = 3306
+)
+
+// DatastoreContents The storage referenced by a datastore, one of AzureBlobContents, AzureDataLakeGen1Contents,
+// AzureDataLakeGen2Contents, AzureFileContents, AzureSqlDatabaseContents, AzurePostgreSqlContents and
+// AzureMySqlContents. DBFS datastores are not supported by the AML APIs used by the client.
+type DatastoreContents interface {
+	// DatastoreType Return the type of the datastores with these contents
+	DatastoreType() DatastoreType
+	validate() error
+}
+
+// AzureBlobContents A container of an Azure Blob storage account. If empty, Endpoint defaults to the storage
+// endpoint suffix of the cloud of the client, e.g. core.windows.net, and Protocol to https.
+type AzureBlobContents struct {
+	AccountName   string
+	ContainerName string
+	Endpoint      string
+	Protocol      string
+}
+
+func (c AzureBlobContents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzureBlob
+}
+
+func (c AzureBlobContents) validate() error {
+	return checkContentsFields(c.DatastoreType(), "account name", c.AccountName, "container name", c.ContainerName)
+}
+
+// AzureDataLakeGen2Contents A filesystem of an Azure Data Lake Storage Gen2 account. If empty, Endpoint defaults
+// to the storage endpoint suffix of the cloud of the client and Protocol to https.
+type AzureDataLakeGen2Contents struct {
+	AccountName string
+	Filesystem  string
+	Endpoint    string
+	Protocol    string
+}
+
+func (c AzureDataLakeGen2Contents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzureDataLakeGen2
+}
+
+func (c AzureDataLakeGen2Contents) validate() error {
+	return checkContentsFields(c.DatastoreType(), "account name", c.AccountName, "filesystem", c.Filesystem)
+}
+
+// AzureFileContents A file share of an Azure Files storage account. If empty, Endpoint defaults to the storage
+// endpoint suffix of the cloud of the client and Protocol to https.
+type AzureFileContents struct {
+	AccountName   string
+	FileShareName string
+	Endpoint      string
+	Protocol      string
+}
+
+func (c AzureFileContents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzureFile
+}
+
+func (c AzureFileContents) validate() error {
+	return checkContentsFields(c.DatastoreType(), "account name", c.AccountName, "file share name", c.FileShareName)
+}
+
+// AzureDataLakeGen1Contents An Azure Data Lake Storage Gen1 store
+type AzureDataLakeGen1Contents struct {
+	StoreName string
+}
+
+func (c AzureDataLakeGen1Contents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzureDataLakeGen1
+}
+
+func (c AzureDataLakeGen1Contents) validate() error {
+	return checkContentsFields(c.DatastoreType(), "store name", c.StoreName)
+}
+
+// AzureSqlDatabaseContents A database of an Azure SQL server. If empty, Endpoint defaults to database.windows.net
+// and PortNumber to 1433.
+type AzureSqlDatabaseContents struct {
+	ServerName   string
+	DatabaseName string
+	Endpoint     string
+	PortNumber   int
+}
+
+func (c AzureSqlDatabaseContents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzureSqlDatabase
+}
+
+func (c AzureSqlDatabaseContents) validate() error {
+	return checkDatabaseContents(c.DatastoreType(), c.ServerName, c.DatabaseName, c.PortNumber)
+}
+
+// AzurePostgreSqlContents A database of an Azure Database for PostgreSQL server. If empty, Endpoint defaults to
+// postgres.database.azure.com and PortNumber to 5432.
+type AzurePostgreSqlContents struct {
+	ServerName   string
+	DatabaseName string
+	Endpoint     string
+	PortNumber   int
+	EnableSsl    bool
+}
+
+func (c AzurePostgreSqlContents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzurePostgreSql
+}
+
+func (c AzurePostgreSqlContents) validate() error {
+	return checkDatabaseContents(c.DatastoreType(), c.ServerName, c.DatabaseName, c.PortNumber)
+}
+
+// AzureMySqlContents A database of an Azure Database for MySQL server. If empty, Endpoint defaults to
+// mysql.database.azure.com and PortNumber to 3306.
+type AzureMySqlContents struct {
+	ServerName   string
+	DatabaseName string
+	Endpoint     string
+	PortNumber   int
+}
+
+func (c AzureMySqlContents) DatastoreType() DatastoreType {
+	return DatastoreTypeAzureMySql
+}
+
+func (c AzureMySqlContents) validate() error {
+	return checkDatabaseContents(c.DatastoreType(), c.ServerName, c.DatabaseName, c.PortNumber)
+}
+
+// checkContentsFields Return an InvalidArgumentError if any of the fields provided as argument, as pairs of name
+// and value, is empty
+func checkContentsFields(datastoreType DatastoreType, namesAndValues ...string) error {
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		if strings.TrimSpace(namesAndValues[i+1]) == "" {
+			return InvalidArgumentError{fmt.Sprintf("the %s of the %s datastore cannot be empty", namesAndValues[i], datastoreType)}
+		}
+	}
+	return nil
+}
+
+func checkDatabaseContents(datastoreType DatastoreType, serverName, databaseName string, portNumber int) error {
+	if err := checkContentsFields(datastoreType, "server name", serverName, "database name", databaseName); err != nil {
+		return err
+	}
+	if portNumber < 0 || portNumber > 65535 {
+		return InvalidArgumentError{fmt.Sprintf("invalid port number %d of the %s datastore", portNumber, datastoreType)}
+	}
+	return nil
+}
+
+// isGaDatastoreType Return true if the datastores of the type provided as argument are supported by the GA APIs
+func isGaDatastoreType(datastoreType DatastoreType) bool {
+	switch datastoreType {
+	case DatastoreTypeAzureBlob, DatastoreTypeAzureDataLakeGen1, DatastoreTypeAzureDataLakeGen2, DatastoreTypeAzureFile:
+		return true
+	}
+	return false
+}
+
+// isKnownDatastoreType Return true if the datastore type provided as argument is one of the DatastoreType constants
+func isKnownDatastoreType(datastoreType DatastoreType) bool {
+	switch datastoreType {
+	case DatastoreTypeAzureBlob, DatastoreTypeAzureDataLakeGen1, DatastoreTypeAzureDataLakeGen2, DatastoreTypeAzureFile,
+		DatastoreTypeAzureSqlDatabase, DatastoreTypeAzurePostgreSql, DatastoreTypeAzureMySql:
+		return true
+	}
+	return false
+}
+
+// storageFieldValues The values of the deprecated storage fields of a datastore
+type storageFieldValues struct {
+	storageType   string
+	accountName   string
+	containerName string
+}
+
+// storageFields Return the values of the deprecated StorageType, StorageAccountName and StorageContainerName of
+// the datastores with the contents provided as argument
+func storageFields(contents DatastoreContents) (storageType, accountName, containerName string) {
+	storageType = string(contents.DatastoreType())
+	switch c := contents.(type) {
+	case AzureBlobContents:
+		return storageType, c.AccountName, c.ContainerName
+	case AzureDataLakeGen2Contents:
+		return storageType, c.AccountName, c.Filesystem
+	case AzureFileContents:
+		return storageType, c.AccountName, c.FileShareName
+	case AzureDataLakeGen1Contents:
+		return storageType, c.StoreName, ""
+	}
+	return storageType, "", ""
+}
+
+// setStorageFields Set the deprecated storage fields of a datastore read from the APIs from its contents, if known
+func (d *Datastore) setStorageFields() {
+	if d.Contents == nil {
+		return
+	}
+	d.StorageType, d.StorageAccountName, d.StorageContainerName = storageFields(d.Contents)
+	d.readStorage = storageFieldValues{d.StorageType, d.StorageAccountName, d.StorageContainerName}
+}
+
+// contents Return the contents of the datastore, updated with the deprecated StorageType, StorageAccountName and
+// StorageContainerName which are set, were changed since the datastore was read and differ from them, so that the
+// changes made through these fields to a retrieved datastore are not lost. Return nil if the datastore has no
+// contents, or if the updated storage type cannot be described by its contents.
+func (d *Datastore) contents() DatastoreContents {
+	if d.Contents == nil {
+		return nil
+	}
+	storageType, accountName, containerName := storageFields(d.Contents)
+	changed := false
+	if isChangedStorageField(d.StorageType, d.readStorage.storageType, storageType) {
+		storageType, changed = d.StorageType, true
+	}
+	if isChangedStorageField(d.StorageAccountName, d.readStorage.accountName, accountName) {
+		accountName, changed = d.StorageAccountName, true
+	}
+	if isChangedStorageField(d.StorageContainerName, d.readStorage.containerName, containerName) {
+		containerName, changed = d.StorageContainerName, true
+	}
+	if !changed {
+		return d.Contents
+	}
+
+	var endpoint, protocol string
+	switch c := d.Contents.(type) {
+	case AzureBlobContents:
+		endpoint, protocol = c.Endpoint, c.Protocol
+	case AzureDataLakeGen2Contents:
+		endpoint, protocol = c.Endpoint, c.Protocol
+	case AzureFileContents:
+		endpoint, protocol = c.Endpoint, c.Protocol
+	}
+	switch DatastoreType(storageType) {
+	case DatastoreTypeAzureBlob:
+		return AzureBlobContents{AccountName: accountName, ContainerName: containerName, Endpoint: endpoint, Protocol: protocol}
+	case DatastoreTypeAzureDataLakeGen2:
+		return AzureDataLakeGen2Contents{AccountName: accountName, Filesystem: containerName, Endpoint: endpoint, Protocol: protocol}
+	case DatastoreTypeAzureFile:
+		return AzureFileContents{AccountName: accountName, FileShareName: containerName, Endpoint: endpoint, Protocol: protocol}
+	case DatastoreTypeAzureDataLakeGen1:
+		return AzureDataLakeGen1Contents{StoreName: accountName}
+	}
+	if storageType == string(d.Contents.DatastoreType()) {
+		return d.Contents
+	}
+	return nil
+}
+
+// isChangedStorageField Return true if the value of a deprecated storage field is set and differs both from the
+// one read from the APIs and from the one of the contents
+func isChangedStorageField(value, readValue, contentsValue string) bool {
+	return value != "" && value != readValue && value != contentsValue
+}
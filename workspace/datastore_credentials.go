@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DatastoreCredentialsType The type of the credentials used by a datastore for accessing its storage
+type DatastoreCredentialsType string
+
+const (
+	DatastoreCredentialsTypeAccountKey       DatastoreCredentialsType = "AccountKey"
+	DatastoreCredentialsTypeSas              DatastoreCredentialsType = "Sas"
+	DatastoreCredentialsTypeServicePrincipal DatastoreCredentialsType = "ServicePrincipal"
+	DatastoreCredentialsTypeCertificate      DatastoreCredentialsType = "Certificate"
+	DatastoreCredentialsTypeSqlAdmin         DatastoreCredentialsType = "SqlAdmin"
+	DatastoreCredentialsTypeNone             DatastoreCredentialsType = "None"
+)
+
+// DatastoreCredentials The credentials used by a datastore for accessing its storage, one of AccountKeyCredentials,
+// SasCredentials, ServicePrincipalCredentials, CertificateCredentials, SqlAdminCredentials and NoCredentials.
+type DatastoreCredentials interface {
+	// CredentialsType Return the type of the credentials
+	CredentialsType() DatastoreCredentialsType
+	validate() error
+}
+
+// AccountKeyCredentials The access key of the storage account of the datastore
+type AccountKeyCredentials struct {
+	AccountKey string
+}
+
+func (c AccountKeyCredentials) CredentialsType() DatastoreCredentialsType {
+	return DatastoreCredentialsTypeAccountKey
+}
+
+func (c AccountKeyCredentials) validate() error {
+	return checkCredentialsFields(c.CredentialsType(), "account key", c.AccountKey)
+}
+
+// SasCredentials A Shared Access Signature token granting access to the storage of the datastore
+type SasCredentials struct {
+	SasToken string
+}
+
+func (c SasCredentials) CredentialsType() DatastoreCredentialsType {
+	return DatastoreCredentialsTypeSas
+}
+
+func (c SasCredentials) validate() error {
+	return checkCredentialsFields(c.CredentialsType(), "SAS token", c.SasToken)
+}
+
+// ServicePrincipalCredentials A Service Principal authenticating with a client secret. AuthorityUrl and ResourceUrl
+// are optional, and default on the server side to the ones of the cloud hosting the workspace.
+type ServicePrincipalCredentials struct {
+	TenantId     string
+	ClientId     string
+	ClientSecret string
+	AuthorityUrl string
+	ResourceUrl  string
+}
+
+func (c ServicePrincipalCredentials) CredentialsType() DatastoreCredentialsType {
+	return DatastoreCredentialsTypeServicePrincipal
+}
+
+func (c ServicePrincipalCredentials) validate() error {
+	return checkCredentialsFields(
+		c.CredentialsType(),
+		"tenant ID", c.TenantId,
+		"client ID", c.ClientId,
+		"client secret", c.ClientSecret,
+	)
+}
+
+// CertificateCredentials A Service Principal authenticating with a certificate, identified by its Thumbprint.
+// Certificate is the content of the certificate. AuthorityUrl and ResourceUrl are optional.
+type CertificateCredentials struct {
+	TenantId     string
+	ClientId     string
+	Thumbprint   string
+	Certificate  string
+	AuthorityUrl string
+	ResourceUrl  string
+}
+
+func (c CertificateCredentials) CredentialsType() DatastoreCredentialsType {
+	return DatastoreCredentialsTypeCertificate
+}
+
+func (c CertificateCredentials) validate() error {
+	return checkCredentialsFields(
+		c.CredentialsType(),
+		"tenant ID", c.TenantId,
+		"client ID", c.ClientId,
+		"thumbprint", c.Thumbprint,
+		"certificate", c.Certificate,
+	)
+}
+
+// SqlAdminCredentials The user and the password of an administrator of the database of the datastore
+type SqlAdminCredentials struct {
+	UserId   string
+	Password string
+}
+
+func (c SqlAdminCredentials) CredentialsType() DatastoreCredentialsType {
+	return DatastoreCredentialsTypeSqlAdmin
+}
+
+func (c SqlAdminCredentials) validate() error {
+	return checkCredentialsFields(c.CredentialsType(), "user ID", c.UserId, "password", c.Password)
+}
+
+// NoCredentials No credentials stored in the datastore, which is accessed with the identity of the users or of the
+// computes using it
+type NoCredentials struct{}
+
+func (c NoCredentials) CredentialsType() DatastoreCredentialsType {
+	return DatastoreCredentialsTypeNone
+}
+
+func (c NoCredentials) validate() error {
+	return nil
+}
+
+// checkCredentialsFields Return an InvalidArgumentError if any of the fields provided as argument, as pairs of name
+// and value, is empty
+func checkCredentialsFields(credentialsType DatastoreCredentialsType, namesAndValues ...string) error {
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		if strings.TrimSpace(namesAndValues[i+1]) == "" {
+			return InvalidArgumentError{fmt.Sprintf("the %s of the %s credentials cannot be empty", namesAndValues[i], credentialsType)}
+		}
+	}
+	return nil
+}
+
+// credentials Return the typed credentials described by the auth, or nil if its credentials type is not one of
+// the types that can be described by the fields of DatastoreAuth
+func (a *DatastoreAuth) credentials() DatastoreCredentials {
+	switch DatastoreCredentialsType(a.CredentialsType) {
+	case DatastoreCredentialsTypeAccountKey:
+		return AccountKeyCredentials{AccountKey: a.AccountKey}
+	case DatastoreCredentialsTypeServicePrincipal:
+		return ServicePrincipalCredentials{TenantId: a.TenantId, ClientId: a.ClientId, ClientSecret: a.ClientSecret}
+	case DatastoreCredentialsTypeSqlAdmin:
+		return SqlAdminCredentials{UserId: a.SqlUserName, Password: a.SqlUserPassword}
+	case DatastoreCredentialsTypeNone:
+		return NoCredentials{}
+	}
+	return nil
+}
+
+// credentials Return the credentials of the datastore, read from Auth if Credentials is nil
+func (d *Datastore) credentials() DatastoreCredentials {
+	if d.Credentials != nil {
+		return d.Credentials
+	}
+	if d.Auth != nil {
+		return d.Auth.credentials()
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (w *Workspace) GetEnvironments(resourceGroup, workspace string) ([]Environment, error) {
+	return w.GetEnvironmentsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetEnvironmentsWithContext(ctx context.Context, resourceGroup, workspace string) ([]Environment, error) {
+	pager := w.NewEnvironmentPager(resourceGroup, workspace)
+	result := make([]Environment, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewEnvironmentPager Return a pager for iterating page by page over the environments of the workspace.
+func (w *Workspace) NewEnvironmentPager(resourceGroup, workspace string) *EnvironmentPager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "environments"), unmarshalEnvironmentArray)
+}
+
+func (w *Workspace) GetEnvironment(resourceGroup, workspace, name string) (*Environment, error) {
+	return w.GetEnvironmentWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetEnvironmentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Environment, error) {
+	path := fmt.Sprintf("environments/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"environment", name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEnvironment(body), nil
+}
+
+func (w *Workspace) CreateOrUpdateEnvironment(resourceGroup, workspace string, environment *Environment) (*Environment, error) {
+	return w.CreateOrUpdateEnvironmentWithContext(context.Background(), resourceGroup, workspace, environment)
+}
+
+func (w *Workspace) CreateOrUpdateEnvironmentWithContext(ctx context.Context, resourceGroup, workspace string, environment *Environment) (*Environment, error) {
+	if strings.TrimSpace(environment.Name) == "" {
+		return nil, InvalidArgumentError{"the environment name cannot be empty"}
+	}
+
+	path := fmt.Sprintf("environments/%s", environment.Name)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteEnvironmentSchema(environment))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEnvironment(body), nil
+}
+
+// ArchiveEnvironment Archive the environment with the name provided as argument. Archived environments are hidden
+// from the default list operations, but can still be used.
+func (w *Workspace) ArchiveEnvironment(resourceGroup, workspace, name string) (*Environment, error) {
+	return w.ArchiveEnvironmentWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) ArchiveEnvironmentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Environment, error) {
+	environment, err := w.GetEnvironmentWithContext(ctx, resourceGroup, workspace, name)
+	if err != nil {
+		return nil, err
+	}
+	environment.IsArchived = true
+	return w.CreateOrUpdateEnvironmentWithContext(ctx, resourceGroup, workspace, environment)
+}
+
+func (w *Workspace) GetEnvironmentVersions(resourceGroup, workspace, environmentName string) ([]EnvironmentVersion, error) {
+	return w.GetEnvironmentVersionsWithContext(context.Background(), resourceGroup, workspace, environmentName)
+}
+
+func (w *Workspace) GetEnvironmentVersionsWithContext(ctx context.Context, resourceGroup, workspace, environmentName string) ([]EnvironmentVersion, error) {
+	pager := w.NewEnvironmentVersionPager(resourceGroup, workspace, environmentName)
+	result := make([]EnvironmentVersion, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewEnvironmentVersionPager Return a pager for iterating page by page over the versions of the environment
+// with the name provided as argument.
+func (w *Workspace) NewEnvironmentVersionPager(resourceGroup, workspace, environmentName string) *EnvironmentVersionPager {
+	path := fmt.Sprintf("environments/%s/versions", environmentName)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"environment", environmentName}
+	return newTypedPager(pager, func(body []byte) []EnvironmentVersion {
+		return unmarshalEnvironmentVersionArray(environmentName, body)
+	})
+}
+
+func (w *Workspace) GetEnvironmentVersion(resourceGroup, workspace, environmentName, version string) (*EnvironmentVersion, error) {
+	return w.GetEnvironmentVersionWithContext(context.Background(), resourceGroup, workspace, environmentName, version)
+}
+
+func (w *Workspace) GetEnvironmentVersionWithContext(ctx context.Context, resourceGroup, workspace, environmentName, version string) (*EnvironmentVersion, error) {
+	path := fmt.Sprintf("environments/%s/versions/%s", environmentName, version)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"environment", assetVersionIdentifier(environmentName, version)})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEnvironmentVersion(environmentName, body), nil
+}
+
+func (w *Workspace) CreateOrUpdateEnvironmentVersion(resourceGroup, workspace string, environmentVersion *EnvironmentVersion) (*EnvironmentVersion, error) {
+	return w.CreateOrUpdateEnvironmentVersionWithContext(context.Background(), resourceGroup, workspace, environmentVersion)
+}
+
+func (w *Workspace) CreateOrUpdateEnvironmentVersionWithContext(ctx context.Context, resourceGroup, workspace string, environmentVersion *EnvironmentVersion) (*EnvironmentVersion, error) {
+	if err := validateEnvironmentVersion(environmentVersion); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("environments/%s/versions/%s", environmentVersion.Name, environmentVersion.Version)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteEnvironmentVersionSchema(environmentVersion))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalEnvironmentVersion(environmentVersion.Name, body), nil
+}
+
+// ArchiveEnvironmentVersion Archive the version provided as argument of the environment with the specified name.
+func (w *Workspace) ArchiveEnvironmentVersion(resourceGroup, workspace, environmentName, version string) (*EnvironmentVersion, error) {
+	return w.ArchiveEnvironmentVersionWithContext(context.Background(), resourceGroup, workspace, environmentName, version)
+}
+
+func (w *Workspace) ArchiveEnvironmentVersionWithContext(ctx context.Context, resourceGroup, workspace, environmentName, version string) (*EnvironmentVersion, error) {
+	environmentVersion, err := w.GetEnvironmentVersionWithContext(ctx, resourceGroup, workspace, environmentName, version)
+	if err != nil {
+		return nil, err
+	}
+	environmentVersion.IsArchived = true
+	return w.CreateOrUpdateEnvironmentVersionWithContext(ctx, resourceGroup, workspace, environmentVersion)
+}
+
+func validateEnvironmentVersion(environmentVersion *EnvironmentVersion) error {
+	if strings.TrimSpace(environmentVersion.Name) == "" {
+		return InvalidArgumentError{"the environment name cannot be empty"}
+	}
+	if strings.TrimSpace(environmentVersion.Version) == "" {
+		return InvalidArgumentError{"the environment version cannot be empty"}
+	}
+	hasImage := strings.TrimSpace(environmentVersion.Image) != ""
+	hasBuild := environmentVersion.Build != nil
+	if hasImage == hasBuild {
+		return InvalidArgumentError{"exactly one between the image and the build context of the environment must be set"}
+	}
+	if hasBuild && strings.TrimSpace(environmentVersion.Build.ContextUri) == "" {
+		return InvalidArgumentError{"the build context URI cannot be empty"}
+	}
+	if hasBuild && environmentVersion.CondaFile != "" {
+		return InvalidArgumentError{"the conda file can only be used together with an image"}
+	}
+	switch environmentVersion.OsType {
+	case "", OsTypeLinux, OsTypeWindows:
+		return nil
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid OS type %q", environmentVersion.OsType)}
+	}
+}
@@ -0,0 +1,149 @@
+package workspace
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalEnvironmentVersion(t *testing.T) {
+	a := assert.New(t)
+
+	environmentVersion := unmarshalEnvironmentVersion("environment", loadExampleResp("example_resp_get_environment_version.json"))
+	a.Equal("environment", environmentVersion.Name)
+	a.Equal("1", environmentVersion.Version)
+	a.Equal("mcr.microsoft.com/azureml/openmpi4.1.0-ubuntu20.04", environmentVersion.Image)
+	a.Nil(environmentVersion.Build)
+	a.Equal("name: env\ndependencies:\n  - python=3.8\n", environmentVersion.CondaFile)
+	a.Equal(OsTypeLinux, environmentVersion.OsType)
+	a.Equal("UserCreated", environmentVersion.EnvironmentType)
+	a.Equal(&InferenceConfig{
+		LivenessRoute: &Route{Path: "/health", Port: 5001},
+		ScoringRoute:  &Route{Path: "/score", Port: 5001},
+	}, environmentVersion.InferenceConfig)
+
+	a.True(time.Date(2022, 6, 1, 10, 53, 40, 700170900, time.UTC).Equal(environmentVersion.SystemData.CreationDate))
+	a.Equal("creationUser", environmentVersion.SystemData.CreationUser)
+}
+
+func TestValidateEnvironmentVersion(t *testing.T) {
+	a := assert.New(t)
+	build := &BuildContext{ContextUri: "https://account.blob.core.windows.net/context/"}
+
+	a.Nil(validateEnvironmentVersion(&EnvironmentVersion{Name: "env", Version: "1", Image: "image", CondaFile: "conda"}))
+	a.Nil(validateEnvironmentVersion(&EnvironmentVersion{Name: "env", Version: "1", Build: build, OsType: OsTypeWindows}))
+
+	invalidEnvironmentVersions := []*EnvironmentVersion{
+		{Version: "1", Image: "image"},
+		{Name: "env", Image: "image"},
+		{Name: "env", Version: "1"},
+		{Name: "env", Version: "1", Image: "image", Build: build},
+		{Name: "env", Version: "1", Build: &BuildContext{}},
+		{Name: "env", Version: "1", Build: build, CondaFile: "conda"},
+		{Name: "env", Version: "1", Image: "image", OsType: "foo"},
+	}
+	for _, environmentVersion := range invalidEnvironmentVersions {
+		a.IsType(InvalidArgumentError{}, validateEnvironmentVersion(environmentVersion))
+	}
+}
+
+func TestWorkspace_EnvironmentVersions(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	environmentVersionResp := string(loadExampleResp("example_resp_get_environment_version.json"))
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get environment versions",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "environments/environment/versions").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", environmentVersionResp), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				environmentVersions, err := ws.GetEnvironmentVersions("rg", "ws", "environment")
+				a.Nil(err)
+				a.Len(environmentVersions, 1)
+				a.Equal("1", environmentVersions[0].Version)
+			},
+		},
+		{
+			testCaseName: "Test get environment not found",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "environments/environment").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				environment, err := ws.GetEnvironment("rg", "ws", "environment")
+				a.Nil(environment)
+				a.Equal(&ResourceNotFoundError{"environment", "environment"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create or update environment version success",
+			testCase: func() {
+				environmentVersion := &EnvironmentVersion{
+					Name:    "environment",
+					Version: "1",
+					Image:   "mcr.microsoft.com/azureml/openmpi4.1.0-ubuntu20.04",
+					InferenceConfig: &InferenceConfig{
+						ScoringRoute: &Route{Path: "/score", Port: 5001},
+					},
+				}
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "environments/environment/versions/1", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					properties := schema.Properties.(WriteEnvironmentVersionSchema)
+					return properties.Image == environmentVersion.Image &&
+						properties.InferenceConfig.ScoringRoute.Path == "/score" &&
+						properties.InferenceConfig.LivenessRoute == nil
+				})).Return(http.StatusCreated, environmentVersionResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateEnvironmentVersion("rg", "ws", environmentVersion)
+				a.Nil(err)
+				a.Equal("environment", result.Name)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test create or update environment version http response 400 bad request",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", mock.Anything, mock.Anything).Return(http.StatusBadRequest, "error", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateEnvironmentVersion("rg", "ws", &EnvironmentVersion{Name: "environment", Version: "1", Image: "image"})
+				a.Nil(result)
+				a.Equal(&HttpResponseError{StatusCode: http.StatusBadRequest, ResponseContent: "error"}, err)
+			},
+		},
+		{
+			testCaseName: "Test archive environment version",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "environments/environment/versions/1").Return(http.StatusOK, environmentVersionResp, nil)
+				mockedHttpClient.On("doPut", "environments/environment/versions/1", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					properties := schema.Properties.(WriteEnvironmentVersionSchema)
+					return properties.IsArchived && properties.CondaFile != ""
+				})).Return(http.StatusOK, environmentVersionResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				_, err := ws.ArchiveEnvironmentVersion("rg", "ws", "environment", "1")
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
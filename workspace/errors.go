@@ -1,23 +1,188 @@
 package workspace
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"github.com/tidwall/gjson"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrNoMorePages Returned by the pagers when all the pages have already been retrieved.
+var ErrNoMorePages = errors.New("no more pages")
+
+// ErrOperationInProgress Returned by the pollers when retrieving the result of a long-running operation that is
+// not done yet.
+var ErrOperationInProgress = errors.New("operation in progress")
+
+// Sentinel errors that can be used with errors.Is for checking the category of the errors returned by the Workspace.
+var (
+	ErrNotFound     = errors.New("resource not found")
+	ErrConflict     = errors.New("conflict")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrThrottled    = errors.New("throttled")
+)
 
 type ResourceNotFoundError struct {
-	resourceType       string
-	resourceIdentifier string
+	ResourceType       string
+	ResourceIdentifier string
 }
 
 func (e ResourceNotFoundError) Error() string {
-	return fmt.Sprintf("%s %s not found", e.resourceType, e.resourceIdentifier)
+	return fmt.Sprintf("%s %s not found", e.ResourceType, e.ResourceIdentifier)
+}
+
+func (e ResourceNotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ArmErrorDetail A detail of an error returned by the Azure Resource Manager APIs
+type ArmErrorDetail struct {
+	Code    string
+	Message string
+	Target  string
+	Details []ArmErrorDetail
 }
 
+// ArmInnerError The chain of the inner errors returned by the Azure Resource Manager APIs,
+// from the most generic to the most specific
+type ArmInnerError struct {
+	Code       string
+	InnerError *ArmInnerError
+}
+
+// HttpResponseError Returned when the AML APIs reply with an unexpected status code. If the response contains
+// the Azure Resource Manager error envelope, its content is parsed into the respective fields.
 type HttpResponseError struct {
-	statusCode      int
-	responseContent string
+	StatusCode      int
+	ResponseContent string
+
+	Code       string
+	Message    string
+	Target     string
+	Details    []ArmErrorDetail
+	InnerError *ArmInnerError
+
+	RequestId     string
+	CorrelationId string
+}
+
+func newHttpResponseError(resp *http.Response, body []byte) *HttpResponseError {
+	err := &HttpResponseError{
+		StatusCode:      resp.StatusCode,
+		ResponseContent: string(body),
+		RequestId:       resp.Header.Get("x-ms-request-id"),
+		CorrelationId:   resp.Header.Get("x-ms-correlation-request-id"),
+	}
+
+	jsonError := gjson.GetBytes(body, "error")
+	if jsonError.IsObject() {
+		err.Code = jsonError.Get("code").Str
+		err.Message = jsonError.Get("message").Str
+		err.Target = jsonError.Get("target").Str
+		err.Details = unmarshalArmErrorDetails(jsonError.Get("details"))
+		err.InnerError = unmarshalArmInnerError(jsonError.Get("innerError"))
+	}
+	return err
+}
+
+func unmarshalArmErrorDetails(json gjson.Result) []ArmErrorDetail {
+	if json.IsArray() == false || len(json.Array()) == 0 {
+		return nil
+	}
+	var result []ArmErrorDetail
+	for _, detail := range json.Array() {
+		result = append(result, ArmErrorDetail{
+			Code:    detail.Get("code").Str,
+			Message: detail.Get("message").Str,
+			Target:  detail.Get("target").Str,
+			Details: unmarshalArmErrorDetails(detail.Get("details")),
+		})
+	}
+	return result
+}
+
+func unmarshalArmInnerError(json gjson.Result) *ArmInnerError {
+	if json.IsObject() == false {
+		return nil
+	}
+	return &ArmInnerError{
+		Code:       json.Get("code").Str,
+		InnerError: unmarshalArmInnerError(json.Get("innerError")),
+	}
 }
 
 func (e HttpResponseError) Error() string {
-	return fmt.Sprintf("HTTP Response is in error [status code %d]: %s", e.statusCode, e.responseContent)
+	if e.Code != "" {
+		return fmt.Sprintf("HTTP Response is in error [status code %d, error code %s]: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("HTTP Response is in error [status code %d]: %s", e.StatusCode, e.ResponseContent)
+}
+
+// Is Return true if the sentinel error provided as argument describes the category of the error,
+// based on the status code and on the error code of the response.
+func (e HttpResponseError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.hasCode("ResourceNotFound", "NotFound", "ResourceGroupNotFound")
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict || e.hasCode("Conflict")
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden ||
+			e.hasCode("AuthorizationFailed", "AuthenticationFailed", "InvalidAuthenticationToken")
+	case ErrThrottled:
+		return e.StatusCode == http.StatusTooManyRequests || e.hasCode("TooManyRequests")
+	}
+	return false
+}
+
+// hasCode Return true if the error code, or the code of any of the inner errors, is among the ones provided as argument
+func (e HttpResponseError) hasCode(codes ...string) bool {
+	errorCodes := []string{e.Code}
+	for inner := e.InnerError; inner != nil; inner = inner.InnerError {
+		errorCodes = append(errorCodes, inner.Code)
+	}
+	for _, errorCode := range errorCodes {
+		for _, code := range codes {
+			if errorCode == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// JobUnresponsiveError Returned while waiting for a job that stayed NotResponding, Unknown or in a status not known
+// by the SDK for too long
+type JobUnresponsiveError struct {
+	JobName string
+	Status  JobStatus
+	Timeout time.Duration
+}
+
+func (e JobUnresponsiveError) Error() string {
+	return fmt.Sprintf("job %s has been unresponsive for more than %s, last status is %q", e.JobName, e.Timeout, e.Status)
+}
+
+// OperationFailedError Returned by the pollers when a long-running operation failed or has been canceled
+type OperationFailedError struct {
+	Status  OperationStatus
+	Code    string
+	Message string
+	Details []ArmErrorDetail
+}
+
+func (e OperationFailedError) Error() string {
+	status := strings.ToLower(string(e.Status))
+	switch {
+	case e.Code != "":
+		return fmt.Sprintf("operation %s [error code %s]: %s", status, e.Code, e.Message)
+	case e.Message != "":
+		return fmt.Sprintf("operation %s: %s", status, e.Message)
+	default:
+		return fmt.Sprintf("operation %s", status)
+	}
 }
 
 type InvalidArgumentError struct {
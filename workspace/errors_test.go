@@ -0,0 +1,69 @@
+package workspace
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestNewHttpResponseError(t *testing.T) {
+	a := assert.New(t)
+	body := loadExampleResp("example_resp_arm_error.json")
+	resp := &http.Response{
+		StatusCode: http.StatusConflict,
+		Header: http.Header{
+			"X-Ms-Request-Id":             []string{"request-id"},
+			"X-Ms-Correlation-Request-Id": []string{"correlation-id"},
+		},
+	}
+
+	err := newHttpResponseError(resp, body)
+	a.Equal(http.StatusConflict, err.StatusCode)
+	a.Equal(string(body), err.ResponseContent)
+	a.Equal("UserError", err.Code)
+	a.Equal("Conflicting datastore definition.", err.Message)
+	a.Equal("datastore-1", err.Target)
+	a.Equal([]ArmErrorDetail{{Code: "Conflict", Message: "A datastore with the same name already exists.", Target: "name"}}, err.Details)
+	a.Equal(&ArmInnerError{Code: "Conflict", InnerError: &ArmInnerError{Code: "DatastoreAlreadyExists"}}, err.InnerError)
+	a.Equal("request-id", err.RequestId)
+	a.Equal("correlation-id", err.CorrelationId)
+	a.Equal("HTTP Response is in error [status code 409, error code UserError]: Conflicting datastore definition.", err.Error())
+}
+
+func TestNewHttpResponseError_NotArmEnvelope(t *testing.T) {
+	a := assert.New(t)
+	resp := &http.Response{StatusCode: http.StatusBadGateway}
+
+	err := newHttpResponseError(resp, []byte("bad gateway"))
+	a.Equal(&HttpResponseError{StatusCode: http.StatusBadGateway, ResponseContent: "bad gateway"}, err)
+	a.Equal("HTTP Response is in error [status code 502]: bad gateway", err.Error())
+}
+
+func TestErrorsIs(t *testing.T) {
+	a := assert.New(t)
+	testCases := []struct {
+		description string
+		err         error
+		target      error
+		expected    bool
+	}{
+		{"Not found status code", &HttpResponseError{StatusCode: http.StatusNotFound}, ErrNotFound, true},
+		{"Not found resource", &ResourceNotFoundError{"datastore", "foo"}, ErrNotFound, true},
+		{"Not found resource is not a conflict", &ResourceNotFoundError{"datastore", "foo"}, ErrConflict, false},
+		{"Conflict status code", &HttpResponseError{StatusCode: http.StatusConflict}, ErrConflict, true},
+		{"Conflict inner error code", &HttpResponseError{StatusCode: http.StatusBadRequest, Code: "UserError", InnerError: &ArmInnerError{Code: "Conflict"}}, ErrConflict, true},
+		{"Unauthorized status code", &HttpResponseError{StatusCode: http.StatusUnauthorized}, ErrUnauthorized, true},
+		{"Authorization failed code", &HttpResponseError{StatusCode: http.StatusForbidden, Code: "AuthorizationFailed"}, ErrUnauthorized, true},
+		{"Throttled status code", &HttpResponseError{StatusCode: http.StatusTooManyRequests}, ErrThrottled, true},
+		{"Internal error is not throttled", &HttpResponseError{StatusCode: http.StatusInternalServerError}, ErrThrottled, false},
+	}
+
+	for _, tc := range testCases {
+		a.Equal(tc.expected, errors.Is(tc.err, tc.target), tc.description)
+	}
+
+	var respErr *HttpResponseError
+	a.True(errors.As(error(&HttpResponseError{StatusCode: http.StatusConflict, Code: "Conflict"}), &respErr))
+	a.Equal("Conflict", respErr.Code)
+}
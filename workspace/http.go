@@ -5,88 +5,144 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/confidential"
 	"go.uber.org/zap"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 const (
-	amlApiVersion          = "2021-03-01-preview"
-	amlWorkspaceApiBaseUrl = "https://management.azure.com/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MachineLearningServices/workspaces/%s"
+	amlApiVersion          = ApiVersion20210301Preview
+	amlWorkspaceApiBaseUrl = "%s/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MachineLearningServices/workspaces/%s"
+
+	// amlResourceGroupApiBaseUrl, amlSubscriptionApiBaseUrl The base URLs of the clients not bound to a workspace,
+	// addressing the workspaces of a resource group or of the whole subscription
+	amlResourceGroupApiBaseUrl = "%s/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MachineLearningServices/workspaces"
+	amlSubscriptionApiBaseUrl  = "%s/subscriptions/%s/providers/Microsoft.MachineLearningServices/workspaces"
 )
 
 type HttpClientBuilderAPI interface {
+	// newClient Return a client whose request paths are relative to the workspace provided as argument. The
+	// requests fail with an InvalidArgumentError if the resource group or the workspace name is empty.
 	newClient(resourceGroupName, workspaceName string) HttpClientAPI
+
+	// newWorkspaceCollectionClient Return a client whose request paths are relative to the workspaces of the
+	// resource group provided as argument, or to the ones of the subscription if resourceGroupName is empty
+	newWorkspaceCollectionClient(resourceGroupName string) HttpClientAPI
 }
 
 func newHttpClientBuilder(
 	logger *zap.SugaredLogger,
-	msalClient confidential.Client,
-	subscriptionId string) HttpClientBuilderAPI {
+	credential TokenCredential,
+	subscriptionId string,
+	cloud Cloud,
+	retryPolicy RetryPolicy,
+	apiVersions ApiVersions) HttpClientBuilderAPI {
 	return &HttpClientBuilder{
 		logger:         logger,
-		msalClient:     msalClient,
+		credential:     credential,
 		subscriptionId: subscriptionId,
+		cloud:          cloud.withDefaults(),
 		httpClient:     &http.Client{},
+		retrier:        newRetrier(retryPolicy, logger),
+		apiVersions:    apiVersions,
 	}
 }
 
 type HttpClientBuilder struct {
 	logger         *zap.SugaredLogger
-	msalClient     confidential.Client
+	credential     TokenCredential
 	subscriptionId string
+	cloud          Cloud
 	httpClient     *http.Client
+	retrier        *retrier
+	apiVersions    ApiVersions
 }
 
 func (b *HttpClientBuilder) newClient(resourceGroupName, workspaceName string) HttpClientAPI {
+	return b.newHttpClient(resourceGroupName, workspaceName, false)
+}
+
+func (b *HttpClientBuilder) newWorkspaceCollectionClient(resourceGroupName string) HttpClientAPI {
+	return b.newHttpClient(resourceGroupName, "", true)
+}
+
+func (b *HttpClientBuilder) newHttpClient(resourceGroupName, workspaceName string, workspaceCollection bool) *HttpClient {
 	return &HttpClient{
-		logger:            b.logger,
-		msalClient:        b.msalClient,
-		subscriptionId:    b.subscriptionId,
-		resourceGroupName: resourceGroupName,
-		workspaceName:     workspaceName,
-		httpClient:        b.httpClient,
+		logger:              b.logger,
+		credential:          b.credential,
+		subscriptionId:      b.subscriptionId,
+		cloud:               b.cloud,
+		resourceGroupName:   resourceGroupName,
+		workspaceName:       workspaceName,
+		workspaceCollection: workspaceCollection,
+		httpClient:          b.httpClient,
+		retrier:             b.retrier,
+		apiVersions:         b.apiVersions,
 	}
 }
 
 type HttpClientAPI interface {
-	doGet(path string) (*http.Response, error)
+	doGet(ctx context.Context, path string) (*http.Response, error)
 
-	doGetWithContext(ctx context.Context, path string) (*http.Response, error)
+	doGetUrl(ctx context.Context, url string) (*http.Response, error)
 
-	doDelete(path string) (*http.Response, error)
+	doDelete(ctx context.Context, path string) (*http.Response, error)
 
-	doPut(path string, requestBody interface{}) (*http.Response, error)
+	doPut(ctx context.Context, path string, requestBody interface{}) (*http.Response, error)
+
+	doPost(ctx context.Context, path string, requestBody interface{}) (*http.Response, error)
 }
 
 type HttpClient struct {
 	logger            *zap.SugaredLogger
-	msalClient        confidential.Client
+	credential        TokenCredential
 	subscriptionId    string
+	cloud             Cloud
 	resourceGroupName string
 	workspaceName     string
-	httpClient        *http.Client
+
+	// workspaceCollection Whether the client addresses the workspaces of the resource group or of the subscription
+	// instead of the resources of a single workspace
+	workspaceCollection bool
+
+	httpClient  *http.Client
+	retrier     *retrier
+	apiVersions ApiVersions
 }
 
-func (c HttpClient) getJwt() (string, error) {
-	scopes := []string{DefaultAmlOauthScope}
-	c.logger.Debug("Using cached JWT silently...")
-	authResult, err := c.msalClient.AcquireTokenSilent(context.Background(), scopes)
+func (c HttpClient) getJwt(ctx context.Context) (string, error) {
+	scopes := []string{c.cloud.ResourceManagerScope}
+	token, err := c.credential.GetToken(ctx, scopes)
 	if err != nil {
-		c.logger.Debug("Could not acquire JWT silently, now acquiring it with Client Credential flow...")
-		authResult, err = c.msalClient.AcquireTokenByCredential(context.Background(), scopes)
-		c.logger.Debug("JWT acquired")
+		return "", err
 	}
-	return authResult.AccessToken, err
+	return token.Token, nil
 }
 
 func (c *HttpClient) getWorkspaceApiBaseUrl() string {
-	return fmt.Sprintf(amlWorkspaceApiBaseUrl, c.subscriptionId, c.resourceGroupName, c.workspaceName)
+	switch {
+	case !c.workspaceCollection:
+		return fmt.Sprintf(amlWorkspaceApiBaseUrl, c.cloud.ResourceManagerEndpoint, c.subscriptionId, c.resourceGroupName, c.workspaceName)
+	case c.resourceGroupName != "":
+		return fmt.Sprintf(amlResourceGroupApiBaseUrl, c.cloud.ResourceManagerEndpoint, c.subscriptionId, c.resourceGroupName)
+	default:
+		return fmt.Sprintf(amlSubscriptionApiBaseUrl, c.cloud.ResourceManagerEndpoint, c.subscriptionId)
+	}
 }
 
-func (c *HttpClient) prepareRequest(req *http.Request) error {
-	jwt, err := c.getJwt()
+// getUrl Return the URL of the path provided as argument, relative to the base URL of the client. An empty path,
+// possibly followed by a query, addresses the base URL itself.
+func (c *HttpClient) getUrl(path string) string {
+	if path == "" || strings.HasPrefix(path, "?") {
+		return c.getWorkspaceApiBaseUrl() + path
+	}
+	return fmt.Sprintf("%s/%s", c.getWorkspaceApiBaseUrl(), path)
+}
+
+func (c *HttpClient) prepareRequest(req *http.Request, apiVersion string) error {
+	jwt, err := c.getJwt(req.Context())
 	if err != nil {
 		return err
 	}
@@ -94,14 +150,16 @@ func (c *HttpClient) prepareRequest(req *http.Request) error {
 	// Add required headers
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", jwt))
 
-	// Add required query params
+	// Add required query params. URLs returned by the APIs, such as next links, may already contain them.
 	q := req.URL.Query()
-	q.Add("api-version", amlApiVersion)
+	if q.Get("api-version") == "" {
+		q.Set("api-version", apiVersion)
+	}
 	req.URL.RawQuery = q.Encode()
 	return nil
 }
 
-func (c *HttpClient) newRequest(method string, url string, requestBody []byte) (*http.Request, error) {
+func (c *HttpClient) newRequestWithContext(ctx context.Context, method string, url string, requestBody []byte, apiVersion string) (*http.Request, error) {
 	var requestBodyReader io.Reader
 	if requestBody == nil {
 		requestBodyReader = nil
@@ -109,76 +167,123 @@ func (c *HttpClient) newRequest(method string, url string, requestBody []byte) (
 		requestBodyReader = bytes.NewBuffer(requestBody)
 	}
 
-	req, err := http.NewRequest(method, url, requestBodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, url, requestBodyReader)
 	if err != nil {
 		return req, err
 	}
 
-	err = c.prepareRequest(req)
+	err = c.prepareRequest(req, apiVersion)
 	return req, err
 }
 
-func (c *HttpClient) newRequestWithContext(ctx context.Context, method string, url string, requestBody []byte) (*http.Request, error) {
-	var requestBodyReader io.Reader
-	if requestBody == nil {
-		requestBodyReader = nil
-	} else {
-		requestBodyReader = bytes.NewBuffer(requestBody)
+// checkWorkspace Check that the workspace of the clients not addressing a collection of workspaces is set, since
+// otherwise the request paths would address a different resource, e.g. a workspace named as the first segment
+func (c *HttpClient) checkWorkspace() error {
+	if c.workspaceCollection {
+		return nil
 	}
+	return validateAmlWorkspaceReference(c.resourceGroupName, c.workspaceName)
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, requestBodyReader)
+func (c *HttpClient) doGet(ctx context.Context, path string) (*http.Response, error) {
+	if err := c.checkWorkspace(); err != nil {
+		return nil, err
+	}
+	url := c.getUrl(path)
+	request, err := c.newRequestWithContext(ctx, "GET", url, nil, c.apiVersions.forPath(path))
 	if err != nil {
-		return req, err
+		return nil, err
 	}
-
-	err = c.prepareRequest(req)
-	return req, err
+	c.logger.Infof("GET > %s", request.URL)
+	return c.retrier.do(c.httpClient, request)
 }
 
-func (c *HttpClient) doGet(path string) (*http.Response, error) {
-	url := fmt.Sprintf("%s/%s", c.getWorkspaceApiBaseUrl(), path)
-	request, err := c.newRequest("GET", url, nil)
+// doGetUrl Perform a GET request to an absolute URL returned by the APIs, such as the next link of a paginated list.
+// The URL must point to the same host of the AML APIs, since the request is authenticated.
+func (c *HttpClient) doGetUrl(ctx context.Context, rawUrl string) (*http.Response, error) {
+	if err := c.checkSameHost(rawUrl); err != nil {
+		return nil, err
+	}
+	request, err := c.newRequestWithContext(ctx, "GET", rawUrl, nil, c.apiVersions.defaultVersion())
 	if err != nil {
 		return nil, err
 	}
 	c.logger.Infof("GET > %s", request.URL)
-	return c.httpClient.Do(request)
+	return c.retrier.do(c.httpClient, request)
 }
 
-func (c HttpClient) doGetWithContext(ctx context.Context, path string) (*http.Response, error) {
-	url := fmt.Sprintf("%s/%s", c.getWorkspaceApiBaseUrl(), path)
-	request, err := c.newRequest("GET", url, nil)
+func (c *HttpClient) checkSameHost(rawUrl string) error {
+	target, err := url.Parse(rawUrl)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	c.logger.Infof("GET > %s", request.URL)
-	return c.httpClient.Do(request)
+	base, err := url.Parse(c.getWorkspaceApiBaseUrl())
+	if err != nil {
+		return err
+	}
+	if target.Scheme != base.Scheme || target.Host != base.Host {
+		return fmt.Errorf("refusing to send an authenticated request to %s://%s", target.Scheme, target.Host)
+	}
+	return nil
 }
 
-func (c *HttpClient) doDelete(path string) (*http.Response, error) {
-	url := fmt.Sprintf("%s/%s", c.getWorkspaceApiBaseUrl(), path)
-	request, err := c.newRequest("DELETE", url, nil)
+func (c *HttpClient) doDelete(ctx context.Context, path string) (*http.Response, error) {
+	if err := c.checkWorkspace(); err != nil {
+		return nil, err
+	}
+	url := c.getUrl(path)
+	request, err := c.newRequestWithContext(ctx, "DELETE", url, nil, c.apiVersions.forPath(path))
 	if err != nil {
 		return nil, err
 	}
 	c.logger.Infof("DELETE > %s", request.URL)
-	return c.httpClient.Do(request)
+	return c.retrier.do(c.httpClient, request)
 }
 
-func (c *HttpClient) doPut(path string, requestBody interface{}) (*http.Response, error) {
-	url := fmt.Sprintf("%s/%s", c.getWorkspaceApiBaseUrl(), path)
+func (c *HttpClient) doPut(ctx context.Context, path string, requestBody interface{}) (*http.Response, error) {
+	if err := c.checkWorkspace(); err != nil {
+		return nil, err
+	}
+	url := c.getUrl(path)
 
 	b, err := json.Marshal(requestBody)
 	if err != nil {
 		return nil, err
 	}
 
-	request, err := c.newRequest("PUT", url, b)
-	request.Header.Add("Content-Type", "application/json")
+	request, err := c.newRequestWithContext(ctx, "PUT", url, b, c.apiVersions.forPath(path))
 	if err != nil {
 		return nil, err
 	}
+	request.Header.Add("Content-Type", "application/json")
 
 	c.logger.Infof("PUT > %s", request.URL)
-	return c.httpClient.Do(request)
+	return c.retrier.do(c.httpClient, request)
+}
+
+// doPost Perform a POST request, used for the actions on the resources. If requestBody is nil, the request has no body.
+func (c *HttpClient) doPost(ctx context.Context, path string, requestBody interface{}) (*http.Response, error) {
+	if err := c.checkWorkspace(); err != nil {
+		return nil, err
+	}
+	url := c.getUrl(path)
+
+	var b []byte
+	if requestBody != nil {
+		var err error
+		if b, err = json.Marshal(requestBody); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := c.newRequestWithContext(ctx, "POST", url, b, c.apiVersions.forPath(path))
+	if err != nil {
+		return nil, err
+	}
+	if b != nil {
+		request.Header.Add("Content-Type", "application/json")
+	}
+
+	c.logger.Infof("POST > %s", request.URL)
+	return c.retrier.do(c.httpClient, request)
 }
@@ -0,0 +1,98 @@
+package workspace
+
+import (
+	"context"
+	"time"
+)
+
+// JobStatusChange A change of the status of a job observed by WatchJob
+type JobStatusChange struct {
+	// Previous The status observed before the change, empty for the first observation of the job
+	Previous JobStatus
+	Current  JobStatus
+	Job      *Job
+}
+
+// WatchJobOptions Configure how WatchJob polls the status of a job.
+type WatchJobOptions struct {
+	// PollInterval The delay before retrieving the job again after its status changed. If not positive,
+	// DefaultJobPollInterval is used.
+	PollInterval time.Duration
+
+	// MaxPollInterval While the status of the job does not change, the delay between two retrievals doubles up to
+	// MaxPollInterval. If lower than PollInterval, the delay never changes.
+	MaxPollInterval time.Duration
+
+	// UnresponsiveTimeout How long the job can stay NotResponding, Unknown or in a status not known by the SDK before
+	// a JobUnresponsiveError is returned. If not positive, DefaultJobUnresponsiveTimeout is used. Paused jobs are
+	// waited for without a limit.
+	UnresponsiveTimeout time.Duration
+}
+
+// unresponsiveTimeout Return how long the job can stay unresponsive before giving up waiting for it
+func (o WatchJobOptions) unresponsiveTimeout() time.Duration {
+	if o.UnresponsiveTimeout <= 0 {
+		return DefaultJobUnresponsiveTimeout
+	}
+	return o.UnresponsiveTimeout
+}
+
+// nextInterval Return the delay before the next retrieval of the job, given the current one and whether the
+// status of the job just changed.
+func (o WatchJobOptions) nextInterval(current time.Duration, changed bool) time.Duration {
+	pollInterval := o.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultJobPollInterval
+	}
+	if changed || current < pollInterval || o.MaxPollInterval <= pollInterval {
+		return pollInterval
+	}
+	if current*2 > o.MaxPollInterval {
+		return o.MaxPollInterval
+	}
+	return current * 2
+}
+
+// WatchJob Poll the job with the name provided as argument until it is Completed, Failed or Canceled, then return
+// it. Every time the status of the job changes, onStatusChange is called with the new status, including the first
+// time the job is retrieved and the transitions to NotResponding or Paused. onStatusChange may be nil. If the job
+// stays NotResponding, Unknown or in a status not known by the SDK for longer than options.UnresponsiveTimeout, a
+// JobUnresponsiveError is returned.
+func (w *Workspace) WatchJob(resourceGroup, workspace, name string, options WatchJobOptions, onStatusChange func(change JobStatusChange)) (*Job, error) {
+	return w.WatchJobWithContext(context.Background(), resourceGroup, workspace, name, options, onStatusChange)
+}
+
+// WatchJobWithContext Same as WatchJob, returning the error of the context as soon as it is done.
+func (w *Workspace) WatchJobWithContext(ctx context.Context, resourceGroup, workspace, name string, options WatchJobOptions, onStatusChange func(change JobStatusChange)) (*Job, error) {
+	var previous JobStatus
+	var interval time.Duration
+	var unresponsiveSince time.Time
+	for first := true; ; first = false {
+		job, err := w.GetJobWithContext(ctx, resourceGroup, workspace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := first || job.Status != previous
+		if changed && onStatusChange != nil {
+			onStatusChange(JobStatusChange{Previous: previous, Current: job.Status, Job: job})
+		}
+		if job.Status.IsTerminal() {
+			return job, nil
+		}
+		if !job.Status.isUnresponsive() {
+			unresponsiveSince = time.Time{}
+		} else if unresponsiveSince.IsZero() {
+			unresponsiveSince = time.Now()
+		} else if timeout := options.unresponsiveTimeout(); time.Since(unresponsiveSince) >= timeout {
+			return nil, &JobUnresponsiveError{JobName: name, Status: job.Status, Timeout: timeout}
+		}
+
+		previous = job.Status
+		interval = options.nextInterval(interval, changed)
+		w.logger.Debugf("job %s is %s, checking again in %s", name, job.Status, interval)
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWatchJobOptions_NextInterval(t *testing.T) {
+	a := assert.New(t)
+
+	options := WatchJobOptions{PollInterval: time.Second, MaxPollInterval: 5 * time.Second}
+	a.Equal(time.Second, options.nextInterval(0, true))
+	a.Equal(time.Second, options.nextInterval(0, false))
+	a.Equal(2*time.Second, options.nextInterval(time.Second, false))
+	a.Equal(4*time.Second, options.nextInterval(2*time.Second, false))
+	a.Equal(5*time.Second, options.nextInterval(4*time.Second, false))
+	a.Equal(5*time.Second, options.nextInterval(5*time.Second, false))
+	a.Equal(time.Second, options.nextInterval(5*time.Second, true))
+
+	a.Equal(time.Second, WatchJobOptions{PollInterval: time.Second}.nextInterval(time.Second, false))
+	a.Equal(DefaultJobPollInterval, WatchJobOptions{}.nextInterval(0, true))
+}
+
+func TestWorkspace_WatchJob(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	jobWithStatus := func(status JobStatus) string {
+		return fmt.Sprintf(`{"name": "train-1", "properties": {"jobType": "Command", "status": "%s"}}`, status)
+	}
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test watch job emits status transitions",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				for _, status := range []JobStatus{JobStatusQueued, JobStatusQueued, JobStatusPreparing, JobStatusRunning, JobStatusRunning, JobStatusRunning, JobStatusCompleted} {
+					mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(status), nil).Once()
+				}
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				var changes []JobStatusChange
+				options := WatchJobOptions{PollInterval: time.Millisecond, MaxPollInterval: 4 * time.Millisecond}
+				job, err := ws.WatchJob("rg", "ws", "train-1", options, func(change JobStatusChange) {
+					changes = append(changes, change)
+				})
+				a.Nil(err)
+				a.Equal(JobStatusCompleted, job.Status)
+				mockedHttpClient.AssertExpectations(t)
+
+				a.Len(changes, 4)
+				expected := [][2]JobStatus{
+					{"", JobStatusQueued},
+					{JobStatusQueued, JobStatusPreparing},
+					{JobStatusPreparing, JobStatusRunning},
+					{JobStatusRunning, JobStatusCompleted},
+				}
+				for i, change := range changes {
+					a.Equal(expected[i][0], change.Previous)
+					a.Equal(expected[i][1], change.Current)
+					a.Equal("train-1", change.Job.Name)
+				}
+			},
+		},
+		{
+			testCaseName: "Test watch job continues while the job is not responding",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				for _, status := range []JobStatus{JobStatusRunning, JobStatusNotResponding, JobStatusNotResponding, JobStatusRunning, JobStatusFailed} {
+					mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(status), nil).Once()
+				}
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				var statuses []JobStatus
+				job, err := ws.WatchJob("rg", "ws", "train-1", WatchJobOptions{PollInterval: time.Millisecond}, func(change JobStatusChange) {
+					statuses = append(statuses, change.Current)
+				})
+				a.Nil(err)
+				a.Equal(JobStatusFailed, job.Status)
+				a.Equal([]JobStatus{JobStatusRunning, JobStatusNotResponding, JobStatusRunning, JobStatusFailed}, statuses)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test watch job gives up on a job with an unknown status",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(JobStatusUnknown), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				options := WatchJobOptions{PollInterval: time.Millisecond, UnresponsiveTimeout: 5 * time.Millisecond}
+				job, err := ws.WatchJob("rg", "ws", "train-1", options, nil)
+				a.Nil(job)
+				a.Equal(&JobUnresponsiveError{JobName: "train-1", Status: JobStatusUnknown, Timeout: 5 * time.Millisecond}, err)
+			},
+		},
+		{
+			testCaseName: "Test watch job gives up on a job with a status not known by the SDK",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(JobStatusRunning), nil).Once()
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus("Hibernating"), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				options := WatchJobOptions{PollInterval: time.Millisecond, UnresponsiveTimeout: 5 * time.Millisecond}
+				job, err := ws.WatchJob("rg", "ws", "train-1", options, nil)
+				a.Nil(job)
+				a.Equal(&JobUnresponsiveError{JobName: "train-1", Status: "Hibernating", Timeout: 5 * time.Millisecond}, err)
+			},
+		},
+		{
+			testCaseName: "Test watch job already terminal",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(JobStatusCanceled), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				job, err := ws.WatchJob("rg", "ws", "train-1", WatchJobOptions{}, nil)
+				a.Nil(err)
+				a.Equal(JobStatusCanceled, job.Status)
+				mockedHttpClient.AssertNumberOfCalls(t, "doGet", 1)
+			},
+		},
+		{
+			testCaseName: "Test watch job error retrieving the job",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(JobStatusRunning), nil).Once()
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusNotFound, "", nil).Once()
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				job, err := ws.WatchJob("rg", "ws", "train-1", WatchJobOptions{PollInterval: time.Millisecond}, nil)
+				a.Nil(job)
+				a.Equal(&ResourceNotFoundError{"job", "train-1"}, err)
+			},
+		},
+		{
+			testCaseName: "Test watch job context cancelled",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, jobWithStatus(JobStatusRunning), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				ctx, cancel := context.WithCancel(context.Background())
+				calls := 0
+				job, err := ws.WatchJobWithContext(ctx, "rg", "ws", "train-1", WatchJobOptions{PollInterval: time.Hour}, func(change JobStatusChange) {
+					calls++
+					cancel()
+				})
+				a.Nil(job)
+				a.Equal(context.Canceled, err)
+				a.Equal(1, calls)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
@@ -0,0 +1,186 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultJobPollInterval The interval between two consecutive retrievals of a job while waiting for its completion
+const DefaultJobPollInterval = 10 * time.Second
+
+// DefaultJobUnresponsiveTimeout How long a job can stay NotResponding, Unknown or in a status not known by the SDK
+// before giving up waiting for it
+const DefaultJobUnresponsiveTimeout = time.Hour
+
+func (w *Workspace) GetJobs(resourceGroup, workspace string, filter JobFilter) ([]Job, error) {
+	return w.GetJobsWithContext(context.Background(), resourceGroup, workspace, filter)
+}
+
+func (w *Workspace) GetJobsWithContext(ctx context.Context, resourceGroup, workspace string, filter JobFilter) ([]Job, error) {
+	pager := w.NewJobPager(resourceGroup, workspace, filter)
+	result := make([]Job, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewJobPager Return a pager for iterating page by page over the jobs of the workspace matching the filter
+// provided as argument.
+func (w *Workspace) NewJobPager(resourceGroup, workspace string, filter JobFilter) *JobPager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), jobsPath(filter)), w.jobConverter.unmarshalJobArray)
+}
+
+func (w *Workspace) GetJob(resourceGroup, workspace, name string) (*Job, error) {
+	return w.GetJobWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetJobWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Job, error) {
+	path := fmt.Sprintf("jobs/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"job", name})
+	if err != nil {
+		return nil, err
+	}
+	return w.jobConverter.unmarshalJob(body), nil
+}
+
+// CreateOrUpdateJob Submit the job provided as argument. Once submitted, only the fields such as the tags, the
+// description and the display name of a job can be updated.
+func (w *Workspace) CreateOrUpdateJob(resourceGroup, workspace string, job *Job) (*Job, error) {
+	return w.CreateOrUpdateJobWithContext(context.Background(), resourceGroup, workspace, job)
+}
+
+func (w *Workspace) CreateOrUpdateJobWithContext(ctx context.Context, resourceGroup, workspace string, job *Job) (*Job, error) {
+	if err := validateJob(job); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("jobs/%s", job.Name)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteJobSchema(job))
+	if err != nil {
+		return nil, err
+	}
+	return w.jobConverter.unmarshalJob(body), nil
+}
+
+// CancelJob Request the cancellation of the job with the name provided as argument. The job is canceled
+// asynchronously, use WaitForJob for waiting until it is actually canceled.
+func (w *Workspace) CancelJob(resourceGroup, workspace, name string) error {
+	return w.CancelJobWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) CancelJobWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	path := fmt.Sprintf("jobs/%s/cancel", name)
+	_, err := w.postResource(ctx, resourceGroup, workspace, path, nil, &ResourceNotFoundError{"job", name})
+	return err
+}
+
+// WaitForJob Retrieve the job with the name provided as argument every pollInterval until it reaches a terminal
+// status, then return it. If pollInterval is not positive, DefaultJobPollInterval is used. A JobUnresponsiveError is
+// returned if the job stays unresponsive for longer than DefaultJobUnresponsiveTimeout.
+func (w *Workspace) WaitForJob(resourceGroup, workspace, name string, pollInterval time.Duration) (*Job, error) {
+	return w.WaitForJobWithContext(context.Background(), resourceGroup, workspace, name, pollInterval)
+}
+
+func (w *Workspace) WaitForJobWithContext(ctx context.Context, resourceGroup, workspace, name string, pollInterval time.Duration) (*Job, error) {
+	return w.WatchJobWithContext(ctx, resourceGroup, workspace, name, WatchJobOptions{PollInterval: pollInterval}, nil)
+}
+
+// jobsPath Return the path for listing the jobs matching the filter provided as argument
+func jobsPath(filter JobFilter) string {
+	query := url.Values{}
+	if filter.JobType != "" {
+		query.Set("jobType", string(filter.JobType))
+	}
+	if filter.Tag != "" {
+		query.Set("tag", filter.Tag)
+	}
+	if filter.ListViewType != "" {
+		query.Set("listViewType", string(filter.ListViewType))
+	}
+	if len(query) == 0 {
+		return "jobs"
+	}
+	return fmt.Sprintf("jobs?%s", query.Encode())
+}
+
+func validateJob(job *Job) error {
+	if strings.TrimSpace(job.Name) == "" {
+		return InvalidArgumentError{"the job name cannot be empty"}
+	}
+
+	switch job.JobType {
+	case JobTypeCommand:
+		if job.Command == nil {
+			return InvalidArgumentError{"the properties of the command job cannot be empty"}
+		}
+		return validateCommandJob(job.Command)
+	case JobTypeSweep:
+		if job.Sweep == nil {
+			return InvalidArgumentError{"the properties of the sweep job cannot be empty"}
+		}
+		return validateSweepJob(job.Sweep)
+	case JobTypePipeline:
+		if job.Pipeline == nil {
+			return InvalidArgumentError{"the properties of the pipeline job cannot be empty"}
+		}
+		return validatePipelineJob(job.Pipeline)
+	default:
+		return InvalidArgumentError{fmt.Sprintf("unsupported job type %q", job.JobType)}
+	}
+}
+
+func validateCommandJob(command *CommandJob) error {
+	if strings.TrimSpace(command.Command) == "" {
+		return InvalidArgumentError{"the command of the job cannot be empty"}
+	}
+	if strings.TrimSpace(command.EnvironmentId) == "" {
+		return InvalidArgumentError{"the environment of the job cannot be empty"}
+	}
+	for name, input := range command.Inputs {
+		if err := validateJobInput(name, input); err != nil {
+			return err
+		}
+	}
+	for name, output := range command.Outputs {
+		switch output.OutputType {
+		case JobOutputTypeUriFile, JobOutputTypeUriFolder, JobOutputTypeMLTable,
+			JobOutputTypeCustomModel, JobOutputTypeMLflowModel, JobOutputTypeTritonModel:
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid type %q of output %s", output.OutputType, name)}
+		}
+	}
+	if distribution := command.Distribution; distribution != nil {
+		switch distribution.DistributionType {
+		case DistributionTypePyTorch, DistributionTypeTensorFlow, DistributionTypeMpi:
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid distribution type %q", distribution.DistributionType)}
+		}
+	}
+	if command.Timeout < 0 {
+		return InvalidArgumentError{"the timeout of the job cannot be negative"}
+	}
+	return nil
+}
+
+func validateJobInput(name string, input JobInput) error {
+	switch input.InputType {
+	case JobInputTypeLiteral:
+		return nil
+	case JobInputTypeUriFile, JobInputTypeUriFolder, JobInputTypeMLTable,
+		JobInputTypeCustomModel, JobInputTypeMLflowModel, JobInputTypeTritonModel:
+		if strings.TrimSpace(input.Uri) == "" {
+			return InvalidArgumentError{fmt.Sprintf("the URI of input %s cannot be empty", name)}
+		}
+		return nil
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid type %q of input %s", input.InputType, name)}
+	}
+}
@@ -0,0 +1,270 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func getMockedCommandJob() *Job {
+	return &Job{
+		Name:           "train-1",
+		JobType:        JobTypeCommand,
+		ExperimentName: "experiment",
+		ComputeId:      "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/computes/cluster",
+		Command: &CommandJob{
+			Command:       "python train.py --data ${{inputs.data}} --lr ${{inputs.lr}}",
+			EnvironmentId: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/environments/env/versions/1",
+			Inputs: map[string]JobInput{
+				"data": NewDatastoreJobInput(JobInputTypeUriFolder, DatastorePath{DatastoreName: "workspaceblobstore", Path: "data/"}),
+				"lr":   NewLiteralJobInput("0.01"),
+			},
+			Outputs: map[string]JobOutput{
+				"model": {OutputType: JobOutputTypeMLflowModel},
+			},
+			Timeout: time.Hour,
+		},
+	}
+}
+
+func TestJobStatus_IsTerminal(t *testing.T) {
+	a := assert.New(t)
+
+	for _, status := range []JobStatus{JobStatusCompleted, JobStatusFailed, JobStatusCanceled} {
+		a.True(status.IsTerminal(), status)
+	}
+	for _, status := range []JobStatus{
+		JobStatusNotStarted, JobStatusQueued, JobStatusPreparing, JobStatusRunning, JobStatusCancelRequested,
+		JobStatusNotResponding, JobStatusPaused, "",
+	} {
+		a.False(status.IsTerminal(), status)
+	}
+}
+
+func TestJobStatus_IsUnresponsive(t *testing.T) {
+	a := assert.New(t)
+
+	for _, status := range []JobStatus{JobStatusNotResponding, JobStatusUnknown, "Hibernating", ""} {
+		a.True(status.isUnresponsive(), status)
+	}
+	for _, status := range []JobStatus{JobStatusQueued, JobStatusRunning, JobStatusPaused, JobStatusCompleted} {
+		a.False(status.isUnresponsive(), status)
+	}
+}
+
+func TestNewDataAssetJobInput(t *testing.T) {
+	a := assert.New(t)
+
+	input := NewDataAssetJobInput(getMockedDataAsset())
+	a.Equal(JobInput{InputType: JobInputTypeUriFile, Uri: "azureml:dataset:3"}, input)
+}
+
+func TestUnmarshalJob(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &JobConverter{l.Sugar()}
+
+	job := converter.unmarshalJob(loadExampleResp("example_resp_get_command_job.json"))
+	a.Equal("train-1", job.Name)
+	a.Equal("train", job.DisplayName)
+	a.Equal("experiment", job.ExperimentName)
+	a.Equal(JobTypeCommand, job.JobType)
+	a.Equal(JobStatusRunning, job.Status)
+	a.Equal(map[string]string{"team": "ml"}, job.Tags)
+	a.Equal("creationUser", job.SystemData.CreationUser)
+
+	command := job.Command
+	a.Equal("python train.py --data ${{inputs.data}} --lr ${{inputs.lr}}", command.Command)
+	a.Equal(map[string]string{"LOG_LEVEL": "debug"}, command.EnvironmentVariables)
+	a.Equal(map[string]JobInput{
+		"data": {InputType: JobInputTypeUriFolder, Uri: "azureml://datastores/workspaceblobstore/paths/data/", Mode: DataModeReadOnlyMount},
+		"lr":   {InputType: JobInputTypeLiteral, Value: "0.01"},
+	}, command.Inputs)
+	a.Equal(map[string]JobOutput{"model": {OutputType: JobOutputTypeMLflowModel, Mode: DataModeReadWriteMount}}, command.Outputs)
+	a.Equal(&Distribution{DistributionType: DistributionTypePyTorch, ProcessCountPerInstance: 2}, command.Distribution)
+	a.Equal(2, command.Resources.InstanceCount)
+	a.Equal(time.Hour, command.Timeout)
+
+	jobs := converter.unmarshalJobArray(loadExampleResp("example_resp_get_jobs.json"))
+	a.Len(jobs, 2)
+	a.Equal(JobType("Sweep"), jobs[1].JobType)
+	a.Nil(jobs[1].Command)
+}
+
+func TestToWriteJobSchema(t *testing.T) {
+	a := assert.New(t)
+
+	schema := toWriteJobSchema(getMockedCommandJob()).Properties.(WriteCommandJobSchema)
+	a.Equal(JobTypeCommand, schema.JobType)
+	a.Equal("experiment", schema.ExperimentName)
+	a.Equal(map[string]JobInputSchema{
+		"data": {JobInputType: JobInputTypeUriFolder, Uri: "azureml://datastores/workspaceblobstore/paths/data/"},
+		"lr":   {JobInputType: JobInputTypeLiteral, Value: "0.01"},
+	}, schema.Inputs)
+	a.Equal(map[string]JobOutputSchema{"model": {JobOutputType: JobOutputTypeMLflowModel}}, schema.Outputs)
+	a.Nil(schema.Distribution)
+	a.Nil(schema.Resources)
+	a.Equal(&JobLimitsSchema{JobLimitsType: "Command", Timeout: "PT3600S"}, schema.Limits)
+}
+
+func TestJobsPath(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("jobs", jobsPath(JobFilter{}))
+	a.Equal("jobs?jobType=Command&listViewType=All&tag=team", jobsPath(JobFilter{JobType: JobTypeCommand, Tag: "team", ListViewType: ListViewTypeAll}))
+}
+
+func TestValidateJob(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateJob(getMockedCommandJob()))
+
+	invalidJobs := []func(job *Job){
+		func(job *Job) { job.Name = " " },
+		func(job *Job) { job.JobType = "foo" },
+		func(job *Job) { job.Command = nil },
+		func(job *Job) { job.Command.Command = "" },
+		func(job *Job) { job.Command.EnvironmentId = "" },
+		func(job *Job) { job.Command.Inputs["data"] = JobInput{InputType: JobInputTypeUriFolder} },
+		func(job *Job) { job.Command.Inputs["data"] = JobInput{InputType: "foo", Uri: "uri"} },
+		func(job *Job) { job.Command.Outputs["model"] = JobOutput{OutputType: "literal"} },
+		func(job *Job) { job.Command.Distribution = &Distribution{DistributionType: "Ray"} },
+		func(job *Job) { job.Command.Timeout = -time.Second },
+	}
+	for _, invalidate := range invalidJobs {
+		job := getMockedCommandJob()
+		invalidate(job)
+		a.IsType(InvalidArgumentError{}, validateJob(job))
+	}
+}
+
+func TestWorkspace_Jobs(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get jobs with filter",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs?jobType=Command").Return(http.StatusOK, string(loadExampleResp("example_resp_get_jobs.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				jobs, err := ws.GetJobs("rg", "ws", JobFilter{JobType: JobTypeCommand})
+				a.Nil(err)
+				a.Len(jobs, 2)
+				a.Equal("train-1", jobs[0].Name)
+			},
+		},
+		{
+			testCaseName: "Test get job not found",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				job, err := ws.GetJob("rg", "ws", "foo")
+				a.Nil(job)
+				a.Equal(&ResourceNotFoundError{"job", "foo"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create or update job",
+			testCase: func() {
+				job := getMockedCommandJob()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "jobs/train-1", toWriteJobSchema(job)).Return(http.StatusCreated, string(loadExampleResp("example_resp_get_command_job.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateJob("rg", "ws", job)
+				a.Nil(err)
+				a.Equal("train-1", result.Name)
+				a.Equal(JobStatusRunning, result.Status)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test create or update invalid job",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateJob("rg", "ws", &Job{Name: "foo", JobType: JobTypeCommand})
+				a.Nil(result)
+				a.IsType(InvalidArgumentError{}, err)
+				mockedHttpClient.AssertNotCalled(t, "doPut", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			testCaseName: "Test cancel job",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPost", "jobs/train-1/cancel", mock.Anything).Return(http.StatusAccepted, "", nil)
+				mockedHttpClient.On("doPost", "jobs/foo/cancel", mock.Anything).Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				a.Nil(ws.CancelJob("rg", "ws", "train-1"))
+				a.Equal(&ResourceNotFoundError{"job", "foo"}, ws.CancelJob("rg", "ws", "foo"))
+			},
+		},
+		{
+			testCaseName: "Test wait for job until terminal status",
+			testCase: func() {
+				running := string(loadExampleResp("example_resp_get_command_job.json"))
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, running, nil).Twice()
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, `{"name": "train-1", "properties": {"jobType": "Command", "status": "Failed"}}`, nil).Once()
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				job, err := ws.WaitForJob("rg", "ws", "train-1", time.Millisecond)
+				a.Nil(err)
+				a.Equal(JobStatusFailed, job.Status)
+				mockedHttpClient.AssertNumberOfCalls(t, "doGet", 3)
+			},
+		},
+		{
+			testCaseName: "Test wait for job resumed after being paused",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				for _, status := range []JobStatus{JobStatusPaused, JobStatusRunning, JobStatusCompleted} {
+					mockedHttpClient.On("doGet", "jobs/train-1").Return(
+						http.StatusOK, fmt.Sprintf(`{"name": "train-1", "properties": {"jobType": "Command", "status": "%s"}}`, status), nil,
+					).Once()
+				}
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				job, err := ws.WaitForJob("rg", "ws", "train-1", time.Millisecond)
+				a.Nil(err)
+				a.Equal(JobStatusCompleted, job.Status)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test wait for job context cancelled",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "jobs/train-1").Return(http.StatusOK, string(loadExampleResp("example_resp_get_command_job.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+				defer cancel()
+				job, err := ws.WaitForJobWithContext(ctx, "rg", "ws", "train-1", time.Hour)
+				a.Nil(job)
+				a.Equal(context.DeadlineExceeded, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
@@ -16,58 +16,49 @@ func (m MockedHttpClientBuilder) newClient(_, _ string) HttpClientAPI {
 	return m.httpClient
 }
 
+func (m MockedHttpClientBuilder) newWorkspaceCollectionClient(_ string) HttpClientAPI {
+	return m.httpClient
+}
+
+// MockedHttpClient mocks the HttpClientAPI. The context is not recorded among the
+// arguments of the mocked calls, therefore expectations only need to match the remaining arguments.
 type MockedHttpClient struct {
 	mock.Mock
 }
 
-// expected args:
+// newMockedResponse Build the response returned by a mocked call from the following args:
 // - position 0: the response status code (int)
 // - position 1: the response body (string)
 // - position 2: the returned error (error)
-func (t MockedHttpClient) doGet(path string) (*http.Response, error) {
-	args := t.Called(path)
+// - position 3: the response headers (http.Header), optional
+func newMockedResponse(args mock.Arguments) (*http.Response, error) {
 	mockedResponse := &http.Response{
 		StatusCode: args.Int(0),
+		Header:     http.Header{},
 		Body:       ioutil.NopCloser(bytes.NewReader([]byte(args.String(1)))),
 	}
+	if len(args) > 3 {
+		mockedResponse.Header = args.Get(3).(http.Header)
+	}
 	return mockedResponse, args.Error(2)
 }
 
-// expected args:
-// - position 0: the response status code (int)
-// - position 1: the response body (string)
-// - position 2: the returned error (error)
-func (t MockedHttpClient) doGetWithContext(ctx context.Context, path string) (*http.Response, error) {
-	args := t.Called(ctx, path)
-	mockedResponse := &http.Response{
-		StatusCode: args.Int(0),
-		Body:       ioutil.NopCloser(bytes.NewReader([]byte(args.String(1)))),
-	}
-	return mockedResponse, args.Error(2)
+func (t *MockedHttpClient) doGet(_ context.Context, path string) (*http.Response, error) {
+	return newMockedResponse(t.Called(path))
 }
 
-// expected args:
-// - position 0: the response status code (int)
-// - position 1: the response body (string)
-// - position 2: the returned error (error)
-func (t MockedHttpClient) doDelete(path string) (*http.Response, error) {
-	args := t.Called(path)
-	mockedResponse := &http.Response{
-		StatusCode: args.Int(0),
-		Body:       ioutil.NopCloser(bytes.NewReader([]byte(args.String(1)))),
-	}
-	return mockedResponse, args.Error(2)
+func (t *MockedHttpClient) doGetUrl(_ context.Context, url string) (*http.Response, error) {
+	return newMockedResponse(t.Called(url))
 }
 
-// expected args:
-// - position 0: the response status code (int)
-// - position 1: the response body (string)
-// - position 2: the returned error (error)
-func (t MockedHttpClient) doPut(path string, requestBody interface{}) (*http.Response, error) {
-	args := t.Called(path, requestBody)
-	mockedResponse := &http.Response{
-		StatusCode: args.Int(0),
-		Body:       ioutil.NopCloser(bytes.NewReader([]byte(args.String(1)))),
-	}
-	return mockedResponse, args.Error(2)
+func (t *MockedHttpClient) doDelete(_ context.Context, path string) (*http.Response, error) {
+	return newMockedResponse(t.Called(path))
+}
+
+func (t *MockedHttpClient) doPut(_ context.Context, path string, requestBody interface{}) (*http.Response, error) {
+	return newMockedResponse(t.Called(path, requestBody))
+}
+
+func (t *MockedHttpClient) doPost(_ context.Context, path string, requestBody interface{}) (*http.Response, error) {
+	return newMockedResponse(t.Called(path, requestBody))
 }
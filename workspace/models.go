@@ -34,12 +34,29 @@ type Datastore struct {
 	IsDefault   bool
 	Description string
 
+	// Contents The storage referenced by the datastore, e.g. AzureBlobContents. If nil, the datastore is written
+	// as a storage account from StorageType, StorageAccountName and StorageContainerName.
+	Contents DatastoreContents
+
+	// StorageType, StorageAccountName, StorageContainerName The storage account referenced by the datastore and
+	// its container, file share or filesystem, set from Contents when reading the datastores. When writing, the
+	// ones which are set and were changed since the datastore was read take precedence over Contents.
+	// Deprecated: use Contents, which also supports the datastores not referencing a storage account.
 	StorageType          string
 	StorageAccountName   string
 	StorageContainerName string
 
+	// readStorage The deprecated storage fields as read from the APIs, for detecting the ones changed afterwards
+	readStorage storageFieldValues
+
+	// Credentials The credentials used by the datastore for accessing its storage, e.g. AccountKeyCredentials.
+	// If nil, the credentials are written from Auth. Set when reading only if the API returns the secrets.
+	Credentials DatastoreCredentials
+
 	SystemData *SystemData
-	Auth       *DatastoreAuth
+
+	// Deprecated: use Credentials, which also supports the SAS tokens and the certificates.
+	Auth *DatastoreAuth
 }
 
 type Dataset struct {
@@ -53,6 +70,1005 @@ type Dataset struct {
 	SystemData     *SystemData
 }
 
+type DataType string
+
+const (
+	DataTypeUriFile   DataType = "uri_file"
+	DataTypeUriFolder DataType = "uri_folder"
+	DataTypeMLTable   DataType = "mltable"
+)
+
+// DataAsset A version of a data asset, referencing a file (uri_file), a folder (uri_folder) or a folder containing
+// an MLTable file (mltable).
+type DataAsset struct {
+	Id          string
+	Name        string
+	Version     string
+	Description string
+	DataType    DataType
+
+	// DataUri The URI of the data, e.g. the string representation of a DatastorePath
+	DataUri string
+
+	Tags       map[string]string
+	Properties map[string]string
+	IsArchived bool
+	SystemData *SystemData
+}
+
+type ModelType string
+
+const (
+	ModelTypeCustom ModelType = "custom_model"
+	ModelTypeMLflow ModelType = "mlflow_model"
+	ModelTypeTriton ModelType = "triton_model"
+)
+
+// Model A registered model, containing all the versions with the same name
+type Model struct {
+	Id            string
+	Name          string
+	Description   string
+	Tags          map[string]string
+	Properties    map[string]string
+	IsArchived    bool
+	LatestVersion string
+	NextVersion   string
+	SystemData    *SystemData
+}
+
+// ModelVersion A version of a registered model
+type ModelVersion struct {
+	Id          string
+	Name        string
+	Version     string
+	Description string
+	ModelType   ModelType
+
+	// Path The path of the model files on a datastore
+	Path *DatastorePath
+
+	// Uri The URI of the model files returned by the API, e.g. the output of a job or a long-form datastore URI
+	// which cannot be parsed into Path. It is written only if Path is nil.
+	Uri string
+
+	// JobName The name of the job that produced the model, if any
+	JobName string
+
+	Tags       map[string]string
+	Properties map[string]string
+
+	// Flavors The flavors of the model, e.g. for MLflow models, mapped to their data
+	Flavors map[string]map[string]string
+
+	IsArchived bool
+	SystemData *SystemData
+}
+
+type ComponentType string
+
+const (
+	ComponentTypeCommand ComponentType = "command"
+)
+
+// ComponentIOType The type of an input or an output of a component. Outputs cannot have literal types.
+type ComponentIOType string
+
+const (
+	ComponentIOTypeUriFile     ComponentIOType = "uri_file"
+	ComponentIOTypeUriFolder   ComponentIOType = "uri_folder"
+	ComponentIOTypeMLTable     ComponentIOType = "mltable"
+	ComponentIOTypeCustomModel ComponentIOType = "custom_model"
+	ComponentIOTypeMLflowModel ComponentIOType = "mlflow_model"
+	ComponentIOTypeTritonModel ComponentIOType = "triton_model"
+	ComponentIOTypeString      ComponentIOType = "string"
+	ComponentIOTypeNumber      ComponentIOType = "number"
+	ComponentIOTypeInteger     ComponentIOType = "integer"
+	ComponentIOTypeBoolean     ComponentIOType = "boolean"
+)
+
+// ComponentInput An input declared by a component
+type ComponentInput struct {
+	Type        ComponentIOType
+	Description string
+	Optional    bool
+
+	// Default The default value of inputs with a literal type, if any
+	Default string
+}
+
+// ComponentOutput An output declared by a component
+type ComponentOutput struct {
+	Type        ComponentIOType
+	Description string
+}
+
+// Component A component, containing all the versions with the same name
+type Component struct {
+	Id            string
+	Name          string
+	Description   string
+	Tags          map[string]string
+	Properties    map[string]string
+	IsArchived    bool
+	LatestVersion string
+	SystemData    *SystemData
+}
+
+// ComponentVersion A version of a component, i.e. a reusable step of the pipelines
+type ComponentVersion struct {
+	Id            string
+	Name          string
+	Version       string
+	DisplayName   string
+	Description   string
+	ComponentType ComponentType
+
+	// Command The command template, referencing the inputs and the outputs as ${{inputs.<name>}} and
+	// ${{outputs.<name>}}
+	Command string
+
+	// CodeId The ARM resource ID of the code asset containing the source code of the component
+	CodeId string
+
+	// EnvironmentId The ARM resource ID of the environment version in which the command runs
+	EnvironmentId string
+
+	Inputs  map[string]ComponentInput
+	Outputs map[string]ComponentOutput
+
+	Tags       map[string]string
+	Properties map[string]string
+	IsArchived bool
+	SystemData *SystemData
+}
+
+type OsType string
+
+const (
+	OsTypeLinux   OsType = "Linux"
+	OsTypeWindows OsType = "Windows"
+)
+
+// Environment A registered environment, containing all the versions with the same name
+type Environment struct {
+	Id            string
+	Name          string
+	Description   string
+	Tags          map[string]string
+	Properties    map[string]string
+	IsArchived    bool
+	LatestVersion string
+	NextVersion   string
+	SystemData    *SystemData
+}
+
+// BuildContext The context used for building the Docker image of an environment
+type BuildContext struct {
+	// ContextUri The URI of the folder containing the Dockerfile and the files it uses
+	ContextUri string
+
+	// DockerfilePath The path of the Dockerfile relative to the root of the context. If empty, "Dockerfile" is used.
+	DockerfilePath string
+}
+
+// Route A route exposed by an inference server
+type Route struct {
+	Path string
+	Port int
+}
+
+// InferenceConfig The routes exposed by the inference server of an environment
+type InferenceConfig struct {
+	LivenessRoute  *Route
+	ReadinessRoute *Route
+	ScoringRoute   *Route
+}
+
+// EnvironmentVersion A version of a registered environment. Exactly one between Image and Build must be set.
+type EnvironmentVersion struct {
+	Id          string
+	Name        string
+	Version     string
+	Description string
+
+	// Image The Docker image of the environment, e.g. mcr.microsoft.com/azureml/openmpi4.1.0-ubuntu20.04
+	Image string
+
+	// Build The context for building the Docker image of the environment
+	Build *BuildContext
+
+	// CondaFile The content of the conda file installed on top of the Docker image
+	CondaFile string
+
+	OsType          OsType
+	InferenceConfig *InferenceConfig
+
+	// EnvironmentType Whether the environment is curated or created by users. Read-only.
+	EnvironmentType string
+
+	Tags       map[string]string
+	Properties map[string]string
+	IsArchived bool
+	SystemData *SystemData
+}
+
+type ComputeType string
+
+const (
+	ComputeTypeAmlCompute      ComputeType = "AmlCompute"
+	ComputeTypeComputeInstance ComputeType = "ComputeInstance"
+)
+
+type IdentityType string
+
+const (
+	IdentityTypeNone                       IdentityType = "None"
+	IdentityTypeSystemAssigned             IdentityType = "SystemAssigned"
+	IdentityTypeUserAssigned               IdentityType = "UserAssigned"
+	IdentityTypeSystemAssignedUserAssigned IdentityType = "SystemAssigned,UserAssigned"
+)
+
+// UnderlyingResourceAction The action performed on the resource underlying a compute when the compute is deleted
+type UnderlyingResourceAction string
+
+const (
+	UnderlyingResourceActionDelete UnderlyingResourceAction = "Delete"
+	UnderlyingResourceActionDetach UnderlyingResourceAction = "Detach"
+)
+
+// ManagedIdentity The managed identity assigned to a resource
+type ManagedIdentity struct {
+	Type IdentityType
+
+	// UserAssignedIdentities The ARM resource IDs of the user assigned identities
+	UserAssignedIdentities []string
+
+	// PrincipalId The principal ID of the system assigned identity. Read-only.
+	PrincipalId string
+
+	// TenantId The tenant ID of the system assigned identity. Read-only.
+	TenantId string
+}
+
+type ScaleSettings struct {
+	MinNodeCount int
+	MaxNodeCount int
+
+	// NodeIdleTimeBeforeScaleDown The time a node can be idle before being removed from the cluster
+	NodeIdleTimeBeforeScaleDown time.Duration
+}
+
+// AmlComputeProperties The properties of an AmlCompute cluster
+type AmlComputeProperties struct {
+	VmSize string
+
+	// VmPriority Either "Dedicated" or "LowPriority"
+	VmPriority    string
+	ScaleSettings *ScaleSettings
+
+	// SubnetId The ARM resource ID of the subnet in which the nodes are deployed
+	SubnetId string
+
+	// RemoteLoginPortPublicAccess One among "Enabled", "Disabled" and "NotSpecified"
+	RemoteLoginPortPublicAccess string
+
+	// AllocationState Whether the cluster is resizing or steady. Read-only.
+	AllocationState string
+
+	// CurrentNodeCount The number of nodes currently allocated. Read-only.
+	CurrentNodeCount int
+
+	// TargetNodeCount The number of nodes the cluster is resizing to. Read-only.
+	TargetNodeCount int
+}
+
+type AssignedUser struct {
+	ObjectId string
+	TenantId string
+}
+
+// ComputeInstanceProperties The properties of a compute instance
+type ComputeInstanceProperties struct {
+	VmSize string
+
+	// SubnetId The ARM resource ID of the subnet in which the instance is deployed
+	SubnetId string
+
+	SshPublicAccess   bool
+	SshAdminPublicKey string
+
+	// AssignedUser The user on behalf of which the instance is created, if any
+	AssignedUser *AssignedUser
+
+	// ApplicationSharingPolicy Either "Personal" or "Shared"
+	ApplicationSharingPolicy string
+
+	// State The state of the instance, e.g. "Running" or "Stopped". Read-only.
+	State string
+}
+
+// Compute A compute target of a workspace. Depending on the ComputeType, either AmlCompute or ComputeInstance is set.
+type Compute struct {
+	Id          string
+	Name        string
+	Location    string
+	Description string
+	ComputeType ComputeType
+
+	// ProvisioningState The provisioning state of the compute, e.g. "Creating" or "Succeeded". Read-only.
+	ProvisioningState string
+
+	Identity         *ManagedIdentity
+	DisableLocalAuth bool
+
+	AmlCompute      *AmlComputeProperties
+	ComputeInstance *ComputeInstanceProperties
+
+	SystemData *SystemData
+}
+
+// ComputeNode A node of an AmlCompute cluster
+type ComputeNode struct {
+	NodeId           string
+	PrivateIpAddress string
+	PublicIpAddress  string
+	Port             int
+
+	// NodeState The state of the node, e.g. "idle", "running" or "preempted"
+	NodeState string
+
+	// RunId The ID of the run executing on the node, if any
+	RunId string
+}
+
+type JobType string
+
+const (
+	JobTypeCommand  JobType = "Command"
+	JobTypeSweep    JobType = "Sweep"
+	JobTypePipeline JobType = "Pipeline"
+)
+
+type JobStatus string
+
+const (
+	JobStatusNotStarted      JobStatus = "NotStarted"
+	JobStatusStarting        JobStatus = "Starting"
+	JobStatusProvisioning    JobStatus = "Provisioning"
+	JobStatusPreparing       JobStatus = "Preparing"
+	JobStatusQueued          JobStatus = "Queued"
+	JobStatusRunning         JobStatus = "Running"
+	JobStatusFinalizing      JobStatus = "Finalizing"
+	JobStatusCancelRequested JobStatus = "CancelRequested"
+	JobStatusCompleted       JobStatus = "Completed"
+	JobStatusFailed          JobStatus = "Failed"
+	JobStatusCanceled        JobStatus = "Canceled"
+	JobStatusNotResponding   JobStatus = "NotResponding"
+	JobStatusPaused          JobStatus = "Paused"
+	JobStatusUnknown         JobStatus = "Unknown"
+)
+
+// IsTerminal Return true if the job has reached a state from which it will not progress anymore, i.e. Completed,
+// Failed or Canceled. NotResponding and Paused jobs are still live, since they can resume running.
+func (s JobStatus) IsTerminal() bool {
+	switch s {
+	case JobStatusCompleted, JobStatusFailed, JobStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// isUnresponsive Return true if the status of the job does not tell whether it is progressing, i.e. NotResponding,
+// Unknown or a status not known by the SDK.
+func (s JobStatus) isUnresponsive() bool {
+	switch s {
+	case JobStatusNotStarted, JobStatusStarting, JobStatusProvisioning, JobStatusPreparing, JobStatusQueued,
+		JobStatusRunning, JobStatusFinalizing, JobStatusCancelRequested, JobStatusCompleted, JobStatusFailed,
+		JobStatusCanceled, JobStatusPaused:
+		return false
+	default:
+		return true
+	}
+}
+
+// ListViewType Whether the list operations return the active resources, the archived ones or both
+type ListViewType string
+
+const (
+	ListViewTypeActiveOnly   ListViewType = "ActiveOnly"
+	ListViewTypeArchivedOnly ListViewType = "ArchivedOnly"
+	ListViewTypeAll          ListViewType = "All"
+)
+
+// JobFilter The filters applied when listing the jobs of a workspace. Empty fields are ignored.
+type JobFilter struct {
+	JobType JobType
+
+	// Tag Only return the jobs having this tag
+	Tag string
+
+	ListViewType ListViewType
+}
+
+type JobInputType string
+
+const (
+	JobInputTypeLiteral     JobInputType = "literal"
+	JobInputTypeUriFile     JobInputType = "uri_file"
+	JobInputTypeUriFolder   JobInputType = "uri_folder"
+	JobInputTypeMLTable     JobInputType = "mltable"
+	JobInputTypeCustomModel JobInputType = "custom_model"
+	JobInputTypeMLflowModel JobInputType = "mlflow_model"
+	JobInputTypeTritonModel JobInputType = "triton_model"
+)
+
+type JobOutputType string
+
+const (
+	JobOutputTypeUriFile     JobOutputType = "uri_file"
+	JobOutputTypeUriFolder   JobOutputType = "uri_folder"
+	JobOutputTypeMLTable     JobOutputType = "mltable"
+	JobOutputTypeCustomModel JobOutputType = "custom_model"
+	JobOutputTypeMLflowModel JobOutputType = "mlflow_model"
+	JobOutputTypeTritonModel JobOutputType = "triton_model"
+)
+
+// DataMode How the data of the inputs and outputs of a job is made available to the job
+type DataMode string
+
+const (
+	DataModeReadOnlyMount  DataMode = "ReadOnlyMount"
+	DataModeReadWriteMount DataMode = "ReadWriteMount"
+	DataModeDownload       DataMode = "Download"
+	DataModeUpload         DataMode = "Upload"
+	DataModeDirect         DataMode = "Direct"
+)
+
+// JobInput An input of a job. Literal inputs have a Value, while the other inputs reference their data by Uri.
+type JobInput struct {
+	InputType   JobInputType
+	Description string
+
+	// Uri Either the string representation of a DatastorePath or a reference to an asset, e.g. azureml:name:version
+	Uri  string
+	Mode DataMode
+
+	Value string
+}
+
+// NewLiteralJobInput Return a job input with the literal value provided as argument
+func NewLiteralJobInput(value string) JobInput {
+	return JobInput{InputType: JobInputTypeLiteral, Value: value}
+}
+
+// NewDatastoreJobInput Return a job input reading the data at the datastore path provided as argument
+func NewDatastoreJobInput(inputType JobInputType, path DatastorePath) JobInput {
+	return JobInput{InputType: inputType, Uri: path.String()}
+}
+
+// NewDataAssetJobInput Return a job input reading the version of the data asset provided as argument
+func NewDataAssetJobInput(dataAsset *DataAsset) JobInput {
+	return JobInput{
+		InputType: JobInputType(dataAsset.DataType),
+		Uri:       fmt.Sprintf("azureml:%s:%s", dataAsset.Name, dataAsset.Version),
+	}
+}
+
+// NewDatasetJobInput Return a job input reading the files of the version of the dataset provided as argument
+func NewDatasetJobInput(dataset *Dataset) JobInput {
+	return JobInput{
+		InputType: JobInputTypeUriFolder,
+		Uri:       fmt.Sprintf("azureml:%s:%d", dataset.Name, dataset.Version),
+	}
+}
+
+// JobOutput An output of a job. If Uri is empty, the output is written to the default datastore of the workspace.
+type JobOutput struct {
+	OutputType  JobOutputType
+	Description string
+	Uri         string
+	Mode        DataMode
+}
+
+// NewDatastoreJobOutput Return a job output writing the data to the datastore path provided as argument
+func NewDatastoreJobOutput(outputType JobOutputType, path DatastorePath) JobOutput {
+	return JobOutput{OutputType: outputType, Uri: path.String()}
+}
+
+type DistributionType string
+
+const (
+	DistributionTypePyTorch    DistributionType = "PyTorch"
+	DistributionTypeTensorFlow DistributionType = "TensorFlow"
+	DistributionTypeMpi        DistributionType = "Mpi"
+)
+
+// Distribution The configuration of a distributed job. ProcessCountPerInstance applies to PyTorch and Mpi,
+// while WorkerCount and ParameterServerCount apply to TensorFlow.
+type Distribution struct {
+	DistributionType        DistributionType
+	ProcessCountPerInstance int
+	WorkerCount             int
+	ParameterServerCount    int
+}
+
+// JobResources The compute resources allocated to a job
+type JobResources struct {
+	InstanceCount int
+
+	// InstanceType The VM size to use, if the compute supports multiple ones
+	InstanceType string
+
+	Properties map[string]string
+}
+
+// CommandJob The properties of a job running a command
+type CommandJob struct {
+	Command string
+
+	// CodeId The ARM resource ID of the code asset containing the source code of the job
+	CodeId string
+
+	// EnvironmentId The ARM resource ID of the environment version in which the command runs
+	EnvironmentId string
+
+	EnvironmentVariables map[string]string
+	Inputs               map[string]JobInput
+	Outputs              map[string]JobOutput
+	Distribution         *Distribution
+	Resources            *JobResources
+
+	// Timeout The maximum run duration of the job. Zero means no limit.
+	Timeout time.Duration
+}
+
+type SamplingAlgorithm string
+
+const (
+	SamplingAlgorithmRandom   SamplingAlgorithm = "Random"
+	SamplingAlgorithmGrid     SamplingAlgorithm = "Grid"
+	SamplingAlgorithmBayesian SamplingAlgorithm = "Bayesian"
+)
+
+type Goal string
+
+const (
+	GoalMinimize Goal = "Minimize"
+	GoalMaximize Goal = "Maximize"
+)
+
+// Objective The metric logged by the trials that the sweep job optimizes
+type Objective struct {
+	PrimaryMetric string
+	Goal          Goal
+}
+
+type EarlyTerminationPolicyType string
+
+const (
+	EarlyTerminationPolicyTypeBandit         EarlyTerminationPolicyType = "Bandit"
+	EarlyTerminationPolicyTypeMedianStopping EarlyTerminationPolicyType = "MedianStopping"
+	EarlyTerminationPolicyTypeTruncation     EarlyTerminationPolicyType = "TruncationSelection"
+)
+
+// EarlyTerminationPolicy The policy for terminating the poorly performing trials of a sweep job. Bandit policies
+// use either SlackFactor or SlackAmount, while truncation policies use TruncationPercentage.
+type EarlyTerminationPolicy struct {
+	PolicyType EarlyTerminationPolicyType
+
+	// EvaluationInterval The frequency of the evaluations of the policy, in number of metric reports
+	EvaluationInterval int
+
+	// DelayEvaluation The number of metric reports before the first evaluation of the policy
+	DelayEvaluation int
+
+	SlackFactor          float64
+	SlackAmount          float64
+	TruncationPercentage int
+}
+
+// SweepLimits The limits of a sweep job. Zero values mean no limit.
+type SweepLimits struct {
+	MaxTotalTrials      int
+	MaxConcurrentTrials int
+	Timeout             time.Duration
+}
+
+// SweepJob The properties of a hyperparameter tuning job, running the trial command with the hyperparameters
+// sampled from the search space. The command references the hyperparameters as ${{search_space.<name>}}.
+type SweepJob struct {
+	SearchSpace       map[string]SearchDistribution
+	SamplingAlgorithm SamplingAlgorithm
+	Objective         Objective
+	EarlyTermination  *EarlyTerminationPolicy
+	Limits            SweepLimits
+
+	// Trial The command run by each trial. Its inputs and outputs are the ones of the sweep job, while its
+	// timeout is the one of each trial.
+	Trial *CommandJob
+}
+
+// PipelineBindingSource The source of the value of an input of a pipeline step
+type PipelineBindingSource string
+
+const (
+	PipelineBindingSourceLiteral       PipelineBindingSource = "Literal"
+	PipelineBindingSourcePipelineInput PipelineBindingSource = "PipelineInput"
+	PipelineBindingSourceStepOutput    PipelineBindingSource = "StepOutput"
+)
+
+// PipelineBinding The value bound to an input of a pipeline step: a literal Value, the input of the pipeline
+// called Name, or the output called Name of the step called Step.
+type PipelineBinding struct {
+	Source PipelineBindingSource
+	Step   string
+	Name   string
+	Value  string
+}
+
+func LiteralBinding(value string) PipelineBinding {
+	return PipelineBinding{Source: PipelineBindingSourceLiteral, Value: value}
+}
+
+func PipelineInputBinding(name string) PipelineBinding {
+	return PipelineBinding{Source: PipelineBindingSourcePipelineInput, Name: name}
+}
+
+func StepOutputBinding(step, output string) PipelineBinding {
+	return PipelineBinding{Source: PipelineBindingSourceStepOutput, Step: step, Name: output}
+}
+
+// PipelineStep A step of a pipeline job, running a component
+type PipelineStep struct {
+	Name string
+
+	// ComponentId The ARM resource ID of the component version run by the step
+	ComponentId string
+
+	// ComputeId The ARM resource ID of the compute on which the step runs. If empty, the default compute of the
+	// pipeline is used.
+	ComputeId string
+
+	// Inputs The values bound to the inputs of the component
+	Inputs map[string]PipelineBinding
+
+	// Outputs The names of the pipeline outputs to which the outputs of the component are promoted
+	Outputs map[string]string
+}
+
+// PipelineJob The properties of a job running a graph of steps
+type PipelineJob struct {
+	Inputs  map[string]JobInput
+	Outputs map[string]JobOutput
+
+	// DefaultComputeId The ARM resource ID of the compute used by the steps without a compute
+	DefaultComputeId      string
+	ContinueOnStepFailure bool
+
+	Steps map[string]PipelineStep
+}
+
+// Job A job of a workspace. Depending on the JobType, the respective properties are set.
+type Job struct {
+	Id             string
+	Name           string
+	DisplayName    string
+	Description    string
+	ExperimentName string
+	JobType        JobType
+
+	// ComputeId The ARM resource ID of the compute on which the job runs
+	ComputeId string
+
+	// Status The status of the job. Read-only.
+	Status JobStatus
+
+	Tags       map[string]string
+	Properties map[string]string
+	IsArchived bool
+
+	Command  *CommandJob
+	Sweep    *SweepJob
+	Pipeline *PipelineJob
+
+	SystemData *SystemData
+}
+
+// EndpointAuthMode How the clients of an endpoint authenticate
+type EndpointAuthMode string
+
+const (
+	EndpointAuthModeKey      EndpointAuthMode = "Key"
+	EndpointAuthModeAMLToken EndpointAuthMode = "AMLToken"
+	EndpointAuthModeAADToken EndpointAuthMode = "AADToken"
+)
+
+// OnlineEndpoint An endpoint serving real-time inference requests, which are routed to its deployments
+type OnlineEndpoint struct {
+	Id          string
+	Name        string
+	Location    string
+	Description string
+	AuthMode    EndpointAuthMode
+
+	// Traffic The percentage of the requests routed to each deployment, by deployment name. The percentages
+	// must sum up to 100, unless they are all zero.
+	Traffic map[string]int
+
+	// MirrorTraffic The percentage of the requests copied to each deployment, whose responses are discarded
+	MirrorTraffic map[string]int
+
+	// Identity The managed identity of the endpoint. If nil, a system assigned identity is used.
+	Identity *ManagedIdentity
+
+	// PublicNetworkAccess Whether the endpoint can be scored from public networks. If empty, the default of the
+	// APIs is used.
+	PublicNetworkAccess PublicNetworkAccess
+
+	// Kind, Compute The kind of the endpoint, e.g. Managed, and the ARM ID of the Kubernetes compute hosting it,
+	// empty for the managed endpoints
+	Kind    string
+	Compute string
+
+	ScoringUri        string
+	SwaggerUri        string
+	ProvisioningState string
+	Tags              map[string]string
+	Properties        map[string]string
+	SystemData        *SystemData
+}
+
+type ScaleType string
+
+const (
+	ScaleTypeDefault           ScaleType = "Default"
+	ScaleTypeTargetUtilization ScaleType = "TargetUtilization"
+)
+
+// OnlineScaleSettings How the instances of an online deployment are scaled. With the Default scale type the
+// deployment always has InstanceCount instances, while with TargetUtilization the instances are autoscaled between
+// MinInstances and MaxInstances according to their utilization.
+type OnlineScaleSettings struct {
+	ScaleType                   ScaleType
+	MinInstances                int
+	MaxInstances                int
+	PollingInterval             time.Duration
+	TargetUtilizationPercentage int
+}
+
+// OnlineRequestSettings How the scoring requests are handled by the instances of an online deployment
+type OnlineRequestSettings struct {
+	MaxConcurrentRequestsPerInstance int
+	RequestTimeout                   time.Duration
+	MaxQueueWait                     time.Duration
+}
+
+// ProbeSettings The settings of a liveness or readiness probe of an online deployment
+type ProbeSettings struct {
+	FailureThreshold int
+	SuccessThreshold int
+	InitialDelay     time.Duration
+	Period           time.Duration
+	Timeout          time.Duration
+}
+
+// CodeConfiguration The code of a deployment and the script, relative to the code, handling the requests
+type CodeConfiguration struct {
+	CodeId        string
+	ScoringScript string
+}
+
+// OnlineDeployment A managed deployment of a model behind an online endpoint
+type OnlineDeployment struct {
+	Id           string
+	Name         string
+	EndpointName string
+	Location     string
+	Description  string
+
+	// ModelId The ARM resource ID of the model version served by the deployment
+	ModelId string
+
+	// EnvironmentId The ARM resource ID of the environment version in which the scoring script runs
+	EnvironmentId        string
+	CodeConfiguration    *CodeConfiguration
+	EnvironmentVariables map[string]string
+
+	// InstanceType The VM size of the instances, e.g. Standard_DS3_v2
+	InstanceType string
+
+	// InstanceCount The number of instances of the deployment, used with the Default scale type
+	InstanceCount int
+
+	ScaleSettings      *OnlineScaleSettings
+	RequestSettings    *OnlineRequestSettings
+	LivenessProbe      *ProbeSettings
+	ReadinessProbe     *ProbeSettings
+	AppInsightsEnabled bool
+
+	ProvisioningState string
+	Tags              map[string]string
+	Properties        map[string]string
+	SystemData        *SystemData
+}
+
+// BatchEndpoint An endpoint scoring large amounts of data asynchronously, through the jobs run by its deployments.
+// Batch endpoints only support the AADToken auth mode.
+type BatchEndpoint struct {
+	Id          string
+	Name        string
+	Location    string
+	Description string
+
+	// DefaultDeployment The name of the deployment running the invocations that do not target a specific one
+	DefaultDeployment string
+
+	// Identity The managed identity of the endpoint. If nil, a system assigned identity is used.
+	Identity *ManagedIdentity
+
+	ScoringUri        string
+	SwaggerUri        string
+	ProvisioningState string
+	Tags              map[string]string
+	Properties        map[string]string
+	SystemData        *SystemData
+}
+
+// BatchOutputAction How a batch deployment stores the results of the scoring
+type BatchOutputAction string
+
+const (
+	// BatchOutputActionSummaryOnly The scoring script stores the results itself, the job only writes a summary
+	BatchOutputActionSummaryOnly BatchOutputAction = "SummaryOnly"
+
+	// BatchOutputActionAppendRow The results returned by the scoring script are appended to the output file
+	BatchOutputActionAppendRow BatchOutputAction = "AppendRow"
+)
+
+type BatchLoggingLevel string
+
+const (
+	BatchLoggingLevelInfo    BatchLoggingLevel = "Info"
+	BatchLoggingLevelWarning BatchLoggingLevel = "Warning"
+	BatchLoggingLevelDebug   BatchLoggingLevel = "Debug"
+)
+
+// BatchRetrySettings How many times a failed mini batch is retried, and after how long a mini batch times out
+type BatchRetrySettings struct {
+	MaxRetries int
+	Timeout    time.Duration
+}
+
+// BatchDeployment A deployment of a model behind a batch endpoint, scoring the data in mini batches on a compute
+// cluster of the workspace
+type BatchDeployment struct {
+	Id           string
+	Name         string
+	EndpointName string
+	Location     string
+	Description  string
+
+	// ModelId The ARM resource ID of the model version used for the scoring
+	ModelId string
+
+	// EnvironmentId The ARM resource ID of the environment version in which the scoring script runs
+	EnvironmentId        string
+	CodeConfiguration    *CodeConfiguration
+	EnvironmentVariables map[string]string
+
+	// ComputeId The ARM resource ID of the compute cluster running the jobs of the deployment
+	ComputeId     string
+	InstanceCount int
+
+	// MaxConcurrencyPerInstance The number of scoring processes run in parallel on each instance
+	MaxConcurrencyPerInstance int
+
+	// MiniBatchSize The number of files passed to each call of the scoring script
+	MiniBatchSize int
+
+	// ErrorThreshold The number of failed files after which the job is failed, -1 for ignoring the failures
+	ErrorThreshold int
+
+	LoggingLevel   BatchLoggingLevel
+	OutputAction   BatchOutputAction
+	OutputFileName string
+	RetrySettings  *BatchRetrySettings
+
+	ProvisioningState string
+	Tags              map[string]string
+	Properties        map[string]string
+	SystemData        *SystemData
+}
+
+// PublicNetworkAccess Whether a resource can be reached from public networks
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+)
+
+// ManagedNetworkIsolationMode The outbound traffic allowed from the network managed by AML for a workspace
+type ManagedNetworkIsolationMode string
+
+const (
+	ManagedNetworkIsolationModeDisabled                  ManagedNetworkIsolationMode = "Disabled"
+	ManagedNetworkIsolationModeAllowInternetOutbound     ManagedNetworkIsolationMode = "AllowInternetOutbound"
+	ManagedNetworkIsolationModeAllowOnlyApprovedOutbound ManagedNetworkIsolationMode = "AllowOnlyApprovedOutbound"
+)
+
+// ManagedNetwork The settings of the network managed by AML for the computes of a workspace
+type ManagedNetwork struct {
+	IsolationMode ManagedNetworkIsolationMode
+
+	// Status The status of the managed network, e.g. Active or Inactive. Read-only.
+	Status string
+}
+
+// AmlWorkspace An Azure Machine Learning workspace, as an ARM resource of its resource group
+type AmlWorkspace struct {
+	Id           string
+	Name         string
+	Location     string
+	FriendlyName string
+	Description  string
+
+	// Identity The managed identity of the workspace. If nil, a system assigned identity is used.
+	Identity *ManagedIdentity
+
+	// StorageAccountId, KeyVaultId The ARM resource IDs of the storage account and of the key vault associated
+	// with the workspace, both required for creating it
+	StorageAccountId string
+	KeyVaultId       string
+
+	// ContainerRegistryId, ApplicationInsightsId The ARM resource IDs of the optional container registry and
+	// application insights associated with the workspace
+	ContainerRegistryId   string
+	ApplicationInsightsId string
+
+	// HbiWorkspace Whether the workspace contains high business impact data, reducing the diagnostic data
+	// collected by the service
+	HbiWorkspace        bool
+	PublicNetworkAccess PublicNetworkAccess
+	ManagedNetwork      *ManagedNetwork
+
+	// WorkspaceId The immutable ID assigned to the workspace by the service. Read-only.
+	WorkspaceId string
+
+	// DiscoveryUrl The URL for discovering the regional endpoints of the workspace. Read-only.
+	DiscoveryUrl string
+
+	ProvisioningState string
+	Tags              map[string]string
+	SystemData        *SystemData
+}
+
+// ContainerRegistryCredentials The admin credentials of the container registry associated with a workspace
+type ContainerRegistryCredentials struct {
+	Location string
+	Username string
+
+	// Passwords The passwords of the registry, by password name
+	Passwords map[string]string
+}
+
+// NotebookAccessKeys The keys for accessing the notebooks of a workspace
+type NotebookAccessKeys struct {
+	PrimaryAccessKey   string
+	SecondaryAccessKey string
+}
+
+// AmlWorkspaceKeys The keys of the resources associated with a workspace
+type AmlWorkspaceKeys struct {
+	UserStorageKey                string
+	UserStorageResourceId         string
+	AppInsightsInstrumentationKey string
+	ContainerRegistryCredentials  *ContainerRegistryCredentials
+	NotebookAccessKeys            *NotebookAccessKeys
+}
+
 type DatasetPath interface {
 	fmt.Stringer
 }
@@ -0,0 +1,269 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (w *Workspace) GetOnlineEndpoints(resourceGroup, workspace string) ([]OnlineEndpoint, error) {
+	return w.GetOnlineEndpointsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetOnlineEndpointsWithContext(ctx context.Context, resourceGroup, workspace string) ([]OnlineEndpoint, error) {
+	pager := w.NewOnlineEndpointPager(resourceGroup, workspace)
+	result := make([]OnlineEndpoint, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewOnlineEndpointPager Return a pager for iterating page by page over the online endpoints of the workspace.
+func (w *Workspace) NewOnlineEndpointPager(resourceGroup, workspace string) *OnlineEndpointPager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "onlineEndpoints"), w.endpointConverter.unmarshalOnlineEndpointArray)
+}
+
+func (w *Workspace) GetOnlineEndpoint(resourceGroup, workspace, name string) (*OnlineEndpoint, error) {
+	return w.GetOnlineEndpointWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetOnlineEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*OnlineEndpoint, error) {
+	path := fmt.Sprintf("onlineEndpoints/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"online endpoint", name})
+	if err != nil {
+		return nil, err
+	}
+	return w.endpointConverter.unmarshalOnlineEndpoint(body), nil
+}
+
+// CreateOrUpdateOnlineEndpoint Start the creation or the update of the online endpoint provided as argument,
+// returning a poller for waiting until the endpoint is provisioned.
+func (w *Workspace) CreateOrUpdateOnlineEndpoint(resourceGroup, workspace string, endpoint *OnlineEndpoint) (*OnlineEndpointPoller, error) {
+	return w.CreateOrUpdateOnlineEndpointWithContext(context.Background(), resourceGroup, workspace, endpoint)
+}
+
+func (w *Workspace) CreateOrUpdateOnlineEndpointWithContext(ctx context.Context, resourceGroup, workspace string, endpoint *OnlineEndpoint) (*OnlineEndpointPoller, error) {
+	if err := validateOnlineEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("onlineEndpoints/%s", endpoint.Name)
+	operation, err := w.beginPutResource(ctx, resourceGroup, workspace, path, toWriteOnlineEndpointSchema(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindOnlineEndpoint, w.endpointConverter.unmarshalOnlineEndpoint), nil
+}
+
+// UpdateOnlineEndpointTraffic Start updating the percentages of the requests that the online endpoint with the
+// name provided as argument routes to each of its deployments. The deployments not included in traffic stop
+// receiving requests, while the other settings of the endpoint are written back as they are.
+func (w *Workspace) UpdateOnlineEndpointTraffic(resourceGroup, workspace, name string, traffic map[string]int) (*OnlineEndpointPoller, error) {
+	return w.UpdateOnlineEndpointTrafficWithContext(context.Background(), resourceGroup, workspace, name, traffic)
+}
+
+func (w *Workspace) UpdateOnlineEndpointTrafficWithContext(ctx context.Context, resourceGroup, workspace, name string, traffic map[string]int) (*OnlineEndpointPoller, error) {
+	if err := validateTraffic(traffic); err != nil {
+		return nil, err
+	}
+	endpoint, err := w.GetOnlineEndpointWithContext(ctx, resourceGroup, workspace, name)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.Traffic = traffic
+	return w.CreateOrUpdateOnlineEndpointWithContext(ctx, resourceGroup, workspace, endpoint)
+}
+
+// DeleteOnlineEndpoint Start the deletion of the online endpoint with the name provided as argument, together
+// with all its deployments.
+func (w *Workspace) DeleteOnlineEndpoint(resourceGroup, workspace, name string) (*DeletionPoller, error) {
+	return w.DeleteOnlineEndpointWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) DeleteOnlineEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*DeletionPoller, error) {
+	path := fmt.Sprintf("onlineEndpoints/%s", name)
+	operation, err := w.beginDeleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"online endpoint", name})
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindDeletion, unmarshalDeletion), nil
+}
+
+func (w *Workspace) GetOnlineDeployments(resourceGroup, workspace, endpointName string) ([]OnlineDeployment, error) {
+	return w.GetOnlineDeploymentsWithContext(context.Background(), resourceGroup, workspace, endpointName)
+}
+
+func (w *Workspace) GetOnlineDeploymentsWithContext(ctx context.Context, resourceGroup, workspace, endpointName string) ([]OnlineDeployment, error) {
+	pager := w.NewOnlineDeploymentPager(resourceGroup, workspace, endpointName)
+	result := make([]OnlineDeployment, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewOnlineDeploymentPager Return a pager for iterating page by page over the deployments of the online endpoint
+// with the name provided as argument.
+func (w *Workspace) NewOnlineDeploymentPager(resourceGroup, workspace, endpointName string) *OnlineDeploymentPager {
+	path := fmt.Sprintf("onlineEndpoints/%s/deployments", endpointName)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"online endpoint", endpointName}
+	return newTypedPager(pager, func(body []byte) []OnlineDeployment {
+		return w.endpointConverter.unmarshalOnlineDeploymentArray(endpointName, body)
+	})
+}
+
+func (w *Workspace) GetOnlineDeployment(resourceGroup, workspace, endpointName, name string) (*OnlineDeployment, error) {
+	return w.GetOnlineDeploymentWithContext(context.Background(), resourceGroup, workspace, endpointName, name)
+}
+
+func (w *Workspace) GetOnlineDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*OnlineDeployment, error) {
+	path := fmt.Sprintf("onlineEndpoints/%s/deployments/%s", endpointName, name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"online deployment", deploymentIdentifier(endpointName, name)})
+	if err != nil {
+		return nil, err
+	}
+	return w.endpointConverter.unmarshalOnlineDeployment(endpointName, body), nil
+}
+
+// CreateOrUpdateOnlineDeployment Start the creation or the update of the online deployment provided as argument,
+// returning a poller for waiting until the deployment is provisioned. New deployments receive no traffic until
+// it is assigned to them with UpdateOnlineEndpointTraffic.
+func (w *Workspace) CreateOrUpdateOnlineDeployment(resourceGroup, workspace string, deployment *OnlineDeployment) (*OnlineDeploymentPoller, error) {
+	return w.CreateOrUpdateOnlineDeploymentWithContext(context.Background(), resourceGroup, workspace, deployment)
+}
+
+func (w *Workspace) CreateOrUpdateOnlineDeploymentWithContext(ctx context.Context, resourceGroup, workspace string, deployment *OnlineDeployment) (*OnlineDeploymentPoller, error) {
+	if err := validateOnlineDeployment(deployment); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("onlineEndpoints/%s/deployments/%s", deployment.EndpointName, deployment.Name)
+	operation, err := w.beginPutResource(ctx, resourceGroup, workspace, path, toWriteOnlineDeploymentSchema(deployment))
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindOnlineDeployment, func(body []byte) *OnlineDeployment {
+		return w.endpointConverter.unmarshalOnlineDeployment(deployment.EndpointName, body)
+	}), nil
+}
+
+// DeleteOnlineDeployment Start the deletion of the deployment with the name provided as argument of the
+// specified online endpoint. Deployments receiving traffic cannot be deleted.
+func (w *Workspace) DeleteOnlineDeployment(resourceGroup, workspace, endpointName, name string) (*DeletionPoller, error) {
+	return w.DeleteOnlineDeploymentWithContext(context.Background(), resourceGroup, workspace, endpointName, name)
+}
+
+func (w *Workspace) DeleteOnlineDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*DeletionPoller, error) {
+	path := fmt.Sprintf("onlineEndpoints/%s/deployments/%s", endpointName, name)
+	notFoundErr := &ResourceNotFoundError{"online deployment", deploymentIdentifier(endpointName, name)}
+	operation, err := w.beginDeleteResource(ctx, resourceGroup, workspace, path, notFoundErr)
+	if err != nil {
+		return nil, err
+	}
+	return newPoller(operation, pollerKindDeletion, unmarshalDeletion), nil
+}
+
+// deploymentIdentifier Return the identifier of a deployment used in the errors, e.g. endpoint/blue
+func deploymentIdentifier(endpointName, name string) string {
+	return fmt.Sprintf("%s/%s", endpointName, name)
+}
+
+func validateOnlineEndpoint(endpoint *OnlineEndpoint) error {
+	if strings.TrimSpace(endpoint.Name) == "" {
+		return InvalidArgumentError{"the endpoint name cannot be empty"}
+	}
+	if strings.TrimSpace(endpoint.Location) == "" {
+		return InvalidArgumentError{"the endpoint location cannot be empty"}
+	}
+	switch endpoint.AuthMode {
+	case EndpointAuthModeKey, EndpointAuthModeAMLToken, EndpointAuthModeAADToken:
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid endpoint auth mode %q", endpoint.AuthMode)}
+	}
+	return validateTraffic(endpoint.Traffic)
+}
+
+// validateTraffic Check that the traffic percentages are between 0 and 100 and that they sum up to 100, unless
+// they are all zero
+func validateTraffic(traffic map[string]int) error {
+	total := 0
+	for deployment, percentage := range traffic {
+		if percentage < 0 || percentage > 100 {
+			return InvalidArgumentError{fmt.Sprintf("the traffic of deployment %s must be between 0 and 100, got %d", deployment, percentage)}
+		}
+		total += percentage
+	}
+	if total != 0 && total != 100 {
+		return InvalidArgumentError{fmt.Sprintf("the traffic of the deployments must sum up to 100, got %d", total)}
+	}
+	return nil
+}
+
+func validateOnlineDeployment(deployment *OnlineDeployment) error {
+	if strings.TrimSpace(deployment.EndpointName) == "" {
+		return InvalidArgumentError{"the endpoint name of the deployment cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.Name) == "" {
+		return InvalidArgumentError{"the deployment name cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.Location) == "" {
+		return InvalidArgumentError{"the deployment location cannot be empty"}
+	}
+	if strings.TrimSpace(deployment.InstanceType) == "" {
+		return InvalidArgumentError{"the instance type of the deployment cannot be empty"}
+	}
+	if code := deployment.CodeConfiguration; code != nil && (code.CodeId == "" || code.ScoringScript == "") {
+		return InvalidArgumentError{"the code configuration of the deployment must have both the code and the scoring script"}
+	}
+
+	scaleSettings := deployment.ScaleSettings
+	if scaleSettings == nil || scaleSettings.ScaleType == ScaleTypeDefault {
+		if deployment.InstanceCount <= 0 {
+			return InvalidArgumentError{"the instance count of the deployment must be positive"}
+		}
+	}
+	if scaleSettings != nil {
+		switch scaleSettings.ScaleType {
+		case ScaleTypeDefault:
+		case ScaleTypeTargetUtilization:
+			if scaleSettings.MinInstances < 0 || scaleSettings.MaxInstances < scaleSettings.MinInstances || scaleSettings.MaxInstances == 0 {
+				return InvalidArgumentError{fmt.Sprintf(
+					"invalid instances range [%d, %d] of the scale settings", scaleSettings.MinInstances, scaleSettings.MaxInstances,
+				)}
+			}
+			if scaleSettings.TargetUtilizationPercentage < 0 || scaleSettings.TargetUtilizationPercentage > 100 {
+				return InvalidArgumentError{"the target utilization percentage must be between 0 and 100"}
+			}
+			if scaleSettings.PollingInterval < 0 {
+				return InvalidArgumentError{"the polling interval of the scale settings cannot be negative"}
+			}
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid scale type %q", scaleSettings.ScaleType)}
+		}
+	}
+
+	if requestSettings := deployment.RequestSettings; requestSettings != nil {
+		if requestSettings.MaxConcurrentRequestsPerInstance < 0 || requestSettings.RequestTimeout < 0 || requestSettings.MaxQueueWait < 0 {
+			return InvalidArgumentError{"the request settings of the deployment cannot be negative"}
+		}
+	}
+	for name, probe := range map[string]*ProbeSettings{"liveness": deployment.LivenessProbe, "readiness": deployment.ReadinessProbe} {
+		if probe == nil {
+			continue
+		}
+		if probe.FailureThreshold < 0 || probe.SuccessThreshold < 0 || probe.InitialDelay < 0 || probe.Period < 0 || probe.Timeout < 0 {
+			return InvalidArgumentError{fmt.Sprintf("the settings of the %s probe cannot be negative", name)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,360 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func getMockedOnlineEndpoint() *OnlineEndpoint {
+	return &OnlineEndpoint{
+		Name:     "endpoint",
+		Location: "westeurope",
+		AuthMode: EndpointAuthModeKey,
+		Traffic:  map[string]int{"blue": 90, "green": 10},
+		Tags:     map[string]string{"team": "ml"},
+	}
+}
+
+func getMockedOnlineDeployment() *OnlineDeployment {
+	return &OnlineDeployment{
+		Name:          "blue",
+		EndpointName:  "endpoint",
+		Location:      "westeurope",
+		ModelId:       "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/models/model/versions/2",
+		EnvironmentId: mockedEnvironmentId,
+		CodeConfiguration: &CodeConfiguration{
+			CodeId:        "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/codes/score/versions/1",
+			ScoringScript: "score.py",
+		},
+		InstanceType:    "Standard_DS3_v2",
+		InstanceCount:   2,
+		RequestSettings: &OnlineRequestSettings{MaxConcurrentRequestsPerInstance: 1, RequestTimeout: 5 * time.Second},
+	}
+}
+
+func TestUnmarshalOnlineEndpoint(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &EndpointConverter{l.Sugar()}
+
+	endpoint := converter.unmarshalOnlineEndpoint(loadExampleResp("example_resp_get_online_endpoint.json"))
+	a.Equal("endpoint", endpoint.Name)
+	a.Equal("westeurope", endpoint.Location)
+	a.Equal("Scores the transactions", endpoint.Description)
+	a.Equal(EndpointAuthModeKey, endpoint.AuthMode)
+	a.Equal(map[string]int{"blue": 90, "green": 10}, endpoint.Traffic)
+	a.Nil(endpoint.MirrorTraffic)
+	a.Equal("Managed", endpoint.Kind)
+	a.Equal(IdentityTypeSystemAssigned, endpoint.Identity.Type)
+	a.Equal("https://endpoint.westeurope.inference.ml.azure.com/score", endpoint.ScoringUri)
+	a.Equal("Succeeded", endpoint.ProvisioningState)
+	a.Equal(map[string]string{"team": "ml"}, endpoint.Tags)
+	a.True(time.Date(2022, 6, 1, 10, 53, 40, 700170900, time.UTC).Equal(endpoint.SystemData.CreationDate))
+}
+
+func TestUnmarshalOnlineDeployment(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &EndpointConverter{l.Sugar()}
+
+	deployment := converter.unmarshalOnlineDeployment("endpoint", loadExampleResp("example_resp_get_online_deployment.json"))
+	a.Equal("blue", deployment.Name)
+	a.Equal("endpoint", deployment.EndpointName)
+	a.Equal(getMockedOnlineDeployment().ModelId, deployment.ModelId)
+	a.Equal(getMockedOnlineDeployment().CodeConfiguration, deployment.CodeConfiguration)
+	a.Equal(map[string]string{"WORKERS": "2"}, deployment.EnvironmentVariables)
+	a.Equal("Standard_DS3_v2", deployment.InstanceType)
+	a.Equal(2, deployment.InstanceCount)
+	a.Equal(&OnlineScaleSettings{ScaleType: ScaleTypeDefault}, deployment.ScaleSettings)
+	a.Equal(&OnlineRequestSettings{
+		MaxConcurrentRequestsPerInstance: 1,
+		RequestTimeout:                   5 * time.Second,
+		MaxQueueWait:                     500 * time.Millisecond,
+	}, deployment.RequestSettings)
+	a.Equal(&ProbeSettings{
+		FailureThreshold: 30,
+		SuccessThreshold: 1,
+		InitialDelay:     10 * time.Second,
+		Period:           10 * time.Second,
+		Timeout:          2 * time.Second,
+	}, deployment.LivenessProbe)
+	a.Nil(deployment.ReadinessProbe)
+	a.True(deployment.AppInsightsEnabled)
+}
+
+func TestToWriteOnlineDeploymentSchema(t *testing.T) {
+	a := assert.New(t)
+
+	deployment := getMockedOnlineDeployment()
+	deployment.ScaleSettings = &OnlineScaleSettings{
+		ScaleType:                   ScaleTypeTargetUtilization,
+		MinInstances:                1,
+		MaxInstances:                4,
+		PollingInterval:             time.Minute,
+		TargetUtilizationPercentage: 70,
+	}
+	schema := toWriteOnlineDeploymentSchema(deployment)
+	a.Equal("Managed", schema.Properties.EndpointComputeType)
+	a.Equal(&SkuSchema{Name: "Default", Capacity: 2}, schema.Sku)
+	a.Equal(&OnlineScaleSettingsSchema{
+		ScaleType:                   ScaleTypeTargetUtilization,
+		MinInstances:                1,
+		MaxInstances:                4,
+		PollingInterval:             "PT60S",
+		TargetUtilizationPercentage: 70,
+	}, schema.Properties.ScaleSettings)
+	a.Equal(&OnlineRequestSettingsSchema{MaxConcurrentRequestsPerInstance: 1, RequestTimeout: "PT5S"}, schema.Properties.RequestSettings)
+	a.Nil(schema.Properties.LivenessProbe)
+
+	endpointSchema := toWriteOnlineEndpointSchema(getMockedOnlineEndpoint())
+	a.Equal(&IdentitySchema{Type: IdentityTypeSystemAssigned}, endpointSchema.Identity)
+}
+
+func TestValidateOnlineDeployment(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateOnlineDeployment(getMockedOnlineDeployment()))
+
+	invalidDeployments := map[string]func(d *OnlineDeployment){
+		"empty endpoint":      func(d *OnlineDeployment) { d.EndpointName = "" },
+		"empty name":          func(d *OnlineDeployment) { d.Name = "" },
+		"empty location":      func(d *OnlineDeployment) { d.Location = "" },
+		"empty instance type": func(d *OnlineDeployment) { d.InstanceType = "" },
+		"no instances":        func(d *OnlineDeployment) { d.InstanceCount = 0 },
+		"no scoring script":   func(d *OnlineDeployment) { d.CodeConfiguration.ScoringScript = "" },
+		"invalid scale type":  func(d *OnlineDeployment) { d.ScaleSettings = &OnlineScaleSettings{ScaleType: "foo"} },
+		"invalid range": func(d *OnlineDeployment) {
+			d.ScaleSettings = &OnlineScaleSettings{ScaleType: ScaleTypeTargetUtilization, MinInstances: 3, MaxInstances: 2}
+		},
+		"invalid utilization": func(d *OnlineDeployment) {
+			d.ScaleSettings = &OnlineScaleSettings{ScaleType: ScaleTypeTargetUtilization, MaxInstances: 2, TargetUtilizationPercentage: 120}
+		},
+		"negative timeout": func(d *OnlineDeployment) { d.RequestSettings.RequestTimeout = -time.Second },
+		"negative probe":   func(d *OnlineDeployment) { d.ReadinessProbe = &ProbeSettings{Period: -time.Second} },
+	}
+	for name, mutate := range invalidDeployments {
+		deployment := getMockedOnlineDeployment()
+		mutate(deployment)
+		a.IsType(InvalidArgumentError{}, validateOnlineDeployment(deployment), name)
+	}
+
+	// With autoscaling the instance count is optional
+	deployment := getMockedOnlineDeployment()
+	deployment.InstanceCount = 0
+	deployment.ScaleSettings = &OnlineScaleSettings{ScaleType: ScaleTypeTargetUtilization, MinInstances: 1, MaxInstances: 2}
+	a.Nil(validateOnlineDeployment(deployment))
+}
+
+func TestValidateTraffic(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(validateTraffic(nil))
+	a.Nil(validateTraffic(map[string]int{"blue": 0}))
+	a.Nil(validateTraffic(map[string]int{"blue": 100, "green": 0}))
+	a.Equal(InvalidArgumentError{"the traffic of the deployments must sum up to 100, got 90"}, validateTraffic(map[string]int{"blue": 80, "green": 10}))
+	a.IsType(InvalidArgumentError{}, validateTraffic(map[string]int{"blue": 110, "green": -10}))
+}
+
+func TestWorkspace_OnlineEndpoints(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	endpointResp := string(loadExampleResp("example_resp_get_online_endpoint.json"))
+	deploymentResp := string(loadExampleResp("example_resp_get_online_deployment.json"))
+	asyncOperationHeaders := newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl, "Retry-After", "0")
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get online endpoints",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", endpointResp), nil)
+				mockedHttpClient.On("doGet", "onlineEndpoints/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				endpoints, err := ws.GetOnlineEndpoints("rg", "ws")
+				a.Nil(err)
+				a.Len(endpoints, 1)
+				a.Equal("endpoint", endpoints[0].Name)
+
+				endpoint, err := ws.GetOnlineEndpoint("rg", "ws", "foo")
+				a.Nil(endpoint)
+				a.Equal(&ResourceNotFoundError{"online endpoint", "foo"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create online endpoint returns a poller",
+			testCase: func() {
+				endpoint := getMockedOnlineEndpoint()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "onlineEndpoints/endpoint", toWriteOnlineEndpointSchema(endpoint)).Return(
+					http.StatusCreated, "{\"properties\": {\"provisioningState\": \"Creating\"}}", nil, asyncOperationHeaders,
+				)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"InProgress\"}", nil).Once()
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"Succeeded\"}", nil).Once()
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, endpointResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.CreateOrUpdateOnlineEndpoint("rg", "ws", endpoint)
+				a.Nil(err)
+				a.False(poller.Done())
+				_, err = poller.Result()
+				a.Equal(ErrOperationInProgress, err)
+
+				result, err := poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				a.True(poller.Done())
+				a.Equal("https://endpoint.westeurope.inference.ml.azure.com/score", result.ScoringUri)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test create online endpoint with invalid fields",
+			testCase: func() {
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				invalidEndpoints := []*OnlineEndpoint{
+					{Location: "westeurope", AuthMode: EndpointAuthModeKey},
+					{Name: "endpoint", AuthMode: EndpointAuthModeKey},
+					{Name: "endpoint", Location: "westeurope", AuthMode: "foo"},
+					{Name: "endpoint", Location: "westeurope", AuthMode: EndpointAuthModeKey, Traffic: map[string]int{"blue": 50}},
+				}
+				for _, endpoint := range invalidEndpoints {
+					poller, err := ws.CreateOrUpdateOnlineEndpoint("rg", "ws", endpoint)
+					a.Nil(poller)
+					a.IsType(InvalidArgumentError{}, err)
+				}
+			},
+		},
+		{
+			testCaseName: "Test update online endpoint traffic",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, endpointResp, nil)
+				mockedHttpClient.On("doPut", "onlineEndpoints/endpoint", mock.MatchedBy(func(schema *WriteOnlineEndpointSchema) bool {
+					return schema.Properties.Traffic["green"] == 100 && schema.Properties.Traffic["blue"] == 0 &&
+						schema.Properties.Description == "Scores the transactions"
+				})).Return(http.StatusOK, endpointResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.UpdateOnlineEndpointTraffic("rg", "ws", "endpoint", map[string]int{"blue": 0, "green": 100})
+				a.Nil(err)
+				a.True(poller.Done())
+				mockedHttpClient.AssertExpectations(t)
+
+				_, err = ws.UpdateOnlineEndpointTraffic("rg", "ws", "endpoint", map[string]int{"blue": 50})
+				a.IsType(InvalidArgumentError{}, err)
+			},
+		},
+		{
+			testCaseName: "Test update online endpoint traffic keeps the other settings",
+			testCase: func() {
+				identityId := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/scorer"
+				resp := fmt.Sprintf(`{
+					"name": "endpoint", "location": "westeurope", "kind": "Managed", "tags": {"team": "ml"},
+					"identity": {"type": "UserAssigned", "userAssignedIdentities": {"%s": {"principalId": "principal-id"}}},
+					"properties": {
+						"authMode": "AADToken", "description": "Scores the transactions", "publicNetworkAccess": "Disabled",
+						"traffic": {"blue": 100}, "mirrorTraffic": {"green": 10}, "provisioningState": "Succeeded"
+					}
+				}`, identityId)
+				expected := &WriteOnlineEndpointSchema{
+					Location: "westeurope",
+					Kind:     "Managed",
+					Identity: &IdentitySchema{Type: IdentityTypeUserAssigned, UserAssignedIdentities: map[string]struct{}{identityId: {}}},
+					Tags:     map[string]string{"team": "ml"},
+					Properties: OnlineEndpointPropertiesSchema{
+						AuthMode:            EndpointAuthModeAADToken,
+						Description:         "Scores the transactions",
+						Properties:          map[string]string{},
+						Traffic:             map[string]int{"blue": 0, "green": 100},
+						MirrorTraffic:       map[string]int{"green": 10},
+						PublicNetworkAccess: PublicNetworkAccessDisabled,
+					},
+				}
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, resp, nil)
+				mockedHttpClient.On("doPut", "onlineEndpoints/endpoint", expected).Return(http.StatusOK, resp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				_, err := ws.UpdateOnlineEndpointTraffic("rg", "ws", "endpoint", map[string]int{"blue": 0, "green": 100})
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test create online deployment failed",
+			testCase: func() {
+				deployment := getMockedOnlineDeployment()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "onlineEndpoints/endpoint/deployments/blue", toWriteOnlineDeploymentSchema(deployment)).Return(
+					http.StatusCreated, deploymentResp, nil, asyncOperationHeaders,
+				)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(
+					http.StatusOK, "{\"status\": \"Failed\", \"error\": {\"code\": \"ResourceNotReady\", \"message\": \"User container has crashed\"}}", nil,
+				)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.CreateOrUpdateOnlineDeployment("rg", "ws", deployment)
+				a.Nil(err)
+				result, err := poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(result)
+				a.Equal(&OperationFailedError{Status: OperationStatusFailed, Code: "ResourceNotReady", Message: "User container has crashed"}, err)
+			},
+		},
+		{
+			testCaseName: "Test get online deployments",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint/deployments").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", deploymentResp), nil)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint/deployments/green").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				deployments, err := ws.GetOnlineDeployments("rg", "ws", "endpoint")
+				a.Nil(err)
+				a.Len(deployments, 1)
+				a.Equal("endpoint", deployments[0].EndpointName)
+
+				deployment, err := ws.GetOnlineDeployment("rg", "ws", "endpoint", "green")
+				a.Nil(deployment)
+				a.Equal(&ResourceNotFoundError{"online deployment", "endpoint/green"}, err)
+			},
+		},
+		{
+			testCaseName: "Test delete online endpoint and deployment",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "onlineEndpoints/endpoint/deployments/blue").Return(
+					http.StatusAccepted, "", nil, newMockedHeader(locationHeader, mockedLocationUrl, "Retry-After", "0"),
+				)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusOK, "", nil)
+				mockedHttpClient.On("doDelete", "onlineEndpoints/foo").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.DeleteOnlineDeployment("rg", "ws", "endpoint", "blue")
+				a.Nil(err)
+				_, err = poller.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				_, err = poller.Result()
+				a.Nil(err)
+
+				_, err = ws.DeleteOnlineEndpoint("rg", "ws", "foo")
+				a.Equal(&ResourceNotFoundError{"online endpoint", "foo"}, err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
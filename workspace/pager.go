@@ -0,0 +1,154 @@
+package workspace
+
+import (
+	"context"
+	"github.com/tidwall/gjson"
+	"io/ioutil"
+	"net/http"
+)
+
+// pager Iterate over the pages returned by a list operation of the AML APIs, following the nextLink of each page.
+type pager struct {
+	client   HttpClientAPI
+	path     string
+	nextLink string
+	started  bool
+
+	// notFoundErr The error returned if the list operation replies with 404 Not Found. If nil, a
+	// HttpResponseError is returned.
+	notFoundErr error
+}
+
+func newPager(client HttpClientAPI, path string) *pager {
+	return &pager{client: client, path: path}
+}
+
+func (p *pager) more() bool {
+	return p.started == false || p.nextLink != ""
+}
+
+// nextPage Return the body of the next page
+func (p *pager) nextPage(ctx context.Context) ([]byte, error) {
+	if p.more() == false {
+		return nil, ErrNoMorePages
+	}
+
+	var resp *http.Response
+	var err error
+	if p.started == false {
+		resp, err = p.client.doGet(ctx, p.path)
+	} else {
+		resp, err = p.client.doGetUrl(ctx, p.nextLink)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound && p.notFoundErr != nil {
+		return nil, p.notFoundErr
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newHttpResponseError(resp, body)
+	}
+
+	nextLink := gjson.GetBytes(body, "nextLink").Str
+	if nextLink == p.nextLink {
+		// Guard against APIs returning the same link forever
+		nextLink = ""
+	}
+	p.started = true
+	p.nextLink = nextLink
+	return body, nil
+}
+
+// Pager Iterate page by page over the results of type T of a list operation, such as the datastores of a workspace.
+type Pager[T any] struct {
+	pager     *pager
+	unmarshal func(ctx context.Context, body []byte) ([]T, error)
+}
+
+// DatastorePager Iterate page by page over the datastores of a workspace.
+type DatastorePager = Pager[Datastore]
+
+// DatasetVersionPager Iterate page by page over the versions of a dataset.
+type DatasetVersionPager = Pager[Dataset]
+
+// DatasetPager Iterate page by page over the datasets of a workspace. For each dataset, only its latest
+// version is returned.
+type DatasetPager = Pager[Dataset]
+
+// DataAssetPager Iterate page by page over the data assets of a workspace. For each data asset, only its latest
+// version is returned.
+type DataAssetPager = Pager[DataAsset]
+
+// DataAssetVersionPager Iterate page by page over the versions of a data asset.
+type DataAssetVersionPager = Pager[DataAsset]
+
+// ModelPager Iterate page by page over the registered models of a workspace.
+type ModelPager = Pager[Model]
+
+// ModelVersionPager Iterate page by page over the versions of a registered model.
+type ModelVersionPager = Pager[ModelVersion]
+
+// EnvironmentPager Iterate page by page over the environments of a workspace.
+type EnvironmentPager = Pager[Environment]
+
+// EnvironmentVersionPager Iterate page by page over the versions of an environment.
+type EnvironmentVersionPager = Pager[EnvironmentVersion]
+
+// ComputePager Iterate page by page over the computes of a workspace.
+type ComputePager = Pager[Compute]
+
+// JobPager Iterate page by page over the jobs of a workspace.
+type JobPager = Pager[Job]
+
+// ComponentPager Iterate page by page over the components of a workspace.
+type ComponentPager = Pager[Component]
+
+// ComponentVersionPager Iterate page by page over the versions of a component.
+type ComponentVersionPager = Pager[ComponentVersion]
+
+// OnlineEndpointPager Iterate page by page over the online endpoints of a workspace.
+type OnlineEndpointPager = Pager[OnlineEndpoint]
+
+// OnlineDeploymentPager Iterate page by page over the deployments of an online endpoint.
+type OnlineDeploymentPager = Pager[OnlineDeployment]
+
+// BatchEndpointPager Iterate page by page over the batch endpoints of a workspace.
+type BatchEndpointPager = Pager[BatchEndpoint]
+
+// BatchDeploymentPager Iterate page by page over the deployments of a batch endpoint.
+type BatchDeploymentPager = Pager[BatchDeployment]
+
+// AmlWorkspacePager Iterate page by page over the AML workspaces of a resource group or of a subscription.
+type AmlWorkspacePager = Pager[AmlWorkspace]
+
+// newTypedPager Return a pager unmarshalling each page with the function provided as argument
+func newTypedPager[T any](pager *pager, unmarshal func(body []byte) []T) *Pager[T] {
+	return &Pager[T]{
+		pager: pager,
+		unmarshal: func(_ context.Context, body []byte) ([]T, error) {
+			return unmarshal(body), nil
+		},
+	}
+}
+
+// More Return true if there are more pages to retrieve.
+func (p *Pager[T]) More() bool {
+	return p.pager.more()
+}
+
+// NextPage Return the results of the next page, or ErrNoMorePages if all the pages have been retrieved.
+func (p *Pager[T]) NextPage(ctx context.Context) ([]T, error) {
+	body, err := p.pager.nextPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.unmarshal(ctx, body)
+}
@@ -0,0 +1,135 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+func TestWorkspace_GetDatastoresPaginated(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	firstPage := loadExampleResp("example_resp_get_datastore_list_page_1.json")
+	secondPage := loadExampleResp("example_resp_get_datastore_list_page_2.json")
+	nextLink := gjson.GetBytes(firstPage, "nextLink").Str
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get datastores follows next link",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores").Return(http.StatusOK, string(firstPage), nil)
+				mockedHttpClient.On("doGetUrl", nextLink).Return(http.StatusOK, string(secondPage), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				datastores, err := ws.GetDatastores("rg", "ws")
+				a.Nil(err)
+				a.Len(datastores, 3)
+				a.Equal("datastore-1", datastores[0].Name)
+				a.Equal("datastore-3", datastores[2].Name)
+				mockedHttpClient.AssertNumberOfCalls(t, "doGetUrl", 1)
+			},
+		},
+		{
+			testCaseName: "Test get datastores error in second page",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores").Return(http.StatusOK, string(firstPage), nil)
+				mockedHttpClient.On("doGetUrl", nextLink).Return(http.StatusInternalServerError, "error", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				datastores, err := ws.GetDatastores("rg", "ws")
+				a.Nil(datastores)
+				a.Equal(&HttpResponseError{StatusCode: http.StatusInternalServerError, ResponseContent: "error"}, err)
+			},
+		},
+		{
+			testCaseName: "Test datastore pager",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores").Return(http.StatusOK, string(firstPage), nil)
+				mockedHttpClient.On("doGetUrl", nextLink).Return(http.StatusOK, string(secondPage), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				pager := ws.NewDatastorePager("rg", "ws")
+				a.True(pager.More())
+
+				page, err := pager.NextPage(context.Background())
+				a.Nil(err)
+				a.Len(page, 1)
+				a.True(pager.More())
+
+				page, err = pager.NextPage(context.Background())
+				a.Nil(err)
+				a.Len(page, 2)
+				a.False(pager.More())
+
+				page, err = pager.NextPage(context.Background())
+				a.Nil(page)
+				a.Equal(ErrNoMorePages, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestWorkspace_GetDatasetVersionsPaginated(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	firstPage := loadExampleResp("example_resp_get_dataset_versions_page_1.json")
+	secondPage := loadExampleResp("example_resp_get_dataset_versions_page_2.json")
+
+	mockedHttpClient := new(MockedHttpClient)
+	mockedHttpClient.On("doGet", "datasets/dataset/versions").Return(http.StatusOK, string(firstPage), nil)
+	mockedHttpClient.On("doGetUrl", mock.Anything).Return(http.StatusOK, string(secondPage), nil)
+
+	ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+	versions, err := ws.GetDatasetVersions("rg", "ws", "dataset")
+	a.Nil(err)
+	a.Len(versions, 2)
+	a.Equal(1, versions[0].Version)
+	a.Equal(2, versions[1].Version)
+	a.Equal("dataset", versions[1].Name)
+	a.Len(versions[1].DirectoryPaths, 1)
+
+	latest, err := ws.getLatestDatasetVersion(context.Background(), "rg", "ws", "dataset")
+	a.Nil(err)
+	a.Equal(2, latest.Version)
+}
+
+func TestPager_SameNextLink(t *testing.T) {
+	a := assert.New(t)
+	body := fmt.Sprintf("{\"value\": [], \"nextLink\": %q}", "https://management.azure.com/next")
+	mockedHttpClient := new(MockedHttpClient)
+	mockedHttpClient.On("doGet", "datastores").Return(http.StatusOK, body, nil)
+	mockedHttpClient.On("doGetUrl", mock.Anything).Return(http.StatusOK, body, nil)
+
+	p := newPager(mockedHttpClient, "datastores")
+	for p.more() {
+		_, err := p.nextPage(context.Background())
+		a.Nil(err)
+	}
+	mockedHttpClient.AssertNumberOfCalls(t, "doGetUrl", 1)
+}
+
+func TestHttpClient_CheckSameHost(t *testing.T) {
+	a := assert.New(t)
+	client := &HttpClient{subscriptionId: "sub", cloud: AzurePublicCloud, resourceGroupName: "rg", workspaceName: "ws"}
+
+	a.Nil(client.checkSameHost("https://management.azure.com/subscriptions/sub/foo?$skipToken=bar"))
+	a.NotNil(client.checkSameHost("https://attacker.example.com/subscriptions/sub/foo"))
+	a.NotNil(client.checkSameHost("http://management.azure.com/subscriptions/sub/foo"))
+}
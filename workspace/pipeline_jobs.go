@@ -0,0 +1,380 @@
+package workspace
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	pipelineInputExpressionRegex  = regexp.MustCompile(`^\$\{\{\s*parent\.inputs\.([^.\s}]+)\s*\}\}$`)
+	pipelineOutputExpressionRegex = regexp.MustCompile(`^\$\{\{\s*parent\.outputs\.([^.\s}]+)\s*\}\}$`)
+	stepOutputExpressionRegex     = regexp.MustCompile(`^\$\{\{\s*parent\.jobs\.([^.\s}]+)\.outputs\.([^.\s}]+)\s*\}\}$`)
+)
+
+// expression Return the expression used by the APIs for the binding, e.g. ${{parent.inputs.data}}
+func (b PipelineBinding) expression() string {
+	switch b.Source {
+	case PipelineBindingSourcePipelineInput:
+		return fmt.Sprintf("${{parent.inputs.%s}}", b.Name)
+	case PipelineBindingSourceStepOutput:
+		return fmt.Sprintf("${{parent.jobs.%s.outputs.%s}}", b.Step, b.Name)
+	default:
+		return b.Value
+	}
+}
+
+// parsePipelineBinding Parse the expression of a binding returned by the APIs. Expressions not referencing an input
+// of the pipeline or an output of a step are literals.
+func parsePipelineBinding(expression string) PipelineBinding {
+	if matches := pipelineInputExpressionRegex.FindStringSubmatch(expression); matches != nil {
+		return PipelineInputBinding(matches[1])
+	}
+	if matches := stepOutputExpressionRegex.FindStringSubmatch(expression); matches != nil {
+		return StepOutputBinding(matches[1], matches[2])
+	}
+	return LiteralBinding(expression)
+}
+
+// PipelineBuilder Build a pipeline job step by step. The graph of the steps is validated when calling Build.
+type PipelineBuilder struct {
+	job      Job
+	pipeline PipelineJob
+	steps    []*PipelineStepBuilder
+
+	// outputSources The step outputs promoted to each output of the pipeline
+	outputSources map[string]PipelineBinding
+}
+
+// NewPipelineBuilder Return a builder of a pipeline job with the name provided as argument
+func NewPipelineBuilder(name string) *PipelineBuilder {
+	return &PipelineBuilder{
+		job: Job{Name: name, JobType: JobTypePipeline},
+		pipeline: PipelineJob{
+			Inputs:  make(map[string]JobInput),
+			Outputs: make(map[string]JobOutput),
+		},
+		outputSources: make(map[string]PipelineBinding),
+	}
+}
+
+func (b *PipelineBuilder) WithDisplayName(displayName string) *PipelineBuilder {
+	b.job.DisplayName = displayName
+	return b
+}
+
+func (b *PipelineBuilder) WithExperimentName(experimentName string) *PipelineBuilder {
+	b.job.ExperimentName = experimentName
+	return b
+}
+
+// WithDefaultCompute Set the ARM resource ID of the compute used by the steps without a compute
+func (b *PipelineBuilder) WithDefaultCompute(computeId string) *PipelineBuilder {
+	b.pipeline.DefaultComputeId = computeId
+	return b
+}
+
+func (b *PipelineBuilder) WithContinueOnStepFailure(continueOnStepFailure bool) *PipelineBuilder {
+	b.pipeline.ContinueOnStepFailure = continueOnStepFailure
+	return b
+}
+
+// WithInput Add an input to the pipeline, which can be bound to the inputs of the steps using Input
+func (b *PipelineBuilder) WithInput(name string, input JobInput) *PipelineBuilder {
+	b.pipeline.Inputs[name] = input
+	return b
+}
+
+// WithOutput Add an output to the pipeline, to which the step output provided as argument is promoted
+func (b *PipelineBuilder) WithOutput(name string, output JobOutput, from PipelineBinding) *PipelineBuilder {
+	b.pipeline.Outputs[name] = output
+	b.outputSources[name] = from
+	return b
+}
+
+// Input Return the binding to the input of the pipeline with the name provided as argument
+func (b *PipelineBuilder) Input(name string) PipelineBinding {
+	return PipelineInputBinding(name)
+}
+
+// AddStep Add to the pipeline a step running the component provided as argument, whose inputs and outputs
+// have the names provided as argument. All the inputs must be bound, unless declared optional.
+func (b *PipelineBuilder) AddStep(name, componentId string, inputs, outputs []string) *PipelineStepBuilder {
+	step := &PipelineStepBuilder{
+		step: PipelineStep{
+			Name:        name,
+			ComponentId: componentId,
+			Inputs:      make(map[string]PipelineBinding),
+			Outputs:     make(map[string]string),
+		},
+		inputs:  make(map[string]bool, len(inputs)),
+		outputs: make(map[string]bool, len(outputs)),
+	}
+	for _, input := range inputs {
+		step.inputs[input] = true
+	}
+	for _, output := range outputs {
+		step.outputs[output] = true
+	}
+	b.steps = append(b.steps, step)
+	return step
+}
+
+// AddComponentStep Add to the pipeline a step running the component version provided as argument, declaring its
+// inputs and outputs. The inputs that are optional or have a default value do not need to be bound.
+func (b *PipelineBuilder) AddComponentStep(name string, component *ComponentVersion) *PipelineStepBuilder {
+	step := b.AddStep(name, component.Id, nil, nil)
+	for input, declaration := range component.Inputs {
+		step.inputs[input] = !declaration.Optional && declaration.Default == ""
+	}
+	for output := range component.Outputs {
+		step.outputs[output] = true
+	}
+	return step
+}
+
+// Build Validate and return the pipeline job, ready to be submitted with CreateOrUpdateJob
+func (b *PipelineBuilder) Build() (*Job, error) {
+	pipeline := PipelineJob{
+		Inputs:                make(map[string]JobInput, len(b.pipeline.Inputs)),
+		Outputs:               make(map[string]JobOutput, len(b.pipeline.Outputs)),
+		DefaultComputeId:      b.pipeline.DefaultComputeId,
+		ContinueOnStepFailure: b.pipeline.ContinueOnStepFailure,
+		Steps:                 make(map[string]PipelineStep, len(b.steps)),
+	}
+	for name, input := range b.pipeline.Inputs {
+		pipeline.Inputs[name] = input
+	}
+	for name, output := range b.pipeline.Outputs {
+		pipeline.Outputs[name] = output
+	}
+
+	stepBuilders := make(map[string]*PipelineStepBuilder, len(b.steps))
+	for _, stepBuilder := range b.steps {
+		if _, ok := stepBuilders[stepBuilder.step.Name]; ok {
+			return nil, InvalidArgumentError{fmt.Sprintf("duplicated pipeline step %q", stepBuilder.step.Name)}
+		}
+		if err := stepBuilder.validateDeclarations(); err != nil {
+			return nil, err
+		}
+		stepBuilders[stepBuilder.step.Name] = stepBuilder
+		pipeline.Steps[stepBuilder.step.Name] = stepBuilder.copyStep()
+	}
+
+	// Check that the bound step outputs are declared, then promote the outputs of the pipeline
+	for _, stepBuilder := range b.steps {
+		for input, binding := range stepBuilder.step.Inputs {
+			if binding.Source != PipelineBindingSourceStepOutput {
+				continue
+			}
+			if source, ok := stepBuilders[binding.Step]; ok && !source.outputs[binding.Name] {
+				return nil, InvalidArgumentError{fmt.Sprintf(
+					"input %s of step %s is bound to the undeclared output %s of step %s", input, stepBuilder.step.Name, binding.Name, binding.Step,
+				)}
+			}
+		}
+	}
+	for name, source := range b.outputSources {
+		if source.Source != PipelineBindingSourceStepOutput {
+			return nil, InvalidArgumentError{fmt.Sprintf("the pipeline output %s must be bound to a step output", name)}
+		}
+		stepBuilder, ok := stepBuilders[source.Step]
+		if !ok || !stepBuilder.outputs[source.Name] {
+			return nil, InvalidArgumentError{fmt.Sprintf("the pipeline output %s is bound to the unknown output %s of step %s", name, source.Name, source.Step)}
+		}
+		if other, ok := pipeline.Steps[source.Step].Outputs[source.Name]; ok {
+			first, second := other, name
+			if second < first {
+				first, second = second, first
+			}
+			return nil, InvalidArgumentError{fmt.Sprintf(
+				"the pipeline outputs %s and %s are both bound to the output %s of step %s", first, second, source.Name, source.Step,
+			)}
+		}
+		pipeline.Steps[source.Step].Outputs[source.Name] = name
+	}
+
+	job := b.job
+	job.Pipeline = &pipeline
+	if err := validateJob(&job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// PipelineStepBuilder Configure a step added to a pipeline with PipelineBuilder.AddStep.
+type PipelineStepBuilder struct {
+	step PipelineStep
+
+	// inputs The inputs declared by the component, with true if the input is required
+	inputs  map[string]bool
+	outputs map[string]bool
+}
+
+// WithCompute Set the ARM resource ID of the compute on which the step runs, overriding the default one
+func (s *PipelineStepBuilder) WithCompute(computeId string) *PipelineStepBuilder {
+	s.step.ComputeId = computeId
+	return s
+}
+
+// WithOptionalInputs Declare inputs of the component that can be left unbound
+func (s *PipelineStepBuilder) WithOptionalInputs(names ...string) *PipelineStepBuilder {
+	for _, name := range names {
+		s.inputs[name] = false
+	}
+	return s
+}
+
+// BindInput Bind the input of the step with the name provided as argument, e.g. to the output of another step
+func (s *PipelineStepBuilder) BindInput(name string, binding PipelineBinding) *PipelineStepBuilder {
+	s.step.Inputs[name] = binding
+	return s
+}
+
+// Output Return the binding to the output of the step with the name provided as argument
+func (s *PipelineStepBuilder) Output(name string) PipelineBinding {
+	return StepOutputBinding(s.step.Name, name)
+}
+
+// validateDeclarations Check that all the required inputs of the step are bound, and only to declared inputs
+func (s *PipelineStepBuilder) validateDeclarations() error {
+	for input, required := range s.inputs {
+		if _, ok := s.step.Inputs[input]; required && !ok {
+			return InvalidArgumentError{fmt.Sprintf("input %s of step %s is not bound", input, s.step.Name)}
+		}
+	}
+	for input := range s.step.Inputs {
+		if _, ok := s.inputs[input]; !ok {
+			return InvalidArgumentError{fmt.Sprintf("step %s has no input %s", s.step.Name, input)}
+		}
+	}
+	return nil
+}
+
+func (s *PipelineStepBuilder) copyStep() PipelineStep {
+	step := s.step
+	step.Inputs = make(map[string]PipelineBinding, len(s.step.Inputs))
+	for name, binding := range s.step.Inputs {
+		step.Inputs[name] = binding
+	}
+	step.Outputs = make(map[string]string)
+	return step
+}
+
+func validatePipelineJob(pipeline *PipelineJob) error {
+	if len(pipeline.Steps) == 0 {
+		return InvalidArgumentError{"the pipeline must have at least one step"}
+	}
+	for name, input := range pipeline.Inputs {
+		if err := validateJobInput(name, input); err != nil {
+			return err
+		}
+	}
+
+	for name, step := range pipeline.Steps {
+		if strings.TrimSpace(name) == "" || step.Name != name {
+			return InvalidArgumentError{fmt.Sprintf("invalid name %q of pipeline step %q", step.Name, name)}
+		}
+		if strings.TrimSpace(step.ComponentId) == "" {
+			return InvalidArgumentError{fmt.Sprintf("the component of step %s cannot be empty", name)}
+		}
+		if step.ComputeId == "" && pipeline.DefaultComputeId == "" {
+			return InvalidArgumentError{fmt.Sprintf("step %s has no compute and the pipeline has no default compute", name)}
+		}
+		for input, binding := range step.Inputs {
+			switch binding.Source {
+			case PipelineBindingSourceLiteral:
+			case PipelineBindingSourcePipelineInput:
+				if _, ok := pipeline.Inputs[binding.Name]; !ok {
+					return InvalidArgumentError{fmt.Sprintf("input %s of step %s is bound to the unknown pipeline input %s", input, name, binding.Name)}
+				}
+			case PipelineBindingSourceStepOutput:
+				if _, ok := pipeline.Steps[binding.Step]; !ok {
+					return InvalidArgumentError{fmt.Sprintf("input %s of step %s is bound to the unknown step %s", input, name, binding.Step)}
+				}
+			default:
+				return InvalidArgumentError{fmt.Sprintf("invalid binding source %q of input %s of step %s", binding.Source, input, name)}
+			}
+		}
+		for output, pipelineOutput := range step.Outputs {
+			if _, ok := pipeline.Outputs[pipelineOutput]; !ok {
+				return InvalidArgumentError{fmt.Sprintf("output %s of step %s is promoted to the unknown pipeline output %s", output, name, pipelineOutput)}
+			}
+		}
+	}
+
+	if cycle := findPipelineCycle(pipeline.Steps); cycle != nil {
+		return InvalidArgumentError{fmt.Sprintf("the pipeline steps contain a cycle: %s", strings.Join(cycle, " -> "))}
+	}
+	return nil
+}
+
+// findPipelineCycle Return the steps forming a cycle, starting and ending with the same step, or nil if the
+// steps form an acyclic graph. A step depends on the steps whose outputs are bound to its inputs.
+func findPipelineCycle(steps map[string]PipelineStep) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(steps))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		path = append(path, name)
+		step := steps[name]
+		for _, input := range sortedInputNames(step.Inputs) {
+			binding := step.Inputs[input]
+			if binding.Source != PipelineBindingSourceStepOutput {
+				continue
+			}
+			switch state[binding.Step] {
+			case visiting:
+				for i, pathStep := range path {
+					if pathStep == binding.Step {
+						return append(append([]string{}, path[i:]...), binding.Step)
+					}
+				}
+			case unvisited:
+				if cycle := visit(binding.Step); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, name := range sortedStepNames(steps) {
+		if state[name] == unvisited {
+			if cycle := visit(name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// sortedStepNames Return the names of the steps in alphabetical order
+func sortedStepNames(steps map[string]PipelineStep) []string {
+	names := make([]string, 0, len(steps))
+	for name := range steps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedInputNames Return the names of the bound inputs in alphabetical order
+func sortedInputNames(inputs map[string]PipelineBinding) []string {
+	names := make([]string, 0, len(inputs))
+	for name := range inputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,236 @@
+package workspace
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+// getMockedPipelineBuilder Return a builder of a pipeline in which the step prep cleans the raw data and the
+// step train trains a model on the cleaned data
+func getMockedPipelineBuilder() (*PipelineBuilder, *PipelineStepBuilder, *PipelineStepBuilder) {
+	pipeline := NewPipelineBuilder("pipeline-1").
+		WithDefaultCompute("cluster-id").
+		WithContinueOnStepFailure(true).
+		WithInput("raw", JobInput{InputType: JobInputTypeUriFolder, Uri: "azureml:raw:1"})
+	prep := pipeline.AddStep("prep", "prep-component-id", []string{"raw_data"}, []string{"data"}).
+		BindInput("raw_data", pipeline.Input("raw"))
+	train := pipeline.AddStep("train", "train-component-id", []string{"data", "lr"}, []string{"model"}).
+		WithCompute("gpu-cluster-id").
+		BindInput("data", prep.Output("data")).
+		BindInput("lr", LiteralBinding("0.01"))
+	pipeline.WithOutput("model", JobOutput{OutputType: JobOutputTypeMLflowModel}, train.Output("model"))
+	return pipeline, prep, train
+}
+
+func getMockedPipelineJob() *PipelineJob {
+	return &PipelineJob{
+		Inputs:                map[string]JobInput{"raw": {InputType: JobInputTypeUriFolder, Uri: "azureml:raw:1"}},
+		Outputs:               map[string]JobOutput{"model": {OutputType: JobOutputTypeMLflowModel}},
+		DefaultComputeId:      "cluster-id",
+		ContinueOnStepFailure: true,
+		Steps: map[string]PipelineStep{
+			"prep": {
+				Name:        "prep",
+				ComponentId: "prep-component-id",
+				Inputs:      map[string]PipelineBinding{"raw_data": PipelineInputBinding("raw")},
+				Outputs:     map[string]string{},
+			},
+			"train": {
+				Name:        "train",
+				ComponentId: "train-component-id",
+				ComputeId:   "gpu-cluster-id",
+				Inputs: map[string]PipelineBinding{
+					"data": StepOutputBinding("prep", "data"),
+					"lr":   LiteralBinding("0.01"),
+				},
+				Outputs: map[string]string{"model": "model"},
+			},
+		},
+	}
+}
+
+func TestPipelineBuilder_Build(t *testing.T) {
+	a := assert.New(t)
+
+	pipeline, _, _ := getMockedPipelineBuilder()
+	job, err := pipeline.Build()
+	a.Nil(err)
+	a.Equal("pipeline-1", job.Name)
+	a.Equal(JobTypePipeline, job.JobType)
+	a.Equal(getMockedPipelineJob(), job.Pipeline)
+
+	// Optional inputs can be left unbound
+	pipeline, prep, _ := getMockedPipelineBuilder()
+	prep.WithOptionalInputs("seed")
+	_, err = pipeline.Build()
+	a.Nil(err)
+}
+
+func TestPipelineBuilder_AddComponentStep(t *testing.T) {
+	a := assert.New(t)
+	component := getMockedComponentVersion()
+
+	// The optional input epochs and the input lr with a default value can be left unbound
+	pipeline := NewPipelineBuilder("pipeline-1").WithDefaultCompute("cluster-id")
+	train := pipeline.AddComponentStep("train", component).BindInput("data", LiteralBinding("azureml:raw:1"))
+	pipeline.WithOutput("model", JobOutput{OutputType: JobOutputTypeMLflowModel}, train.Output("model"))
+	job, err := pipeline.Build()
+	a.Nil(err)
+	a.Equal(component.Id, job.Pipeline.Steps["train"].ComponentId)
+
+	pipeline = NewPipelineBuilder("pipeline-1").WithDefaultCompute("cluster-id")
+	pipeline.AddComponentStep("train", component)
+	_, err = pipeline.Build()
+	a.Equal(InvalidArgumentError{"input data of step train is not bound"}, err)
+}
+
+func TestPipelineBuilder_BuildInvalid(t *testing.T) {
+	a := assert.New(t)
+
+	testCases := map[string]func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder){
+		"unbound input": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			delete(train.step.Inputs, "lr")
+		},
+		"undeclared input": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			train.BindInput("epochs", LiteralBinding("10"))
+		},
+		"undeclared step output": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			train.BindInput("data", prep.Output("foo"))
+		},
+		"unknown step": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			train.BindInput("data", StepOutputBinding("foo", "data"))
+		},
+		"unknown pipeline input": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			prep.BindInput("raw_data", pipeline.Input("foo"))
+		},
+		"invalid pipeline input": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			pipeline.WithInput("raw", JobInput{InputType: JobInputTypeUriFolder})
+		},
+		"pipeline output bound to an input": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			pipeline.WithOutput("model", JobOutput{OutputType: JobOutputTypeMLflowModel}, pipeline.Input("raw"))
+		},
+		"pipeline output bound to an undeclared output": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			pipeline.WithOutput("model", JobOutput{OutputType: JobOutputTypeMLflowModel}, train.Output("foo"))
+		},
+		"duplicated step": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			pipeline.AddStep("prep", "prep-component-id", nil, nil)
+		},
+		"empty component": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			pipeline.AddStep("evaluate", "", nil, nil)
+		},
+		"no compute": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			pipeline.WithDefaultCompute("")
+		},
+		"cycle": func(pipeline *PipelineBuilder, prep, train *PipelineStepBuilder) {
+			prep.inputs["model"] = true
+			prep.BindInput("model", train.Output("model"))
+		},
+	}
+
+	for testCaseName, invalidate := range testCases {
+		pipeline, prep, train := getMockedPipelineBuilder()
+		invalidate(pipeline, prep, train)
+		job, err := pipeline.Build()
+		a.Nil(job, testCaseName)
+		a.IsType(InvalidArgumentError{}, err, testCaseName)
+	}
+
+	pipeline, _, train := getMockedPipelineBuilder()
+	pipeline.WithOutput("best_model", JobOutput{OutputType: JobOutputTypeMLflowModel}, train.Output("model"))
+	job, err := pipeline.Build()
+	a.Nil(job)
+	a.Equal(InvalidArgumentError{"the pipeline outputs best_model and model are both bound to the output model of step train"}, err)
+
+	job, err = NewPipelineBuilder("empty").WithDefaultCompute("cluster-id").Build()
+	a.Nil(job)
+	a.Equal(InvalidArgumentError{"the pipeline must have at least one step"}, err)
+}
+
+func TestFindPipelineCycle(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(findPipelineCycle(getMockedPipelineJob().Steps))
+
+	steps := map[string]PipelineStep{
+		"a": {Name: "a", Inputs: map[string]PipelineBinding{"x": StepOutputBinding("c", "out")}},
+		"b": {Name: "b", Inputs: map[string]PipelineBinding{"x": StepOutputBinding("a", "out")}},
+		"c": {Name: "c", Inputs: map[string]PipelineBinding{"x": StepOutputBinding("b", "out")}},
+		"d": {Name: "d", Inputs: map[string]PipelineBinding{"x": StepOutputBinding("a", "out")}},
+	}
+	a.Equal([]string{"a", "c", "b", "a"}, findPipelineCycle(steps))
+
+	selfLoop := map[string]PipelineStep{
+		"a": {Name: "a", Inputs: map[string]PipelineBinding{"x": StepOutputBinding("a", "out")}},
+	}
+	a.Equal([]string{"a", "a"}, findPipelineCycle(selfLoop))
+}
+
+func TestToWritePipelineJobSchema(t *testing.T) {
+	a := assert.New(t)
+
+	pipeline, _, _ := getMockedPipelineBuilder()
+	job, _ := pipeline.Build()
+	schema, err := json.Marshal(toWriteJobSchema(job))
+	a.Nil(err)
+
+	a.JSONEq(`{
+		"properties": {
+			"jobType": "Pipeline",
+			"isArchived": false,
+			"inputs": {"raw": {"jobInputType": "uri_folder", "uri": "azureml:raw:1"}},
+			"outputs": {"model": {"jobOutputType": "mlflow_model"}},
+			"settings": {"default_compute": "cluster-id", "continue_on_step_failure": true},
+			"jobs": {
+				"prep": {
+					"name": "prep",
+					"type": "command",
+					"componentId": "prep-component-id",
+					"inputs": {"raw_data": {"job_input_type": "literal", "value": "${{parent.inputs.raw}}"}}
+				},
+				"train": {
+					"name": "train",
+					"type": "command",
+					"componentId": "train-component-id",
+					"computeId": "gpu-cluster-id",
+					"inputs": {
+						"data": {"job_input_type": "literal", "value": "${{parent.jobs.prep.outputs.data}}"},
+						"lr": {"job_input_type": "literal", "value": "0.01"}
+					},
+					"outputs": {"model": {"type": "literal", "value": "${{parent.outputs.model}}"}}
+				}
+			}
+		}
+	}`, string(schema))
+}
+
+func TestUnmarshalPipelineJob(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &JobConverter{l.Sugar()}
+
+	job := converter.unmarshalJob(loadExampleResp("example_resp_get_pipeline_job.json"))
+	a.Equal(JobTypePipeline, job.JobType)
+	a.Equal(JobStatusRunning, job.Status)
+	a.Equal(getMockedPipelineJob(), job.Pipeline)
+}
+
+func TestWorkspace_CreateOrUpdatePipelineJob(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+
+	pipeline, _, _ := getMockedPipelineBuilder()
+	job, err := pipeline.Build()
+	a.Nil(err)
+
+	mockedHttpClient := new(MockedHttpClient)
+	mockedHttpClient.On("doPut", "jobs/pipeline-1", toWriteJobSchema(job)).Return(http.StatusCreated, string(loadExampleResp("example_resp_get_pipeline_job.json")), nil)
+
+	ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+	result, err := ws.CreateOrUpdateJob("rg", "ws", job)
+	a.Nil(err)
+	a.Equal(getMockedPipelineJob(), result.Pipeline)
+	mockedHttpClient.AssertExpectations(t)
+}
@@ -0,0 +1,451 @@
+package workspace
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultOperationPollInterval The interval between two consecutive checks of a long-running operation, used
+// when the APIs do not specify it with the Retry-After header. The synchronous methods, e.g. CreateOrUpdateDatastore
+// and DeleteModelVersion, always use it when the APIs run the operation asynchronously.
+const DefaultOperationPollInterval = 10 * time.Second
+
+const (
+	asyncOperationHeader = "Azure-AsyncOperation"
+	locationHeader       = "Location"
+)
+
+// OperationStatus The status of a long-running operation
+type OperationStatus string
+
+const (
+	OperationStatusInProgress OperationStatus = "InProgress"
+	OperationStatusSucceeded  OperationStatus = "Succeeded"
+	OperationStatusFailed     OperationStatus = "Failed"
+	OperationStatusCanceled   OperationStatus = "Canceled"
+)
+
+// toOperationStatus Convert the status or the provisioning state returned by the APIs, such as "Creating" or
+// "Deleting", to the status of the operation. An empty state means that the resource has no pending operations.
+func toOperationStatus(state string) OperationStatus {
+	switch strings.ToLower(state) {
+	case "", "succeeded":
+		return OperationStatusSucceeded
+	case "failed":
+		return OperationStatusFailed
+	case "canceled", "cancelled":
+		return OperationStatusCanceled
+	default:
+		return OperationStatusInProgress
+	}
+}
+
+// operation A long-running operation started by a PUT or a DELETE request. The operation is tracked through the
+// Azure-AsyncOperation or the Location header of the response, or by retrieving the provisioning state of the
+// resource if the response has none of them.
+type operation struct {
+	client HttpClientAPI
+	logger *zap.SugaredLogger
+
+	// resourceGroup, workspace The workspace of the resource, used for resuming the operation
+	resourceGroup string
+	workspace     string
+
+	// resourcePath The path of the resource retrieved once the operation succeeds, empty if the operation
+	// has no result (e.g. a deletion)
+	resourcePath      string
+	asyncOperationUrl string
+	locationUrl       string
+
+	status OperationStatus
+	err    error
+
+	// retryAfter, hasRetryAfter The delay requested by the Retry-After header of the last response, if any
+	retryAfter    time.Duration
+	hasRetryAfter bool
+
+	// started Whether the operation has just been started with a status URL that has not been polled yet, in which
+	// case the first check waits the Retry-After of the initial response
+	started bool
+
+	// result The body of the resource returned when starting the operation, replaced with the final one once
+	// the operation succeeds
+	result []byte
+}
+
+// newOperation Return the operation started by the request whose response is provided as argument
+func newOperation(client HttpClientAPI, logger *zap.SugaredLogger, resourcePath string, resp *http.Response, body []byte) *operation {
+	o := &operation{
+		client:            client,
+		logger:            logger,
+		resourcePath:      resourcePath,
+		asyncOperationUrl: resp.Header.Get(asyncOperationHeader),
+		locationUrl:       resp.Header.Get(locationHeader),
+		status:            OperationStatusInProgress,
+		result:            body,
+	}
+	o.updateRetryAfter(resp)
+	switch {
+	case o.asyncOperationUrl != "" || o.locationUrl != "":
+		o.started = true
+	case resourcePath == "":
+		o.status = OperationStatusSucceeded
+	default:
+		o.updateFromResource(body)
+	}
+	return o
+}
+
+func (o *operation) done() bool {
+	return o.status != OperationStatusInProgress
+}
+
+// poll Check the status of the operation once, unless it is already done. The returned error is the one of the
+// request: the error of a failed operation is instead kept in err.
+func (o *operation) poll(ctx context.Context) error {
+	if o.done() {
+		return nil
+	}
+	o.started = false
+
+	var resp *http.Response
+	var err error
+	switch {
+	case o.asyncOperationUrl != "":
+		resp, err = o.client.doGetUrl(ctx, o.asyncOperationUrl)
+	case o.locationUrl != "":
+		resp, err = o.client.doGetUrl(ctx, o.locationUrl)
+	default:
+		resp, err = o.client.doGet(ctx, o.resourcePath)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	o.updateRetryAfter(resp)
+
+	switch {
+	case o.asyncOperationUrl != "":
+		if resp.StatusCode != http.StatusOK {
+			return newHttpResponseError(resp, body)
+		}
+		// Unlike an empty provisioning state, a missing status does not mean that the operation is done
+		state := gjson.GetBytes(body, "status").Str
+		if state == "" {
+			break
+		}
+		switch status := toOperationStatus(state); status {
+		case OperationStatusSucceeded:
+			return o.succeed(ctx, nil)
+		case OperationStatusFailed, OperationStatusCanceled:
+			o.fail(status, body)
+		}
+	case o.locationUrl != "":
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+		case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+			return o.succeed(ctx, body)
+		default:
+			o.status = OperationStatusFailed
+			o.err = newHttpResponseError(resp, body)
+		}
+	default:
+		if resp.StatusCode != http.StatusOK {
+			return newHttpResponseError(resp, body)
+		}
+		o.updateFromResource(body)
+	}
+
+	o.logger.Debugf("operation on %s is %s", o.resourcePath, o.status)
+	return nil
+}
+
+// pollUntilDone Poll the operation until it is done, waiting before each check the interval requested by the
+// APIs or, if not specified, the one provided as argument. An operation tracked through a status URL is first
+// checked only after waiting, as requested by the response that started it.
+func (o *operation) pollUntilDone(ctx context.Context, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		pollInterval = DefaultOperationPollInterval
+	}
+	if o.started && !o.done() {
+		if err := sleepWithContext(ctx, o.nextInterval(pollInterval)); err != nil {
+			return err
+		}
+	}
+	for {
+		if err := o.poll(ctx); err != nil {
+			return err
+		}
+		if o.done() {
+			return o.err
+		}
+		if err := sleepWithContext(ctx, o.nextInterval(pollInterval)); err != nil {
+			return err
+		}
+	}
+}
+
+// nextInterval Return the delay before the next check of the operation: the Retry-After of the last response,
+// even if zero, or else the interval provided as argument
+func (o *operation) nextInterval(pollInterval time.Duration) time.Duration {
+	if o.hasRetryAfter {
+		return o.retryAfter
+	}
+	return pollInterval
+}
+
+// succeed Mark the operation as succeeded, retrieving the resource if the final response provided as argument
+// does not contain it
+func (o *operation) succeed(ctx context.Context, body []byte) error {
+	if o.resourcePath != "" && len(body) == 0 {
+		resp, err := o.client.doGet(ctx, o.resourcePath)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if body, err = ioutil.ReadAll(resp.Body); err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return newHttpResponseError(resp, body)
+		}
+	}
+	o.status = OperationStatusSucceeded
+	o.result = body
+	return nil
+}
+
+// fail Mark the operation as failed with the status provided as argument and the error contained in the body
+// of the operation status
+func (o *operation) fail(status OperationStatus, body []byte) {
+	jsonError := gjson.GetBytes(body, "error")
+	o.status = status
+	o.err = &OperationFailedError{
+		Status:  status,
+		Code:    jsonError.Get("code").Str,
+		Message: jsonError.Get("message").Str,
+		Details: unmarshalArmErrorDetails(jsonError.Get("details")),
+	}
+}
+
+// updateFromResource Update the status of the operation from the provisioning state of the resource provided
+// as argument
+func (o *operation) updateFromResource(body []byte) {
+	switch status := toOperationStatus(gjson.GetBytes(body, "properties.provisioningState").Str); status {
+	case OperationStatusSucceeded:
+		o.status = status
+		o.result = body
+	case OperationStatusFailed, OperationStatusCanceled:
+		o.fail(status, body)
+	}
+}
+
+func (o *operation) updateRetryAfter(resp *http.Response) {
+	o.retryAfter, o.hasRetryAfter = parseRetryAfter(resp.Header, time.Now())
+}
+
+// Poller Track a long-running operation whose result is of type T, such as the creation of an online endpoint.
+// The operation is either polled step by step with Poll, or until it is done with PollUntilDone. A poller can be
+// resumed by another process through its ResumeToken and ResumePoller.
+type Poller[T any] struct {
+	operation *operation
+	kind      pollerKind
+	unmarshal func(body []byte) T
+}
+
+// OnlineEndpointPoller Track the creation or the update of an online endpoint.
+type OnlineEndpointPoller = Poller[*OnlineEndpoint]
+
+// OnlineDeploymentPoller Track the creation or the update of an online deployment.
+type OnlineDeploymentPoller = Poller[*OnlineDeployment]
+
+// BatchEndpointPoller Track the creation or the update of a batch endpoint.
+type BatchEndpointPoller = Poller[*BatchEndpoint]
+
+// BatchDeploymentPoller Track the creation or the update of a batch deployment.
+type BatchDeploymentPoller = Poller[*BatchDeployment]
+
+// ComputePoller Track the creation or the update of a compute.
+type ComputePoller = Poller[*Compute]
+
+// AmlWorkspacePoller Track the creation or the update of an AML workspace.
+type AmlWorkspacePoller = Poller[*AmlWorkspace]
+
+// DeletionPoller Track the deletion of a resource. The deletions have no result.
+type DeletionPoller = Poller[struct{}]
+
+func newPoller[T any](operation *operation, kind pollerKind, unmarshal func(body []byte) T) *Poller[T] {
+	return &Poller[T]{operation: operation, kind: kind, unmarshal: unmarshal}
+}
+
+// Done Return true if the operation completed, either successfully or not.
+func (p *Poller[T]) Done() bool {
+	return p.operation.done()
+}
+
+// Poll Check the status of the operation once.
+func (p *Poller[T]) Poll(ctx context.Context) error {
+	return p.operation.poll(ctx)
+}
+
+// Result Return the result of the operation once it succeeded, the error of the operation if it failed or
+// ErrOperationInProgress if it is not done yet.
+func (p *Poller[T]) Result() (T, error) {
+	var result T
+	if !p.operation.done() {
+		return result, ErrOperationInProgress
+	}
+	if p.operation.err != nil {
+		return result, p.operation.err
+	}
+	return p.unmarshal(p.operation.result), nil
+}
+
+// PollUntilDone Poll the operation every pollInterval until it is done, then return its result. The interval
+// requested by the APIs through the Retry-After header takes precedence over pollInterval.
+func (p *Poller[T]) PollUntilDone(ctx context.Context, pollInterval time.Duration) (T, error) {
+	if err := p.operation.pollUntilDone(ctx, pollInterval); err != nil {
+		var result T
+		return result, err
+	}
+	return p.Result()
+}
+
+// ResumeToken Return a token from which ResumePoller creates a poller tracking the same operation, e.g. after a
+// restart of the process. Operations that are already done cannot be resumed.
+func (p *Poller[T]) ResumeToken() (string, error) {
+	if p.operation.done() {
+		return "", errors.New("the operation is done and cannot be resumed")
+	}
+	state, err := json.Marshal(resumeState{
+		Kind:              p.kind,
+		ResourceGroup:     p.operation.resourceGroup,
+		Workspace:         p.operation.workspace,
+		ResourcePath:      p.operation.resourcePath,
+		AsyncOperationUrl: p.operation.asyncOperationUrl,
+		LocationUrl:       p.operation.locationUrl,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(state), nil
+}
+
+// pollerKind The kind of the resources whose operations are tracked by a poller, used for resuming the pollers
+type pollerKind string
+
+const (
+	pollerKindOnlineEndpoint   pollerKind = "OnlineEndpoint"
+	pollerKindOnlineDeployment pollerKind = "OnlineDeployment"
+	pollerKindBatchEndpoint    pollerKind = "BatchEndpoint"
+	pollerKindBatchDeployment  pollerKind = "BatchDeployment"
+	pollerKindCompute          pollerKind = "Compute"
+	pollerKindAmlWorkspace     pollerKind = "AmlWorkspace"
+	pollerKindDeletion         pollerKind = "Deletion"
+)
+
+// resumeState The state of an operation encoded in the resume tokens
+type resumeState struct {
+	Kind              pollerKind `json:"kind"`
+	ResourceGroup     string     `json:"resourceGroup"`
+	Workspace         string     `json:"workspace"`
+	ResourcePath      string     `json:"resourcePath,omitempty"`
+	AsyncOperationUrl string     `json:"asyncOperationUrl,omitempty"`
+	LocationUrl       string     `json:"locationUrl,omitempty"`
+}
+
+// ResumePoller Return a poller tracking the operation of the token provided as argument, returned by ResumeToken.
+// The type of the poller must match the one of the poller which returned the token, e.g.
+// ResumePoller[*OnlineEndpoint] for resuming an OnlineEndpointPoller.
+func ResumePoller[T any](w *Workspace, token string) (*Poller[T], error) {
+	rawState, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, InvalidArgumentError{fmt.Sprintf("malformed resume token: %s", err.Error())}
+	}
+	var state resumeState
+	if err := json.Unmarshal(rawState, &state); err != nil {
+		return nil, InvalidArgumentError{fmt.Sprintf("malformed resume token: %s", err.Error())}
+	}
+	if state.AsyncOperationUrl == "" && state.LocationUrl == "" && state.ResourcePath == "" {
+		return nil, InvalidArgumentError{"the resume token does not reference any operation"}
+	}
+
+	unmarshal, ok := w.pollerUnmarshaler(state).(func(body []byte) T)
+	if !ok {
+		var result T
+		return nil, InvalidArgumentError{fmt.Sprintf("the resume token of a %s operation cannot resume a poller of %T", state.Kind, result)}
+	}
+	// The operations on the AML workspaces are the only ones sent by clients not bound to a workspace
+	client := w.httpClientBuilder.newClient(state.ResourceGroup, state.Workspace)
+	if state.Workspace == "" {
+		client = w.httpClientBuilder.newWorkspaceCollectionClient(state.ResourceGroup)
+	}
+	operation := &operation{
+		client:            client,
+		logger:            w.logger,
+		resourceGroup:     state.ResourceGroup,
+		workspace:         state.Workspace,
+		resourcePath:      state.ResourcePath,
+		asyncOperationUrl: state.AsyncOperationUrl,
+		locationUrl:       state.LocationUrl,
+		status:            OperationStatusInProgress,
+	}
+	return newPoller(operation, state.Kind, unmarshal), nil
+}
+
+// pollerUnmarshaler Return the function unmarshalling the result of the operations of the state provided as
+// argument, or nil if the kind of the operations is unknown
+func (w *Workspace) pollerUnmarshaler(state resumeState) interface{} {
+	switch state.Kind {
+	case pollerKindOnlineEndpoint:
+		return w.endpointConverter.unmarshalOnlineEndpoint
+	case pollerKindOnlineDeployment:
+		endpointName := endpointNameFromPath(state.ResourcePath)
+		return func(body []byte) *OnlineDeployment {
+			return w.endpointConverter.unmarshalOnlineDeployment(endpointName, body)
+		}
+	case pollerKindBatchEndpoint:
+		return w.endpointConverter.unmarshalBatchEndpoint
+	case pollerKindBatchDeployment:
+		endpointName := endpointNameFromPath(state.ResourcePath)
+		return func(body []byte) *BatchDeployment {
+			return w.endpointConverter.unmarshalBatchDeployment(endpointName, body)
+		}
+	case pollerKindCompute:
+		return w.computeConverter.unmarshalCompute
+	case pollerKindAmlWorkspace:
+		return unmarshalAmlWorkspace
+	case pollerKindDeletion:
+		return unmarshalDeletion
+	default:
+		return nil
+	}
+}
+
+// unmarshalDeletion Return the result of the deletions, which is empty
+func unmarshalDeletion([]byte) struct{} {
+	return struct{}{}
+}
+
+// endpointNameFromPath Return the name of the endpoint from the path of one of its deployments, e.g.
+// onlineEndpoints/endpoint/deployments/blue
+func endpointNameFromPath(path string) string {
+	if parts := strings.Split(path, "/"); len(parts) > 1 {
+		return parts[1]
+	}
+	return ""
+}
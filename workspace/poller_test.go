@@ -0,0 +1,293 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const (
+	mockedAsyncOperationUrl = "https://management.azure.com/subscriptions/sub/providers/Microsoft.MachineLearningServices/locations/westeurope/mfeOperationsStatus/op"
+	mockedLocationUrl       = "https://management.azure.com/subscriptions/sub/providers/Microsoft.MachineLearningServices/locations/westeurope/mfeOperationResults/op"
+)
+
+// newMockedHeader Return the headers with the names and values provided as argument, e.g. "Location", "https://..."
+func newMockedHeader(namesAndValues ...string) http.Header {
+	header := http.Header{}
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		header.Set(namesAndValues[i], namesAndValues[i+1])
+	}
+	return header
+}
+
+func TestToOperationStatus(t *testing.T) {
+	a := assert.New(t)
+
+	testCases := map[string]OperationStatus{
+		"":           OperationStatusSucceeded,
+		"Succeeded":  OperationStatusSucceeded,
+		"failed":     OperationStatusFailed,
+		"Canceled":   OperationStatusCanceled,
+		"Cancelled":  OperationStatusCanceled,
+		"InProgress": OperationStatusInProgress,
+		"Creating":   OperationStatusInProgress,
+		"Deleting":   OperationStatusInProgress,
+	}
+	for state, expected := range testCases {
+		a.Equal(expected, toOperationStatus(state), state)
+	}
+}
+
+func TestOperation(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	resourceResp := "{\"name\": \"resource\", \"properties\": {\"provisioningState\": \"Succeeded\"}}"
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test operation completed synchronously",
+			testCase: func() {
+				resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+				o := newOperation(new(MockedHttpClient), logger, "resources/resource", resp, []byte(resourceResp))
+				a.True(o.done())
+				a.Equal(OperationStatusSucceeded, o.status)
+				a.Equal(resourceResp, string(o.result))
+				a.Nil(o.poll(context.Background()))
+			},
+		},
+		{
+			testCaseName: "Test operation tracked with Azure-AsyncOperation",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"InProgress\"}", nil, newMockedHeader("Retry-After", "1")).Once()
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"Succeeded\"}", nil).Once()
+				mockedHttpClient.On("doGet", "resources/resource").Return(http.StatusOK, resourceResp, nil)
+
+				resp := &http.Response{StatusCode: http.StatusCreated, Header: newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl)}
+				o := newOperation(mockedHttpClient, logger, "resources/resource", resp, []byte("{\"properties\": {\"provisioningState\": \"Creating\"}}"))
+				a.False(o.done())
+
+				a.Nil(o.poll(context.Background()))
+				a.False(o.done())
+				a.Equal(time.Second, o.retryAfter)
+
+				a.Nil(o.poll(context.Background()))
+				a.True(o.done())
+				a.Nil(o.err)
+				a.Equal(resourceResp, string(o.result))
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test operation with a missing or empty status is in progress",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{}", nil).Once()
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"\"}", nil).Once()
+
+				resp := &http.Response{StatusCode: http.StatusCreated, Header: newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl)}
+				o := newOperation(mockedHttpClient, logger, "resources/resource", resp, nil)
+				a.Nil(o.poll(context.Background()))
+				a.False(o.done())
+				a.Nil(o.poll(context.Background()))
+				a.False(o.done())
+				mockedHttpClient.AssertNotCalled(t, "doGet", "resources/resource")
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test operation failed",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(
+					http.StatusOK,
+					"{\"status\": \"Failed\", \"error\": {\"code\": \"BadArgument\", \"message\": \"Invalid model\"}}",
+					nil,
+				)
+
+				resp := &http.Response{StatusCode: http.StatusCreated, Header: newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl)}
+				o := newOperation(mockedHttpClient, logger, "resources/resource", resp, nil)
+				err := o.pollUntilDone(context.Background(), time.Millisecond)
+				a.Equal(&OperationFailedError{Status: OperationStatusFailed, Code: "BadArgument", Message: "Invalid model"}, err)
+				a.Equal("operation failed [error code BadArgument]: Invalid model", err.Error())
+				a.True(o.done())
+			},
+		},
+		{
+			testCaseName: "Test operation tracked with Location",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusAccepted, "", nil).Once()
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusNoContent, "", nil).Once()
+
+				resp := &http.Response{StatusCode: http.StatusAccepted, Header: newMockedHeader(locationHeader, mockedLocationUrl)}
+				o := newOperation(mockedHttpClient, logger, "", resp, nil)
+				a.Nil(o.pollUntilDone(context.Background(), time.Millisecond))
+				a.Equal(OperationStatusSucceeded, o.status)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test operation tracked with the provisioning state",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "resources/resource").Return(http.StatusOK, resourceResp, nil)
+
+				resp := &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}}
+				o := newOperation(mockedHttpClient, logger, "resources/resource", resp, []byte("{\"properties\": {\"provisioningState\": \"Updating\"}}"))
+				a.False(o.done())
+				a.Nil(o.pollUntilDone(context.Background(), time.Millisecond))
+				a.Equal(resourceResp, string(o.result))
+			},
+		},
+		{
+			testCaseName: "Test poll error does not complete the operation",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusInternalServerError, "", nil)
+
+				resp := &http.Response{StatusCode: http.StatusAccepted, Header: newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl)}
+				o := newOperation(mockedHttpClient, logger, "resources/resource", resp, nil)
+				err := o.poll(context.Background())
+				a.IsType(&HttpResponseError{}, err)
+				a.False(o.done())
+			},
+		},
+		{
+			testCaseName: "Test poll until done waits the Retry-After of the initial response",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedLocationUrl).Return(http.StatusNoContent, "", nil)
+
+				resp := &http.Response{StatusCode: http.StatusAccepted, Header: newMockedHeader(locationHeader, mockedLocationUrl, "Retry-After-Ms", "30")}
+				o := newOperation(mockedHttpClient, logger, "", resp, nil)
+				start := time.Now()
+				a.Nil(o.pollUntilDone(context.Background(), time.Hour))
+				a.GreaterOrEqual(time.Since(start), 30*time.Millisecond)
+				mockedHttpClient.AssertNumberOfCalls(t, "doGetUrl", 1)
+
+				mockedHttpClient = new(MockedHttpClient)
+				o = newOperation(mockedHttpClient, logger, "", resp, nil)
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+				defer cancel()
+				a.True(errors.Is(o.pollUntilDone(ctx, time.Hour), context.DeadlineExceeded))
+				mockedHttpClient.AssertNotCalled(t, "doGetUrl", mockedLocationUrl)
+			},
+		},
+		{
+			testCaseName: "Test poll until done stops when the context is done",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"InProgress\"}", nil)
+
+				resp := &http.Response{StatusCode: http.StatusAccepted, Header: newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl)}
+				o := newOperation(mockedHttpClient, logger, "resources/resource", resp, nil)
+				ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+				defer cancel()
+				err := o.pollUntilDone(ctx, 5*time.Millisecond)
+				a.True(errors.Is(err, context.DeadlineExceeded))
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestPoller_ResumeToken(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	endpointResp := string(loadExampleResp("example_resp_get_online_endpoint.json"))
+	asyncOperationHeaders := newMockedHeader(asyncOperationHeader, mockedAsyncOperationUrl, "Retry-After", "0")
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test resume poller of online endpoint",
+			testCase: func() {
+				endpoint := getMockedOnlineEndpoint()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "onlineEndpoints/endpoint", toWriteOnlineEndpointSchema(endpoint)).Return(
+					http.StatusCreated, "{\"properties\": {\"provisioningState\": \"Creating\"}}", nil, asyncOperationHeaders,
+				)
+				mockedHttpClient.On("doGetUrl", mockedAsyncOperationUrl).Return(http.StatusOK, "{\"status\": \"Succeeded\"}", nil)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, endpointResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				poller, err := ws.CreateOrUpdateOnlineEndpoint("rg", "ws", endpoint)
+				a.Nil(err)
+				token, err := poller.ResumeToken()
+				a.Nil(err)
+
+				resumed, err := ResumePoller[*OnlineEndpoint](ws, token)
+				a.Nil(err)
+				a.False(resumed.Done())
+				result, err := resumed.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				a.Equal("endpoint", result.Name)
+
+				_, err = resumed.ResumeToken()
+				a.NotNil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test resume poller of deployment",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint/deployments/blue").Return(
+					http.StatusOK, string(loadExampleResp("example_resp_get_online_deployment.json")), nil,
+				)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				operation := &operation{resourceGroup: "rg", workspace: "ws", resourcePath: "onlineEndpoints/endpoint/deployments/blue", status: OperationStatusInProgress}
+				unmarshal := func(body []byte) *OnlineDeployment { return nil }
+				token, err := newPoller(operation, pollerKindOnlineDeployment, unmarshal).ResumeToken()
+				a.Nil(err)
+
+				resumed, err := ResumePoller[*OnlineDeployment](ws, token)
+				a.Nil(err)
+				result, err := resumed.PollUntilDone(context.Background(), time.Millisecond)
+				a.Nil(err)
+				a.Equal("endpoint", result.EndpointName)
+				a.Equal("blue", result.Name)
+			},
+		},
+		{
+			testCaseName: "Test resume poller with invalid token",
+			testCase: func() {
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				operation := &operation{resourceGroup: "rg", workspace: "ws", locationUrl: mockedLocationUrl, status: OperationStatusInProgress}
+				token, err := newPoller(operation, pollerKindDeletion, unmarshalDeletion).ResumeToken()
+				a.Nil(err)
+
+				_, err = ResumePoller[struct{}](ws, token)
+				a.Nil(err)
+				_, err = ResumePoller[*Compute](ws, token)
+				a.IsType(InvalidArgumentError{}, err)
+				_, err = ResumePoller[struct{}](ws, "not a token")
+				a.IsType(InvalidArgumentError{}, err)
+				_, err = ResumePoller[struct{}](ws, "e30")
+				a.IsType(InvalidArgumentError{}, err)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
@@ -0,0 +1,182 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (w *Workspace) GetModels(resourceGroup, workspace string) ([]Model, error) {
+	return w.GetModelsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetModelsWithContext(ctx context.Context, resourceGroup, workspace string) ([]Model, error) {
+	pager := w.NewModelPager(resourceGroup, workspace)
+	result := make([]Model, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewModelPager Return a pager for iterating page by page over the registered models of the workspace.
+func (w *Workspace) NewModelPager(resourceGroup, workspace string) *ModelPager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "models"), unmarshalModelArray)
+}
+
+func (w *Workspace) GetModel(resourceGroup, workspace, name string) (*Model, error) {
+	return w.GetModelWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetModelWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Model, error) {
+	path := fmt.Sprintf("models/%s", name)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"model", name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalModel(body), nil
+}
+
+func (w *Workspace) CreateOrUpdateModel(resourceGroup, workspace string, model *Model) (*Model, error) {
+	return w.CreateOrUpdateModelWithContext(context.Background(), resourceGroup, workspace, model)
+}
+
+func (w *Workspace) CreateOrUpdateModelWithContext(ctx context.Context, resourceGroup, workspace string, model *Model) (*Model, error) {
+	if strings.TrimSpace(model.Name) == "" {
+		return nil, InvalidArgumentError{"the model name cannot be empty"}
+	}
+
+	path := fmt.Sprintf("models/%s", model.Name)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteModelSchema(model))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalModel(body), nil
+}
+
+// ArchiveModel Archive the model with the name provided as argument. Archived models are hidden from the
+// default list operations, but can still be used.
+func (w *Workspace) ArchiveModel(resourceGroup, workspace, name string) (*Model, error) {
+	return w.ArchiveModelWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) ArchiveModelWithContext(ctx context.Context, resourceGroup, workspace, name string) (*Model, error) {
+	model, err := w.GetModelWithContext(ctx, resourceGroup, workspace, name)
+	if err != nil {
+		return nil, err
+	}
+	model.IsArchived = true
+	return w.CreateOrUpdateModelWithContext(ctx, resourceGroup, workspace, model)
+}
+
+func (w *Workspace) DeleteModel(resourceGroup, workspace, name string) error {
+	return w.DeleteModelWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) DeleteModelWithContext(ctx context.Context, resourceGroup, workspace, name string) error {
+	path := fmt.Sprintf("models/%s", name)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"model", name})
+}
+
+func (w *Workspace) GetModelVersions(resourceGroup, workspace, modelName string) ([]ModelVersion, error) {
+	return w.GetModelVersionsWithContext(context.Background(), resourceGroup, workspace, modelName)
+}
+
+func (w *Workspace) GetModelVersionsWithContext(ctx context.Context, resourceGroup, workspace, modelName string) ([]ModelVersion, error) {
+	pager := w.NewModelVersionPager(resourceGroup, workspace, modelName)
+	result := make([]ModelVersion, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+	}
+	return result, nil
+}
+
+// NewModelVersionPager Return a pager for iterating page by page over the versions of the model
+// with the name provided as argument.
+func (w *Workspace) NewModelVersionPager(resourceGroup, workspace, modelName string) *ModelVersionPager {
+	path := fmt.Sprintf("models/%s/versions", modelName)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"model", modelName}
+	return newTypedPager(pager, func(body []byte) []ModelVersion {
+		return w.modelConverter.unmarshalModelVersionArray(modelName, body)
+	})
+}
+
+func (w *Workspace) GetModelVersion(resourceGroup, workspace, modelName, version string) (*ModelVersion, error) {
+	return w.GetModelVersionWithContext(context.Background(), resourceGroup, workspace, modelName, version)
+}
+
+func (w *Workspace) GetModelVersionWithContext(ctx context.Context, resourceGroup, workspace, modelName, version string) (*ModelVersion, error) {
+	path := fmt.Sprintf("models/%s/versions/%s", modelName, version)
+	body, err := w.getResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"model", assetVersionIdentifier(modelName, version)})
+	if err != nil {
+		return nil, err
+	}
+	return w.modelConverter.unmarshalModelVersion(modelName, body), nil
+}
+
+func (w *Workspace) CreateOrUpdateModelVersion(resourceGroup, workspace string, modelVersion *ModelVersion) (*ModelVersion, error) {
+	return w.CreateOrUpdateModelVersionWithContext(context.Background(), resourceGroup, workspace, modelVersion)
+}
+
+func (w *Workspace) CreateOrUpdateModelVersionWithContext(ctx context.Context, resourceGroup, workspace string, modelVersion *ModelVersion) (*ModelVersion, error) {
+	if err := validateModelVersion(modelVersion); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("models/%s/versions/%s", modelVersion.Name, modelVersion.Version)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, toWriteModelVersionSchema(modelVersion))
+	if err != nil {
+		return nil, err
+	}
+	return w.modelConverter.unmarshalModelVersion(modelVersion.Name, body), nil
+}
+
+// ArchiveModelVersion Archive the version provided as argument of the model with the specified name.
+func (w *Workspace) ArchiveModelVersion(resourceGroup, workspace, modelName, version string) (*ModelVersion, error) {
+	return w.ArchiveModelVersionWithContext(context.Background(), resourceGroup, workspace, modelName, version)
+}
+
+func (w *Workspace) ArchiveModelVersionWithContext(ctx context.Context, resourceGroup, workspace, modelName, version string) (*ModelVersion, error) {
+	modelVersion, err := w.GetModelVersionWithContext(ctx, resourceGroup, workspace, modelName, version)
+	if err != nil {
+		return nil, err
+	}
+	modelVersion.IsArchived = true
+	return w.CreateOrUpdateModelVersionWithContext(ctx, resourceGroup, workspace, modelVersion)
+}
+
+func (w *Workspace) DeleteModelVersion(resourceGroup, workspace, modelName, version string) error {
+	return w.DeleteModelVersionWithContext(context.Background(), resourceGroup, workspace, modelName, version)
+}
+
+func (w *Workspace) DeleteModelVersionWithContext(ctx context.Context, resourceGroup, workspace, modelName, version string) error {
+	path := fmt.Sprintf("models/%s/versions/%s", modelName, version)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"model", assetVersionIdentifier(modelName, version)})
+}
+
+func validateModelVersion(modelVersion *ModelVersion) error {
+	if strings.TrimSpace(modelVersion.Name) == "" {
+		return InvalidArgumentError{"the model name cannot be empty"}
+	}
+	if strings.TrimSpace(modelVersion.Version) == "" {
+		return InvalidArgumentError{"the model version cannot be empty"}
+	}
+	if modelVersion.Path == nil && strings.TrimSpace(modelVersion.Uri) == "" {
+		return InvalidArgumentError{"the model path cannot be empty"}
+	}
+	switch modelVersion.ModelType {
+	case "", ModelTypeCustom, ModelTypeMLflow, ModelTypeTriton:
+		return nil
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid model type %q", modelVersion.ModelType)}
+	}
+}
@@ -0,0 +1,224 @@
+package workspace
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+func getMockedModelVersion() *ModelVersion {
+	return &ModelVersion{
+		Name:      "model",
+		Version:   "2",
+		ModelType: ModelTypeMLflow,
+		Path:      &DatastorePath{DatastoreName: "workspaceblobstore", Path: "models/model/"},
+		Flavors:   map[string]map[string]string{"python_function": {"loader_module": "mlflow.sklearn"}},
+	}
+}
+
+func TestUnmarshalModelVersion(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &ModelConverter{l.Sugar()}
+
+	modelVersion := converter.unmarshalModelVersion("model", loadExampleResp("example_resp_get_model_version.json"))
+	a.Equal("model", modelVersion.Name)
+	a.Equal("2", modelVersion.Version)
+	a.Equal("test", modelVersion.Description)
+	a.Equal(ModelTypeMLflow, modelVersion.ModelType)
+	a.Equal(&DatastorePath{DatastoreName: "workspaceblobstore", Path: "models/model/"}, modelVersion.Path)
+	a.Equal("job-1", modelVersion.JobName)
+	a.Equal(map[string]string{"accuracy": "0.93"}, modelVersion.Tags)
+	a.Equal(map[string]string{"framework": "sklearn"}, modelVersion.Properties)
+	a.Equal(map[string]map[string]string{
+		"python_function": {"loader_module": "mlflow.sklearn", "python_version": "3.8.10"},
+		"sklearn":         {"sklearn_version": "1.0.2"},
+	}, modelVersion.Flavors)
+	a.False(modelVersion.IsArchived)
+
+	modelVersion = converter.unmarshalModelVersion("model", []byte("{\"name\": \"1\", \"properties\": {\"modelUri\": \"https://foo\"}}"))
+	a.Nil(modelVersion.Path)
+}
+
+func TestToWriteModelVersionSchema(t *testing.T) {
+	a := assert.New(t)
+
+	schema := toWriteModelVersionSchema(getMockedModelVersion()).Properties.(WriteModelVersionSchema)
+	a.Equal(ModelTypeMLflow, schema.ModelType)
+	a.Equal("azureml://datastores/workspaceblobstore/paths/models/model/", schema.ModelUri)
+	a.Equal(map[string]FlavorDataSchema{"python_function": {Data: map[string]string{"loader_module": "mlflow.sklearn"}}}, schema.Flavors)
+
+	schema = toWriteModelVersionSchema(&ModelVersion{Path: &DatastorePath{DatastoreName: "ds", Path: "model"}}).Properties.(WriteModelVersionSchema)
+	a.Equal(ModelTypeCustom, schema.ModelType)
+	a.Nil(schema.Flavors)
+}
+
+func TestWorkspace_GetModels(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+
+	mockedHttpClient := new(MockedHttpClient)
+	mockedHttpClient.On("doGet", "models").Return(http.StatusOK, string(loadExampleResp("example_resp_get_models.json")), nil)
+	mockedHttpClient.On("doGet", "models/model").Return(http.StatusOK, string(loadExampleResp("example_resp_get_model.json")), nil)
+	mockedHttpClient.On("doGet", "models/foo").Return(http.StatusNotFound, "", nil)
+
+	ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+	models, err := ws.GetModels("rg", "ws")
+	a.Nil(err)
+	a.Len(models, 2)
+	a.Equal("other-model", models[1].Name)
+	a.True(models[1].IsArchived)
+
+	model, err := ws.GetModel("rg", "ws", "model")
+	a.Nil(err)
+	a.Equal("model", model.Name)
+	a.Equal("test", model.Description)
+	a.Equal("2", model.LatestVersion)
+	a.Equal("3", model.NextVersion)
+	a.Equal(map[string]string{"team": "ml"}, model.Tags)
+
+	model, err = ws.GetModel("rg", "ws", "foo")
+	a.Nil(model)
+	a.Equal(&ResourceNotFoundError{"model", "foo"}, err)
+}
+
+func TestWorkspace_ModelVersions(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	modelVersionResp := string(loadExampleResp("example_resp_get_model_version.json"))
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test get model versions",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "models/model/versions").Return(http.StatusOK, fmt.Sprintf("{\"value\": [%s]}", modelVersionResp), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				modelVersions, err := ws.GetModelVersions("rg", "ws", "model")
+				a.Nil(err)
+				a.Len(modelVersions, 1)
+				a.Equal("2", modelVersions[0].Version)
+			},
+		},
+		{
+			testCaseName: "Test get model version not found",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "models/model/versions/3").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				modelVersion, err := ws.GetModelVersion("rg", "ws", "model", "3")
+				a.Nil(modelVersion)
+				a.Equal(&ResourceNotFoundError{"model", "model:3"}, err)
+			},
+		},
+		{
+			testCaseName: "Test create or update model version with invalid fields",
+			testCase: func() {
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				invalidModelVersions := []*ModelVersion{
+					{Name: "", Version: "1", Path: &DatastorePath{}},
+					{Name: "model", Path: &DatastorePath{}},
+					{Name: "model", Version: "1"},
+					{Name: "model", Version: "1", Path: &DatastorePath{}, ModelType: "foo"},
+				}
+				for _, modelVersion := range invalidModelVersions {
+					result, err := ws.CreateOrUpdateModelVersion("rg", "ws", modelVersion)
+					a.Nil(result)
+					a.IsType(InvalidArgumentError{}, err)
+				}
+			},
+		},
+		{
+			testCaseName: "Test create or update model version success",
+			testCase: func() {
+				modelVersion := getMockedModelVersion()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPut", "models/model/versions/2", toWriteModelVersionSchema(modelVersion)).Return(http.StatusCreated, modelVersionResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.CreateOrUpdateModelVersion("rg", "ws", modelVersion)
+				a.Nil(err)
+				a.Equal("model", result.Name)
+				a.Equal(ModelTypeMLflow, result.ModelType)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test archive model version registered from a job output",
+			testCase: func() {
+				jobOutputUri := "azureml://jobs/job-name/outputs/artifacts/paths/model/"
+				jobOutputResp := fmt.Sprintf(`{"name": "2", "properties": {"modelUri": %q, "modelType": "mlflow_model"}}`, jobOutputUri)
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "models/model/versions/2").Return(http.StatusOK, jobOutputResp, nil)
+				mockedHttpClient.On("doPut", "models/model/versions/2", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					properties := schema.Properties.(WriteModelVersionSchema)
+					return properties.IsArchived && properties.ModelUri == jobOutputUri
+				})).Return(http.StatusOK, jobOutputResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				result, err := ws.ArchiveModelVersion("rg", "ws", "model", "2")
+				a.Nil(err)
+				a.Nil(result.Path)
+				a.Equal(jobOutputUri, result.Uri)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test archive model version",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "models/model/versions/2").Return(http.StatusOK, modelVersionResp, nil)
+				mockedHttpClient.On("doPut", "models/model/versions/2", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					return schema.Properties.(WriteModelVersionSchema).IsArchived
+				})).Return(http.StatusOK, modelVersionResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				_, err := ws.ArchiveModelVersion("rg", "ws", "model", "2")
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test archive model",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "models/model").Return(http.StatusOK, string(loadExampleResp("example_resp_get_model.json")), nil)
+				mockedHttpClient.On("doPut", "models/model", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					properties := schema.Properties.(WriteModelSchema)
+					return properties.IsArchived && properties.Description == "test"
+				})).Return(http.StatusOK, string(loadExampleResp("example_resp_get_model.json")), nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				_, err := ws.ArchiveModel("rg", "ws", "model")
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test delete model and model version",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doDelete", "models/model/versions/2").Return(http.StatusOK, "", nil)
+				mockedHttpClient.On("doDelete", "models/model").Return(http.StatusNotFound, "", nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				a.Nil(ws.DeleteModelVersion("rg", "ws", "model", "2"))
+				a.Equal(&ResourceNotFoundError{"model", "model"}, ws.DeleteModel("rg", "ws", "model"))
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
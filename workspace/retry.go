@@ -0,0 +1,247 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rateLimitRemainingHeaderPrefix = "X-Ms-Ratelimit-Remaining-"
+	rateLimitLowThreshold          = 10
+)
+
+// RetryPolicy Configure how the requests sent to the AML APIs are retried when they fail with a transient error.
+type RetryPolicy struct {
+	// MaxAttempts The maximum number of attempts of each request, including the first one. Values lower than 2
+	// disable the retries.
+	MaxAttempts int
+
+	// InitialBackoff The delay before the first retry. The delay doubles at each following retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff The maximum delay between two attempts computed with the exponential backoff. The delay
+	// requested by the server with the Retry-After header is always honored.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes The HTTP status codes for which the request is retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy Return the retry policy used when none is provided in the Config.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 800 * time.Millisecond,
+		MaxBackoff:     60 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+type retrier struct {
+	policy RetryPolicy
+	logger *zap.SugaredLogger
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+func newRetrier(policy RetryPolicy, logger *zap.SugaredLogger) *retrier {
+	return &retrier{
+		policy: policy,
+		logger: logger,
+		sleep:  sleepWithContext,
+	}
+}
+
+// do Send the request with the client provided as argument, retrying it according to the retry policy.
+// Requests with a body are replayed using their GetBody function.
+func (r *retrier) do(client *http.Client, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := client.Do(attemptReq)
+		if err == nil {
+			r.logRateLimit(resp)
+		}
+		if attempt >= r.policy.MaxAttempts || !r.shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+
+		delay := r.delay(attempt, resp)
+		if err != nil {
+			r.logger.Warnf("%s %s failed (attempt %d/%d): %s, retrying in %s", req.Method, req.URL, attempt, r.policy.MaxAttempts, err, delay)
+		} else {
+			r.logger.Warnf("%s %s returned %d (attempt %d/%d), retrying in %s", req.Method, req.URL, resp.StatusCode, attempt, r.policy.MaxAttempts, delay)
+			drainAndClose(resp.Body)
+		}
+
+		if err := r.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry Return true if the request should be sent again. Idempotent requests (GET, PUT, DELETE)
+// are retried on transport errors and on any retryable status code, while the other requests are retried
+// only when throttled, since in that case the request has not been processed.
+func (r *retrier) shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method) && requestErrorIsTransient(err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return r.isRetryableStatusCode(resp.StatusCode)
+	}
+	return isIdempotent(method) && r.isRetryableStatusCode(resp.StatusCode)
+}
+
+func (r *retrier) isRetryableStatusCode(statusCode int) bool {
+	for _, code := range r.policy.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// delay Return how long to wait before the next attempt. The delay requested by the server is used if present,
+// otherwise the delay is computed with an exponential backoff with jitter.
+func (r *retrier) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header, time.Now()); ok {
+			return retryAfter
+		}
+		if remaining, ok := rateLimitRemaining(resp.Header); ok && remaining == 0 {
+			return r.policy.MaxBackoff
+		}
+	}
+
+	backoff := r.policy.InitialBackoff
+	for i := 1; i < attempt && backoff < r.policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.policy.MaxBackoff {
+		backoff = r.policy.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Add jitter to avoid synchronized retries, picking the delay in [backoff/2, backoff]
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+func (r *retrier) logRateLimit(resp *http.Response) {
+	if remaining, ok := rateLimitRemaining(resp.Header); ok && remaining < rateLimitLowThreshold {
+		r.logger.Warnf("ARM rate limit almost exhausted: %d requests remaining", remaining)
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// requestErrorIsTransient Return false for errors caused by the caller, such as a cancelled context.
+func requestErrorIsTransient(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// parseRetryAfter Parse the delay requested by the server, expressed either in milliseconds
+// (retry-after-ms, x-ms-retry-after-ms) or with the standard Retry-After header (seconds or HTTP date).
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	for _, h := range []string{"Retry-After-Ms", "X-Ms-Retry-After-Ms"} {
+		if v := header.Get(h); v != "" {
+			if ms, err := strconv.Atoi(v); err == nil && ms >= 0 {
+				return time.Duration(ms) * time.Millisecond, true
+			}
+		}
+	}
+
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// rateLimitRemaining Return the lowest value among the x-ms-ratelimit-remaining-* headers of the response.
+func rateLimitRemaining(header http.Header) (int, bool) {
+	found := false
+	lowest := 0
+	for name, values := range header {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(name), rateLimitRemainingHeaderPrefix) {
+			continue
+		}
+		for _, v := range values {
+			// Some headers are in the format "<resource>;<count>"
+			if i := strings.LastIndexAny(v, ";:"); i >= 0 {
+				v = v[i+1:]
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				continue
+			}
+			if !found || n < lowest {
+				lowest = n
+				found = true
+			}
+		}
+	}
+	return lowest, found
+}
+
+func drainAndClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	_, _ = io.Copy(ioutil.Discard, body)
+	_ = body.Close()
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
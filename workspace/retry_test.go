@@ -0,0 +1,246 @@
+package workspace
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestRetrier Return a retrier that records the delays instead of sleeping
+func newTestRetrier(policy RetryPolicy, delays *[]time.Duration) *retrier {
+	l, _ := zap.NewDevelopment()
+	r := newRetrier(policy, l.Sugar())
+	r.sleep = func(ctx context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return ctx.Err()
+	}
+	return r
+}
+
+// newScriptedServer Return a test server replying with the status codes provided as argument, in order.
+// Once the status codes are over, the server replies with 200 OK.
+func newScriptedServer(statusCodes []int, headers http.Header, receivedBodies *[]string) (*httptest.Server, *int) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if receivedBodies != nil {
+			*receivedBodies = append(*receivedBodies, string(body))
+		}
+		statusCode := http.StatusOK
+		if attempts < len(statusCodes) {
+			statusCode = statusCodes[attempts]
+			for k, v := range headers {
+				w.Header()[k] = v
+			}
+		}
+		attempts++
+		w.WriteHeader(statusCode)
+	}))
+	return server, &attempts
+}
+
+func TestRetrier_Do(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	policy := RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       time.Second,
+		MaxBackoff:           10 * time.Second,
+		RetryableStatusCodes: DefaultRetryPolicy().RetryableStatusCodes,
+	}
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test GET retried on transient errors until success",
+			testCase: func() {
+				var delays []time.Duration
+				server, attempts := newScriptedServer([]int{http.StatusServiceUnavailable, http.StatusBadGateway}, nil, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+				resp, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusOK, resp.StatusCode)
+				a.Equal(3, *attempts)
+				a.Len(delays, 2)
+				a.True(delays[0] >= 500*time.Millisecond && delays[0] <= time.Second)
+				a.True(delays[1] >= time.Second && delays[1] <= 2*time.Second)
+			},
+		},
+		{
+			testCaseName: "Test GET returns last response when attempts are exhausted",
+			testCase: func() {
+				var delays []time.Duration
+				statusCodes := []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+				server, attempts := newScriptedServer(statusCodes, nil, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+				resp, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+				a.Equal(3, *attempts)
+			},
+		},
+		{
+			testCaseName: "Test GET not retried on non retryable status code",
+			testCase: func() {
+				var delays []time.Duration
+				server, attempts := newScriptedServer([]int{http.StatusBadRequest}, nil, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+				resp, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusBadRequest, resp.StatusCode)
+				a.Equal(1, *attempts)
+				a.Empty(delays)
+			},
+		},
+		{
+			testCaseName: "Test PUT body is replayed at each attempt",
+			testCase: func() {
+				var delays []time.Duration
+				var bodies []string
+				server, attempts := newScriptedServer([]int{http.StatusInternalServerError}, nil, &bodies)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodPut, server.URL, bytes.NewBuffer([]byte("{\"foo\":\"bar\"}")))
+				resp, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusOK, resp.StatusCode)
+				a.Equal(2, *attempts)
+				a.Equal([]string{"{\"foo\":\"bar\"}", "{\"foo\":\"bar\"}"}, bodies)
+			},
+		},
+		{
+			testCaseName: "Test POST not retried on server errors",
+			testCase: func() {
+				var delays []time.Duration
+				server, attempts := newScriptedServer([]int{http.StatusServiceUnavailable}, nil, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+				resp, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+				a.Equal(1, *attempts)
+			},
+		},
+		{
+			testCaseName: "Test POST retried when throttled, honoring Retry-After",
+			testCase: func() {
+				var delays []time.Duration
+				headers := http.Header{"Retry-After": []string{"7"}}
+				server, attempts := newScriptedServer([]int{http.StatusTooManyRequests}, headers, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+				resp, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusOK, resp.StatusCode)
+				a.Equal(2, *attempts)
+				a.Equal([]time.Duration{7 * time.Second}, delays)
+			},
+		},
+		{
+			testCaseName: "Test throttled request with exhausted rate limit waits max backoff",
+			testCase: func() {
+				var delays []time.Duration
+				headers := http.Header{"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"0"}}
+				server, _ := newScriptedServer([]int{http.StatusTooManyRequests}, headers, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+				_, err := newTestRetrier(policy, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal([]time.Duration{policy.MaxBackoff}, delays)
+			},
+		},
+		{
+			testCaseName: "Test retries disabled",
+			testCase: func() {
+				var delays []time.Duration
+				server, attempts := newScriptedServer([]int{http.StatusServiceUnavailable}, nil, nil)
+				defer server.Close()
+
+				req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+				resp, err := newTestRetrier(RetryPolicy{MaxAttempts: 1}, &delays).do(server.Client(), req)
+				a.Nil(err)
+				a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+				a.Equal(1, *attempts)
+			},
+		},
+		{
+			testCaseName: "Test context cancelled while waiting for the next attempt",
+			testCase: func() {
+				server, attempts := newScriptedServer([]int{http.StatusServiceUnavailable}, nil, nil)
+				defer server.Close()
+
+				ctx, cancel := context.WithCancel(context.Background())
+				r := newRetrier(policy, logger)
+				r.sleep = func(ctx context.Context, d time.Duration) error {
+					cancel()
+					return sleepWithContext(ctx, d)
+				}
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+				resp, err := r.do(server.Client(), req)
+				a.Nil(resp)
+				a.Equal(context.Canceled, err)
+				a.Equal(1, *attempts)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	a := assert.New(t)
+	now := time.Date(2021, 10, 25, 10, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter(http.Header{"Retry-After": []string{"12"}}, now)
+	a.True(ok)
+	a.Equal(12*time.Second, d)
+
+	d, ok = parseRetryAfter(http.Header{"Retry-After": []string{"Mon, 25 Oct 2021 10:00:30 GMT"}}, now)
+	a.True(ok)
+	a.Equal(30*time.Second, d)
+
+	d, ok = parseRetryAfter(http.Header{"Retry-After-Ms": []string{"250"}, "Retry-After": []string{"12"}}, now)
+	a.True(ok)
+	a.Equal(250*time.Millisecond, d)
+
+	_, ok = parseRetryAfter(http.Header{"Retry-After": []string{"invalid"}}, now)
+	a.False(ok)
+
+	_, ok = parseRetryAfter(http.Header{}, now)
+	a.False(ok)
+}
+
+func TestRateLimitRemaining(t *testing.T) {
+	a := assert.New(t)
+
+	_, ok := rateLimitRemaining(http.Header{})
+	a.False(ok)
+
+	remaining, ok := rateLimitRemaining(http.Header{
+		"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"11999"},
+		"X-Ms-Ratelimit-Remaining-Resource":           []string{"Microsoft.MachineLearningServices/workspaces;42"},
+	})
+	a.True(ok)
+	a.Equal(42, remaining)
+}
@@ -3,15 +3,20 @@ package workspace
 type WriteDatastoreSecretsSchema struct {
 	SecretsType     string `json:"secretsType"`
 	AccountKey      string `json:"key,omitempty"`
+	SasToken        string `json:"sasToken,omitempty"`
 	ClientSecret    string `json:"clientSecret,omitempty"`
+	Certificate     string `json:"certificate,omitempty"`
 	SqlUserPassword string `json:"password,omitempty"`
 }
 
 type WriteDatastoreCredentialsSchema struct {
 	CredentialsType string                       `json:"credentialsType"`
-	Secrets         *WriteDatastoreSecretsSchema `json:"secrets"`
+	Secrets         *WriteDatastoreSecretsSchema `json:"secrets,omitempty"`
 	ClientId        string                       `json:"clientId,omitempty"`
 	TenantId        string                       `json:"tenantId,omitempty"`
+	Thumbprint      string                       `json:"thumbprint,omitempty"`
+	AuthorityUrl    string                       `json:"authorityUrl,omitempty"`
+	ResourceUrl     string                       `json:"resourceUrl,omitempty"`
 	SqlUserName     string                       `json:"userId,omitempty"`
 }
 
@@ -19,9 +24,14 @@ type WriteDatastoreSchema struct {
 	ContentsType         string                           `json:"contentsType"`
 	StorageAccountName   string                           `json:"accountName,omitempty"`
 	StorageContainerName string                           `json:"containerName,omitempty"`
+	StoreName            string                           `json:"storeName,omitempty"`
+	ServerName           string                           `json:"serverName,omitempty"`
+	DatabaseName         string                           `json:"databaseName,omitempty"`
+	PortNumber           int                              `json:"portNumber,omitempty"`
+	EnableSsl            *bool                            `json:"enableSSL,omitempty"`
 	Credentials          *WriteDatastoreCredentialsSchema `json:"credentials,omitempty"`
-	Endpoint             string                           `json:"endpoint"`
-	Protocol             string                           `json:"protocol"`
+	Endpoint             string                           `json:"endpoint,omitempty"`
+	Protocol             string                           `json:"protocol,omitempty"`
 }
 
 type WriteDatastoreSchemaProperties struct {
@@ -30,6 +40,19 @@ type WriteDatastoreSchemaProperties struct {
 	Description string               `json:"description"`
 }
 
+type WriteGaDatastoreSchema struct {
+	DatastoreType      string                           `json:"datastoreType"`
+	Description        string                           `json:"description,omitempty"`
+	StorageAccountName string                           `json:"accountName,omitempty"`
+	ContainerName      string                           `json:"containerName,omitempty"`
+	FileShareName      string                           `json:"fileShareName,omitempty"`
+	Filesystem         string                           `json:"filesystem,omitempty"`
+	StoreName          string                           `json:"storeName,omitempty"`
+	Credentials        *WriteDatastoreCredentialsSchema `json:"credentials,omitempty"`
+	Endpoint           string                           `json:"endpoint,omitempty"`
+	Protocol           string                           `json:"protocol,omitempty"`
+}
+
 type DatasetPathsSchema struct {
 	FilePath      string `json:"file,omitempty"`
 	DirectoryPath string `json:"folder,omitempty"`
@@ -39,6 +62,486 @@ type WriteDatasetSchema struct {
 	Paths       []DatasetPathsSchema `json:"paths"`
 }
 
+type WriteDataVersionSchema struct {
+	Description string            `json:"description,omitempty"`
+	DataType    DataType          `json:"dataType"`
+	DataUri     string            `json:"dataUri"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	IsArchived  bool              `json:"isArchived"`
+}
+
+type WriteModelSchema struct {
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	IsArchived  bool              `json:"isArchived"`
+}
+
+type FlavorDataSchema struct {
+	Data map[string]string `json:"data,omitempty"`
+}
+
+type WriteModelVersionSchema struct {
+	Description string                      `json:"description,omitempty"`
+	ModelType   ModelType                   `json:"modelType"`
+	ModelUri    string                      `json:"modelUri"`
+	JobName     string                      `json:"jobName,omitempty"`
+	Tags        map[string]string           `json:"tags,omitempty"`
+	Properties  map[string]string           `json:"properties,omitempty"`
+	Flavors     map[string]FlavorDataSchema `json:"flavors,omitempty"`
+	IsArchived  bool                        `json:"isArchived"`
+}
+
+type WriteEnvironmentSchema struct {
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	IsArchived  bool              `json:"isArchived"`
+}
+
+type BuildContextSchema struct {
+	ContextUri     string `json:"contextUri"`
+	DockerfilePath string `json:"dockerfilePath,omitempty"`
+}
+
+type RouteSchema struct {
+	Path string `json:"path"`
+	Port int    `json:"port"`
+}
+
+type InferenceConfigSchema struct {
+	LivenessRoute  *RouteSchema `json:"livenessRoute,omitempty"`
+	ReadinessRoute *RouteSchema `json:"readinessRoute,omitempty"`
+	ScoringRoute   *RouteSchema `json:"scoringRoute,omitempty"`
+}
+
+type WriteEnvironmentVersionSchema struct {
+	Description     string                 `json:"description,omitempty"`
+	Image           string                 `json:"image,omitempty"`
+	Build           *BuildContextSchema    `json:"build,omitempty"`
+	CondaFile       string                 `json:"condaFile,omitempty"`
+	OsType          OsType                 `json:"osType,omitempty"`
+	InferenceConfig *InferenceConfigSchema `json:"inferenceConfig,omitempty"`
+	Tags            map[string]string      `json:"tags,omitempty"`
+	Properties      map[string]string      `json:"properties,omitempty"`
+	IsArchived      bool                   `json:"isArchived"`
+}
+
+type WriteComponentSchema struct {
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	IsArchived  bool              `json:"isArchived"`
+}
+
+type ComponentInputSchema struct {
+	Type        ComponentIOType `json:"type"`
+	Description string          `json:"description,omitempty"`
+	Optional    bool            `json:"optional,omitempty"`
+	Default     interface{}     `json:"default,omitempty"`
+}
+
+type ComponentOutputSchema struct {
+	Type        ComponentIOType `json:"type"`
+	Description string          `json:"description,omitempty"`
+}
+
+// CommandComponentSpecSchema The specification of a command component, following the YAML schema of the components
+type CommandComponentSpecSchema struct {
+	Schema      string                           `json:"$schema"`
+	Name        string                           `json:"name"`
+	Version     string                           `json:"version"`
+	Type        ComponentType                    `json:"type"`
+	DisplayName string                           `json:"display_name,omitempty"`
+	Description string                           `json:"description,omitempty"`
+	Command     string                           `json:"command"`
+	Code        string                           `json:"code,omitempty"`
+	Environment string                           `json:"environment"`
+	Inputs      map[string]ComponentInputSchema  `json:"inputs,omitempty"`
+	Outputs     map[string]ComponentOutputSchema `json:"outputs,omitempty"`
+}
+
+type WriteComponentVersionSchema struct {
+	Description   string                     `json:"description,omitempty"`
+	ComponentSpec CommandComponentSpecSchema `json:"componentSpec"`
+	Tags          map[string]string          `json:"tags,omitempty"`
+	Properties    map[string]string          `json:"properties,omitempty"`
+	IsArchived    bool                       `json:"isArchived"`
+}
+
+type IdentitySchema struct {
+	Type                   IdentityType        `json:"type"`
+	UserAssignedIdentities map[string]struct{} `json:"userAssignedIdentities,omitempty"`
+}
+
+type ResourceIdSchema struct {
+	Id string `json:"id"`
+}
+
+type ScaleSettingsSchema struct {
+	MinNodeCount                int    `json:"minNodeCount"`
+	MaxNodeCount                int    `json:"maxNodeCount"`
+	NodeIdleTimeBeforeScaleDown string `json:"nodeIdleTimeBeforeScaleDown,omitempty"`
+}
+
+type AmlComputePropertiesSchema struct {
+	VmSize                      string               `json:"vmSize"`
+	VmPriority                  string               `json:"vmPriority,omitempty"`
+	ScaleSettings               *ScaleSettingsSchema `json:"scaleSettings,omitempty"`
+	Subnet                      *ResourceIdSchema    `json:"subnet,omitempty"`
+	RemoteLoginPortPublicAccess string               `json:"remoteLoginPortPublicAccess,omitempty"`
+}
+
+type SshSettingsSchema struct {
+	SshPublicAccess string `json:"sshPublicAccess"`
+	AdminPublicKey  string `json:"adminPublicKey,omitempty"`
+}
+
+type AssignedUserSchema struct {
+	ObjectId string `json:"objectId"`
+	TenantId string `json:"tenantId"`
+}
+
+type PersonalComputeInstanceSettingsSchema struct {
+	AssignedUser AssignedUserSchema `json:"assignedUser"`
+}
+
+type ComputeInstancePropertiesSchema struct {
+	VmSize                          string                                 `json:"vmSize"`
+	Subnet                          *ResourceIdSchema                      `json:"subnet,omitempty"`
+	SshSettings                     *SshSettingsSchema                     `json:"sshSettings,omitempty"`
+	PersonalComputeInstanceSettings *PersonalComputeInstanceSettingsSchema `json:"personalComputeInstanceSettings,omitempty"`
+	ApplicationSharingPolicy        string                                 `json:"applicationSharingPolicy,omitempty"`
+}
+
+type WriteComputePropertiesSchema struct {
+	ComputeType      ComputeType `json:"computeType"`
+	Description      string      `json:"description,omitempty"`
+	DisableLocalAuth bool        `json:"disableLocalAuth"`
+	Properties       interface{} `json:"properties"`
+}
+
+// WriteComputeSchema The schema used for writing computes, which differently from the other resources
+// have a location and an identity besides the properties
+type WriteComputeSchema struct {
+	Location   string                       `json:"location,omitempty"`
+	Identity   *IdentitySchema              `json:"identity,omitempty"`
+	Properties WriteComputePropertiesSchema `json:"properties"`
+}
+
+type OnlineEndpointPropertiesSchema struct {
+	AuthMode            EndpointAuthMode    `json:"authMode"`
+	Description         string              `json:"description,omitempty"`
+	Properties          map[string]string   `json:"properties,omitempty"`
+	Traffic             map[string]int      `json:"traffic,omitempty"`
+	MirrorTraffic       map[string]int      `json:"mirrorTraffic,omitempty"`
+	PublicNetworkAccess PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+	Compute             string              `json:"compute,omitempty"`
+}
+
+type WriteOnlineEndpointSchema struct {
+	Location   string                         `json:"location"`
+	Kind       string                         `json:"kind,omitempty"`
+	Identity   *IdentitySchema                `json:"identity"`
+	Tags       map[string]string              `json:"tags,omitempty"`
+	Properties OnlineEndpointPropertiesSchema `json:"properties"`
+}
+
+type SkuSchema struct {
+	Name     string `json:"name"`
+	Capacity int    `json:"capacity,omitempty"`
+}
+
+type CodeConfigurationSchema struct {
+	CodeId        string `json:"codeId"`
+	ScoringScript string `json:"scoringScript"`
+}
+
+type OnlineScaleSettingsSchema struct {
+	ScaleType                   ScaleType `json:"scaleType"`
+	MinInstances                int       `json:"minInstances,omitempty"`
+	MaxInstances                int       `json:"maxInstances,omitempty"`
+	PollingInterval             string    `json:"pollingInterval,omitempty"`
+	TargetUtilizationPercentage int       `json:"targetUtilizationPercentage,omitempty"`
+}
+
+type OnlineRequestSettingsSchema struct {
+	MaxConcurrentRequestsPerInstance int    `json:"maxConcurrentRequestsPerInstance,omitempty"`
+	RequestTimeout                   string `json:"requestTimeout,omitempty"`
+	MaxQueueWait                     string `json:"maxQueueWait,omitempty"`
+}
+
+type ProbeSettingsSchema struct {
+	FailureThreshold int    `json:"failureThreshold,omitempty"`
+	SuccessThreshold int    `json:"successThreshold,omitempty"`
+	InitialDelay     string `json:"initialDelay,omitempty"`
+	Period           string `json:"period,omitempty"`
+	Timeout          string `json:"timeout,omitempty"`
+}
+
+type OnlineDeploymentPropertiesSchema struct {
+	EndpointComputeType  string                       `json:"endpointComputeType"`
+	Description          string                       `json:"description,omitempty"`
+	Properties           map[string]string            `json:"properties,omitempty"`
+	Model                string                       `json:"model,omitempty"`
+	EnvironmentId        string                       `json:"environmentId,omitempty"`
+	CodeConfiguration    *CodeConfigurationSchema     `json:"codeConfiguration,omitempty"`
+	EnvironmentVariables map[string]string            `json:"environmentVariables,omitempty"`
+	InstanceType         string                       `json:"instanceType"`
+	ScaleSettings        *OnlineScaleSettingsSchema   `json:"scaleSettings,omitempty"`
+	RequestSettings      *OnlineRequestSettingsSchema `json:"requestSettings,omitempty"`
+	LivenessProbe        *ProbeSettingsSchema         `json:"livenessProbe,omitempty"`
+	ReadinessProbe       *ProbeSettingsSchema         `json:"readinessProbe,omitempty"`
+	AppInsightsEnabled   bool                         `json:"appInsightsEnabled"`
+}
+
+type WriteOnlineDeploymentSchema struct {
+	Location   string                           `json:"location"`
+	Tags       map[string]string                `json:"tags,omitempty"`
+	Sku        *SkuSchema                       `json:"sku,omitempty"`
+	Properties OnlineDeploymentPropertiesSchema `json:"properties"`
+}
+
+type BatchEndpointDefaultsSchema struct {
+	DeploymentName string `json:"deploymentName,omitempty"`
+}
+
+type BatchEndpointPropertiesSchema struct {
+	AuthMode    EndpointAuthMode             `json:"authMode"`
+	Description string                       `json:"description,omitempty"`
+	Properties  map[string]string            `json:"properties,omitempty"`
+	Defaults    *BatchEndpointDefaultsSchema `json:"defaults,omitempty"`
+}
+
+type WriteBatchEndpointSchema struct {
+	Location   string                        `json:"location"`
+	Identity   *IdentitySchema               `json:"identity"`
+	Tags       map[string]string             `json:"tags,omitempty"`
+	Properties BatchEndpointPropertiesSchema `json:"properties"`
+}
+
+type AssetReferenceSchema struct {
+	ReferenceType string `json:"referenceType"`
+	AssetId       string `json:"assetId"`
+}
+
+type ResourceConfigurationSchema struct {
+	InstanceCount int `json:"instanceCount,omitempty"`
+}
+
+type BatchRetrySettingsSchema struct {
+	MaxRetries int    `json:"maxRetries"`
+	Timeout    string `json:"timeout,omitempty"`
+}
+
+type BatchDeploymentPropertiesSchema struct {
+	Description               string                       `json:"description,omitempty"`
+	Properties                map[string]string            `json:"properties,omitempty"`
+	Model                     *AssetReferenceSchema        `json:"model,omitempty"`
+	EnvironmentId             string                       `json:"environmentId,omitempty"`
+	CodeConfiguration         *CodeConfigurationSchema     `json:"codeConfiguration,omitempty"`
+	EnvironmentVariables      map[string]string            `json:"environmentVariables,omitempty"`
+	Compute                   string                       `json:"compute"`
+	Resources                 *ResourceConfigurationSchema `json:"resources,omitempty"`
+	MaxConcurrencyPerInstance int                          `json:"maxConcurrencyPerInstance,omitempty"`
+	MiniBatchSize             int                          `json:"miniBatchSize,omitempty"`
+	ErrorThreshold            int                          `json:"errorThreshold"`
+	LoggingLevel              BatchLoggingLevel            `json:"loggingLevel,omitempty"`
+	OutputAction              BatchOutputAction            `json:"outputAction,omitempty"`
+	OutputFileName            string                       `json:"outputFileName,omitempty"`
+	RetrySettings             *BatchRetrySettingsSchema    `json:"retrySettings,omitempty"`
+}
+
+type WriteBatchDeploymentSchema struct {
+	Location   string                          `json:"location"`
+	Tags       map[string]string               `json:"tags,omitempty"`
+	Properties BatchDeploymentPropertiesSchema `json:"properties"`
+}
+
+// BatchInvocationSchema The body of the requests invoking a batch endpoint, sent to its scoring URI
+type BatchInvocationSchema struct {
+	Properties BatchInvocationPropertiesSchema `json:"properties"`
+}
+
+type BatchInvocationPropertiesSchema struct {
+	InputData  map[string]BatchInvocationDataSchema `json:"InputData"`
+	OutputData map[string]BatchInvocationDataSchema `json:"OutputData,omitempty"`
+}
+
+type BatchInvocationDataSchema struct {
+	JobInputType  string `json:"JobInputType,omitempty"`
+	JobOutputType string `json:"JobOutputType,omitempty"`
+	Uri           string `json:"Uri"`
+}
+
+type JobInputSchema struct {
+	JobInputType JobInputType `json:"jobInputType"`
+	Description  string       `json:"description,omitempty"`
+	Uri          string       `json:"uri,omitempty"`
+	Mode         DataMode     `json:"mode,omitempty"`
+	Value        string       `json:"value,omitempty"`
+}
+
+type JobOutputSchema struct {
+	JobOutputType JobOutputType `json:"jobOutputType"`
+	Description   string        `json:"description,omitempty"`
+	Uri           string        `json:"uri,omitempty"`
+	Mode          DataMode      `json:"mode,omitempty"`
+}
+
+type DistributionSchema struct {
+	DistributionType        DistributionType `json:"distributionType"`
+	ProcessCountPerInstance int              `json:"processCountPerInstance,omitempty"`
+	WorkerCount             int              `json:"workerCount,omitempty"`
+	ParameterServerCount    int              `json:"parameterServerCount,omitempty"`
+}
+
+type JobResourcesSchema struct {
+	InstanceCount int               `json:"instanceCount,omitempty"`
+	InstanceType  string            `json:"instanceType,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+}
+
+type JobLimitsSchema struct {
+	JobLimitsType string `json:"jobLimitsType"`
+	Timeout       string `json:"timeout,omitempty"`
+}
+
+// WriteJobBaseSchema The properties common to all the job types, flattened into the schema of each job type
+type WriteJobBaseSchema struct {
+	JobType        JobType           `json:"jobType"`
+	DisplayName    string            `json:"displayName,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	ExperimentName string            `json:"experimentName,omitempty"`
+	ComputeId      string            `json:"computeId,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Properties     map[string]string `json:"properties,omitempty"`
+	IsArchived     bool              `json:"isArchived"`
+}
+
+type WriteCommandJobSchema struct {
+	WriteJobBaseSchema
+
+	Command              string                     `json:"command"`
+	CodeId               string                     `json:"codeId,omitempty"`
+	EnvironmentId        string                     `json:"environmentId"`
+	EnvironmentVariables map[string]string          `json:"environmentVariables,omitempty"`
+	Inputs               map[string]JobInputSchema  `json:"inputs,omitempty"`
+	Outputs              map[string]JobOutputSchema `json:"outputs,omitempty"`
+	Distribution         *DistributionSchema        `json:"distribution,omitempty"`
+	Resources            *JobResourcesSchema        `json:"resources,omitempty"`
+	Limits               *JobLimitsSchema           `json:"limits,omitempty"`
+}
+
+// TrialComponentSchema The command run by each trial of a sweep job
+type TrialComponentSchema struct {
+	Command              string              `json:"command"`
+	CodeId               string              `json:"codeId,omitempty"`
+	EnvironmentId        string              `json:"environmentId"`
+	EnvironmentVariables map[string]string   `json:"environmentVariables,omitempty"`
+	Distribution         *DistributionSchema `json:"distribution,omitempty"`
+	Resources            *JobResourcesSchema `json:"resources,omitempty"`
+}
+
+type SamplingAlgorithmSchema struct {
+	SamplingAlgorithmType SamplingAlgorithm `json:"samplingAlgorithmType"`
+}
+
+type ObjectiveSchema struct {
+	PrimaryMetric string `json:"primaryMetric"`
+	Goal          Goal   `json:"goal"`
+}
+
+type EarlyTerminationPolicySchema struct {
+	PolicyType           EarlyTerminationPolicyType `json:"policyType"`
+	EvaluationInterval   int                        `json:"evaluationInterval,omitempty"`
+	DelayEvaluation      int                        `json:"delayEvaluation,omitempty"`
+	SlackFactor          float64                    `json:"slackFactor,omitempty"`
+	SlackAmount          float64                    `json:"slackAmount,omitempty"`
+	TruncationPercentage int                        `json:"truncationPercentage,omitempty"`
+}
+
+type SweepLimitsSchema struct {
+	JobLimitsType       string `json:"jobLimitsType"`
+	MaxTotalTrials      int    `json:"maxTotalTrials,omitempty"`
+	MaxConcurrentTrials int    `json:"maxConcurrentTrials,omitempty"`
+	Timeout             string `json:"timeout,omitempty"`
+	TrialTimeout        string `json:"trialTimeout,omitempty"`
+}
+
+type WriteSweepJobSchema struct {
+	WriteJobBaseSchema
+
+	// SearchSpace The distribution of each hyperparameter, in the format [<distribution>, [<parameters>...]]
+	SearchSpace       map[string][]interface{}      `json:"searchSpace"`
+	SamplingAlgorithm SamplingAlgorithmSchema       `json:"samplingAlgorithm"`
+	Objective         ObjectiveSchema               `json:"objective"`
+	EarlyTermination  *EarlyTerminationPolicySchema `json:"earlyTermination,omitempty"`
+	Limits            SweepLimitsSchema             `json:"limits"`
+	Trial             TrialComponentSchema          `json:"trial"`
+	Inputs            map[string]JobInputSchema     `json:"inputs,omitempty"`
+	Outputs           map[string]JobOutputSchema    `json:"outputs,omitempty"`
+}
+
+type PipelineSettingsSchema struct {
+	DefaultCompute        string `json:"default_compute,omitempty"`
+	ContinueOnStepFailure bool   `json:"continue_on_step_failure"`
+}
+
+// PipelineStepInputSchema The binding of an input of a pipeline step, expressed as a literal that may contain
+// a reference such as ${{parent.inputs.data}}
+type PipelineStepInputSchema struct {
+	JobInputType JobInputType `json:"job_input_type"`
+	Value        string       `json:"value"`
+}
+
+type PipelineStepOutputSchema struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type PipelineStepSchema struct {
+	Name        string                              `json:"name"`
+	Type        string                              `json:"type"`
+	ComponentId string                              `json:"componentId"`
+	ComputeId   string                              `json:"computeId,omitempty"`
+	Inputs      map[string]PipelineStepInputSchema  `json:"inputs,omitempty"`
+	Outputs     map[string]PipelineStepOutputSchema `json:"outputs,omitempty"`
+}
+
+type WritePipelineJobSchema struct {
+	WriteJobBaseSchema
+
+	Inputs   map[string]JobInputSchema     `json:"inputs,omitempty"`
+	Outputs  map[string]JobOutputSchema    `json:"outputs,omitempty"`
+	Settings PipelineSettingsSchema        `json:"settings"`
+	Jobs     map[string]PipelineStepSchema `json:"jobs"`
+}
+
 type SchemaWrapper struct {
 	Properties interface{} `json:"properties"`
 }
+
+type ManagedNetworkSchema struct {
+	IsolationMode ManagedNetworkIsolationMode `json:"isolationMode"`
+}
+
+type AmlWorkspacePropertiesSchema struct {
+	FriendlyName        string                `json:"friendlyName,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	StorageAccount      string                `json:"storageAccount"`
+	KeyVault            string                `json:"keyVault"`
+	ContainerRegistry   string                `json:"containerRegistry,omitempty"`
+	ApplicationInsights string                `json:"applicationInsights,omitempty"`
+	HbiWorkspace        bool                  `json:"hbiWorkspace,omitempty"`
+	PublicNetworkAccess PublicNetworkAccess   `json:"publicNetworkAccess,omitempty"`
+	ManagedNetwork      *ManagedNetworkSchema `json:"managedNetwork,omitempty"`
+}
+
+type WriteAmlWorkspaceSchema struct {
+	Location   string                       `json:"location"`
+	Identity   *IdentitySchema              `json:"identity"`
+	Tags       map[string]string            `json:"tags,omitempty"`
+	Properties AmlWorkspacePropertiesSchema `json:"properties"`
+}
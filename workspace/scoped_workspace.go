@@ -0,0 +1,976 @@
+package workspace
+
+import (
+	"context"
+	"time"
+)
+
+// ScopedWorkspace A client bound to a single workspace, exposing the operations of Workspace without the resource
+// group and the name of the workspace among their arguments. Its requests share the same underlying HTTP client.
+type ScopedWorkspace struct {
+	workspace     *Workspace
+	resourceGroup string
+	name          string
+}
+
+// Scope Return a client bound to the workspace with the resource group and the name provided as argument, which
+// are validated once instead of at every request. The existence of the workspace is not checked.
+func (w *Workspace) Scope(resourceGroup, name string) (*ScopedWorkspace, error) {
+	if err := validateAmlWorkspaceReference(resourceGroup, name); err != nil {
+		return nil, err
+	}
+	scoped := *w
+	scoped.httpClientBuilder = &scopedClientBuilder{
+		HttpClientBuilderAPI: w.httpClientBuilder,
+		resourceGroupName:    resourceGroup,
+		workspaceName:        name,
+		client:               w.httpClientBuilder.newClient(resourceGroup, name),
+	}
+	return &ScopedWorkspace{workspace: &scoped, resourceGroup: resourceGroup, name: name}, nil
+}
+
+// NewScoped Create a client bound to the workspace of the config provided as argument, as if calling Scope on the
+// client returned by New.
+func NewScoped(config Config, debug bool) (*ScopedWorkspace, error) {
+	if err := checkRequiredFields("config", configField{"ResourceGroup", config.ResourceGroup}, configField{"WorkspaceName", config.WorkspaceName}); err != nil {
+		return nil, err
+	}
+	workspace, err := New(config, debug)
+	if err != nil {
+		return nil, err
+	}
+	return workspace.Scope(config.ResourceGroup, config.WorkspaceName)
+}
+
+// scopedClientBuilder Return the same client for the requests to the workspace it is bound to, delegating the
+// creation of the clients with other scopes, e.g. for listing the workspaces, to the wrapped builder
+type scopedClientBuilder struct {
+	HttpClientBuilderAPI
+	resourceGroupName string
+	workspaceName     string
+	client            HttpClientAPI
+}
+
+func (b *scopedClientBuilder) newClient(resourceGroupName, workspaceName string) HttpClientAPI {
+	if resourceGroupName == b.resourceGroupName && workspaceName == b.workspaceName {
+		return b.client
+	}
+	return b.HttpClientBuilderAPI.newClient(resourceGroupName, workspaceName)
+}
+
+// ResourceGroup Return the resource group of the workspace
+func (s *ScopedWorkspace) ResourceGroup() string {
+	return s.resourceGroup
+}
+
+// Name Return the name of the workspace
+func (s *ScopedWorkspace) Name() string {
+	return s.name
+}
+
+// Workspace Return the client of the whole subscription, e.g. for resuming the pollers with ResumePoller
+func (s *ScopedWorkspace) Workspace() *Workspace {
+	return s.workspace
+}
+
+// GetDatastores Return the list of datastore of the workspace.
+func (s *ScopedWorkspace) GetDatastores() ([]Datastore, error) {
+	return s.workspace.GetDatastores(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetDatastoresWithContext(ctx context.Context) ([]Datastore, error) {
+	return s.workspace.GetDatastoresWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewDatastorePager Return a pager for iterating page by page over the datastores of the workspace.
+func (s *ScopedWorkspace) NewDatastorePager() *DatastorePager {
+	return s.workspace.NewDatastorePager(s.resourceGroup, s.name)
+}
+
+// GetDatastore Return the datastore with the name provided as argument.
+func (s *ScopedWorkspace) GetDatastore(datastoreName string) (*Datastore, error) {
+	return s.workspace.GetDatastore(s.resourceGroup, s.name, datastoreName)
+}
+
+func (s *ScopedWorkspace) GetDatastoreWithContext(ctx context.Context, datastoreName string) (*Datastore, error) {
+	return s.workspace.GetDatastoreWithContext(ctx, s.resourceGroup, s.name, datastoreName)
+}
+
+// DeleteDatastore Delete the datastore with the name provided as argument. Block until the operation completes,
+// checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteDatastore(datastoreName string) error {
+	return s.workspace.DeleteDatastore(s.resourceGroup, s.name, datastoreName)
+}
+
+func (s *ScopedWorkspace) DeleteDatastoreWithContext(ctx context.Context, datastoreName string) error {
+	return s.workspace.DeleteDatastoreWithContext(ctx, s.resourceGroup, s.name, datastoreName)
+}
+
+// CreateOrUpdateDatastore Create or update the datastore with the data provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateDatastore(datastore *Datastore) (*Datastore, error) {
+	return s.workspace.CreateOrUpdateDatastore(s.resourceGroup, s.name, datastore)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateDatastoreWithContext(ctx context.Context, datastore *Datastore) (*Datastore, error) {
+	return s.workspace.CreateOrUpdateDatastoreWithContext(ctx, s.resourceGroup, s.name, datastore)
+}
+
+// GetDatasets Return the list of datasets of the workspace. For each dataset, only its latest version is returned.
+func (s *ScopedWorkspace) GetDatasets() ([]Dataset, error) {
+	return s.workspace.GetDatasets(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetDatasetsWithContext(ctx context.Context) ([]Dataset, error) {
+	return s.workspace.GetDatasetsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewDatasetPager Return a pager for iterating page by page over the datasets of the workspace. For each
+// dataset, only its latest version is returned.
+func (s *ScopedWorkspace) NewDatasetPager() *DatasetPager {
+	return s.workspace.NewDatasetPager(s.resourceGroup, s.name)
+}
+
+// GetDataset Return the dataset with the name and version provided as argument
+func (s *ScopedWorkspace) GetDataset(name string, version int) (*Dataset, error) {
+	return s.workspace.GetDataset(s.resourceGroup, s.name, name, version)
+}
+
+func (s *ScopedWorkspace) GetDatasetWithContext(ctx context.Context, name string, version int) (*Dataset, error) {
+	return s.workspace.GetDatasetWithContext(ctx, s.resourceGroup, s.name, name, version)
+}
+
+// GetDatasetNextVersion Return the next version of the dataset with the name provided as argument
+func (s *ScopedWorkspace) GetDatasetNextVersion(name string) (int, error) {
+	return s.workspace.GetDatasetNextVersion(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetDatasetNextVersionWithContext(ctx context.Context, name string) (int, error) {
+	return s.workspace.GetDatasetNextVersionWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetDatasetVersions Return all the versions of the dataset with the name provided as argument
+func (s *ScopedWorkspace) GetDatasetVersions(datasetName string) ([]Dataset, error) {
+	return s.workspace.GetDatasetVersions(s.resourceGroup, s.name, datasetName)
+}
+
+func (s *ScopedWorkspace) GetDatasetVersionsWithContext(ctx context.Context, datasetName string) ([]Dataset, error) {
+	return s.workspace.GetDatasetVersionsWithContext(ctx, s.resourceGroup, s.name, datasetName)
+}
+
+// NewDatasetVersionPager Return a pager for iterating page by page over the versions of the dataset
+// with the name provided as argument.
+func (s *ScopedWorkspace) NewDatasetVersionPager(datasetName string) *DatasetVersionPager {
+	return s.workspace.NewDatasetVersionPager(s.resourceGroup, s.name, datasetName)
+}
+
+// CreateOrUpdateDataset Create or update the dataset with the data provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateDataset(dataset *Dataset) (*Dataset, error) {
+	return s.workspace.CreateOrUpdateDataset(s.resourceGroup, s.name, dataset)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateDatasetWithContext(ctx context.Context, dataset *Dataset) (*Dataset, error) {
+	return s.workspace.CreateOrUpdateDatasetWithContext(ctx, s.resourceGroup, s.name, dataset)
+}
+
+// DeleteDataset Delete the dataset (all its versions) with the name provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteDataset(datasetName string) error {
+	return s.workspace.DeleteDataset(s.resourceGroup, s.name, datasetName)
+}
+
+func (s *ScopedWorkspace) DeleteDatasetWithContext(ctx context.Context, datasetName string) error {
+	return s.workspace.DeleteDatasetWithContext(ctx, s.resourceGroup, s.name, datasetName)
+}
+
+// DeleteDatasetVersion Delete the version provided as argument of the dataset with the specified name. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteDatasetVersion(datasetName string, version int) error {
+	return s.workspace.DeleteDatasetVersion(s.resourceGroup, s.name, datasetName, version)
+}
+
+func (s *ScopedWorkspace) DeleteDatasetVersionWithContext(ctx context.Context, datasetName string, version int) error {
+	return s.workspace.DeleteDatasetVersionWithContext(ctx, s.resourceGroup, s.name, datasetName, version)
+}
+
+// GetDataAssets Return the list of data assets of the workspace. For each data asset, only its latest version is
+// returned.
+func (s *ScopedWorkspace) GetDataAssets() ([]DataAsset, error) {
+	return s.workspace.GetDataAssets(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetDataAssetsWithContext(ctx context.Context) ([]DataAsset, error) {
+	return s.workspace.GetDataAssetsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewDataAssetPager Return a pager for iterating page by page over the data assets of the workspace. For each
+// data asset, only its latest version is returned.
+func (s *ScopedWorkspace) NewDataAssetPager() *DataAssetPager {
+	return s.workspace.NewDataAssetPager(s.resourceGroup, s.name)
+}
+
+// GetDataAssetVersions Return all the versions of the data asset with the name provided as argument
+func (s *ScopedWorkspace) GetDataAssetVersions(name string) ([]DataAsset, error) {
+	return s.workspace.GetDataAssetVersions(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetDataAssetVersionsWithContext(ctx context.Context, name string) ([]DataAsset, error) {
+	return s.workspace.GetDataAssetVersionsWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// NewDataAssetVersionPager Return a pager for iterating page by page over the versions of the data asset
+// with the name provided as argument.
+func (s *ScopedWorkspace) NewDataAssetVersionPager(name string) *DataAssetVersionPager {
+	return s.workspace.NewDataAssetVersionPager(s.resourceGroup, s.name, name)
+}
+
+// GetDataAsset Return the data asset with the name and version provided as argument
+func (s *ScopedWorkspace) GetDataAsset(name, version string) (*DataAsset, error) {
+	return s.workspace.GetDataAsset(s.resourceGroup, s.name, name, version)
+}
+
+func (s *ScopedWorkspace) GetDataAssetWithContext(ctx context.Context, name, version string) (*DataAsset, error) {
+	return s.workspace.GetDataAssetWithContext(ctx, s.resourceGroup, s.name, name, version)
+}
+
+// CreateOrUpdateDataAsset Create or update the data asset version with the data provided as argument. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateDataAsset(dataAsset *DataAsset) (*DataAsset, error) {
+	return s.workspace.CreateOrUpdateDataAsset(s.resourceGroup, s.name, dataAsset)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateDataAssetWithContext(ctx context.Context, dataAsset *DataAsset) (*DataAsset, error) {
+	return s.workspace.CreateOrUpdateDataAssetWithContext(ctx, s.resourceGroup, s.name, dataAsset)
+}
+
+// DeleteDataAsset Delete the data asset (all its versions) with the name provided as argument. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteDataAsset(name string) error {
+	return s.workspace.DeleteDataAsset(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) DeleteDataAssetWithContext(ctx context.Context, name string) error {
+	return s.workspace.DeleteDataAssetWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// DeleteDataAssetVersion Delete the version provided as argument of the data asset with the specified name. Block until
+// the operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteDataAssetVersion(name, version string) error {
+	return s.workspace.DeleteDataAssetVersion(s.resourceGroup, s.name, name, version)
+}
+
+func (s *ScopedWorkspace) DeleteDataAssetVersionWithContext(ctx context.Context, name, version string) error {
+	return s.workspace.DeleteDataAssetVersionWithContext(ctx, s.resourceGroup, s.name, name, version)
+}
+
+// GetModels Return the list of registered models of the workspace.
+func (s *ScopedWorkspace) GetModels() ([]Model, error) {
+	return s.workspace.GetModels(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetModelsWithContext(ctx context.Context) ([]Model, error) {
+	return s.workspace.GetModelsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewModelPager Return a pager for iterating page by page over the registered models of the workspace.
+func (s *ScopedWorkspace) NewModelPager() *ModelPager {
+	return s.workspace.NewModelPager(s.resourceGroup, s.name)
+}
+
+// GetModel Return the registered model with the name provided as argument
+func (s *ScopedWorkspace) GetModel(name string) (*Model, error) {
+	return s.workspace.GetModel(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetModelWithContext(ctx context.Context, name string) (*Model, error) {
+	return s.workspace.GetModelWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateModel Create or update the registered model with the data provided as argument. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateModel(model *Model) (*Model, error) {
+	return s.workspace.CreateOrUpdateModel(s.resourceGroup, s.name, model)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateModelWithContext(ctx context.Context, model *Model) (*Model, error) {
+	return s.workspace.CreateOrUpdateModelWithContext(ctx, s.resourceGroup, s.name, model)
+}
+
+// ArchiveModel Archive the registered model with the name provided as argument
+func (s *ScopedWorkspace) ArchiveModel(name string) (*Model, error) {
+	return s.workspace.ArchiveModel(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) ArchiveModelWithContext(ctx context.Context, name string) (*Model, error) {
+	return s.workspace.ArchiveModelWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// DeleteModel Delete the registered model (all its versions) with the name provided as argument. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteModel(name string) error {
+	return s.workspace.DeleteModel(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) DeleteModelWithContext(ctx context.Context, name string) error {
+	return s.workspace.DeleteModelWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetModelVersions Return all the versions of the model with the name provided as argument
+func (s *ScopedWorkspace) GetModelVersions(modelName string) ([]ModelVersion, error) {
+	return s.workspace.GetModelVersions(s.resourceGroup, s.name, modelName)
+}
+
+func (s *ScopedWorkspace) GetModelVersionsWithContext(ctx context.Context, modelName string) ([]ModelVersion, error) {
+	return s.workspace.GetModelVersionsWithContext(ctx, s.resourceGroup, s.name, modelName)
+}
+
+// NewModelVersionPager Return a pager for iterating page by page over the versions of the model
+// with the name provided as argument.
+func (s *ScopedWorkspace) NewModelVersionPager(modelName string) *ModelVersionPager {
+	return s.workspace.NewModelVersionPager(s.resourceGroup, s.name, modelName)
+}
+
+// GetModelVersion Return the model version with the name and version provided as argument
+func (s *ScopedWorkspace) GetModelVersion(modelName, version string) (*ModelVersion, error) {
+	return s.workspace.GetModelVersion(s.resourceGroup, s.name, modelName, version)
+}
+
+func (s *ScopedWorkspace) GetModelVersionWithContext(ctx context.Context, modelName, version string) (*ModelVersion, error) {
+	return s.workspace.GetModelVersionWithContext(ctx, s.resourceGroup, s.name, modelName, version)
+}
+
+// CreateOrUpdateModelVersion Create or update the model version with the data provided as argument. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateModelVersion(modelVersion *ModelVersion) (*ModelVersion, error) {
+	return s.workspace.CreateOrUpdateModelVersion(s.resourceGroup, s.name, modelVersion)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateModelVersionWithContext(ctx context.Context, modelVersion *ModelVersion) (*ModelVersion, error) {
+	return s.workspace.CreateOrUpdateModelVersionWithContext(ctx, s.resourceGroup, s.name, modelVersion)
+}
+
+// ArchiveModelVersion Archive the version provided as argument of the model with the specified name
+func (s *ScopedWorkspace) ArchiveModelVersion(modelName, version string) (*ModelVersion, error) {
+	return s.workspace.ArchiveModelVersion(s.resourceGroup, s.name, modelName, version)
+}
+
+func (s *ScopedWorkspace) ArchiveModelVersionWithContext(ctx context.Context, modelName, version string) (*ModelVersion, error) {
+	return s.workspace.ArchiveModelVersionWithContext(ctx, s.resourceGroup, s.name, modelName, version)
+}
+
+// DeleteModelVersion Delete the version provided as argument of the model with the specified name. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteModelVersion(modelName, version string) error {
+	return s.workspace.DeleteModelVersion(s.resourceGroup, s.name, modelName, version)
+}
+
+func (s *ScopedWorkspace) DeleteModelVersionWithContext(ctx context.Context, modelName, version string) error {
+	return s.workspace.DeleteModelVersionWithContext(ctx, s.resourceGroup, s.name, modelName, version)
+}
+
+// GetEnvironments Return the list of environments of the workspace.
+func (s *ScopedWorkspace) GetEnvironments() ([]Environment, error) {
+	return s.workspace.GetEnvironments(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetEnvironmentsWithContext(ctx context.Context) ([]Environment, error) {
+	return s.workspace.GetEnvironmentsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewEnvironmentPager Return a pager for iterating page by page over the environments of the workspace.
+func (s *ScopedWorkspace) NewEnvironmentPager() *EnvironmentPager {
+	return s.workspace.NewEnvironmentPager(s.resourceGroup, s.name)
+}
+
+// GetEnvironment Return the environment with the name provided as argument
+func (s *ScopedWorkspace) GetEnvironment(name string) (*Environment, error) {
+	return s.workspace.GetEnvironment(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetEnvironmentWithContext(ctx context.Context, name string) (*Environment, error) {
+	return s.workspace.GetEnvironmentWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateEnvironment Create or update the environment with the data provided as argument. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateEnvironment(environment *Environment) (*Environment, error) {
+	return s.workspace.CreateOrUpdateEnvironment(s.resourceGroup, s.name, environment)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateEnvironmentWithContext(ctx context.Context, environment *Environment) (*Environment, error) {
+	return s.workspace.CreateOrUpdateEnvironmentWithContext(ctx, s.resourceGroup, s.name, environment)
+}
+
+// ArchiveEnvironment Archive the environment with the name provided as argument
+func (s *ScopedWorkspace) ArchiveEnvironment(name string) (*Environment, error) {
+	return s.workspace.ArchiveEnvironment(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) ArchiveEnvironmentWithContext(ctx context.Context, name string) (*Environment, error) {
+	return s.workspace.ArchiveEnvironmentWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetEnvironmentVersions Return all the versions of the environment with the name provided as argument
+func (s *ScopedWorkspace) GetEnvironmentVersions(environmentName string) ([]EnvironmentVersion, error) {
+	return s.workspace.GetEnvironmentVersions(s.resourceGroup, s.name, environmentName)
+}
+
+func (s *ScopedWorkspace) GetEnvironmentVersionsWithContext(ctx context.Context, environmentName string) ([]EnvironmentVersion, error) {
+	return s.workspace.GetEnvironmentVersionsWithContext(ctx, s.resourceGroup, s.name, environmentName)
+}
+
+// NewEnvironmentVersionPager Return a pager for iterating page by page over the versions of the environment
+// with the name provided as argument.
+func (s *ScopedWorkspace) NewEnvironmentVersionPager(environmentName string) *EnvironmentVersionPager {
+	return s.workspace.NewEnvironmentVersionPager(s.resourceGroup, s.name, environmentName)
+}
+
+// GetEnvironmentVersion Return the environment version with the name and version provided as argument
+func (s *ScopedWorkspace) GetEnvironmentVersion(environmentName, version string) (*EnvironmentVersion, error) {
+	return s.workspace.GetEnvironmentVersion(s.resourceGroup, s.name, environmentName, version)
+}
+
+func (s *ScopedWorkspace) GetEnvironmentVersionWithContext(ctx context.Context, environmentName, version string) (*EnvironmentVersion, error) {
+	return s.workspace.GetEnvironmentVersionWithContext(ctx, s.resourceGroup, s.name, environmentName, version)
+}
+
+// CreateOrUpdateEnvironmentVersion Create or update the environment version with the data provided as argument. Block
+// until the operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateEnvironmentVersion(environmentVersion *EnvironmentVersion) (*EnvironmentVersion, error) {
+	return s.workspace.CreateOrUpdateEnvironmentVersion(s.resourceGroup, s.name, environmentVersion)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateEnvironmentVersionWithContext(ctx context.Context, environmentVersion *EnvironmentVersion) (*EnvironmentVersion, error) {
+	return s.workspace.CreateOrUpdateEnvironmentVersionWithContext(ctx, s.resourceGroup, s.name, environmentVersion)
+}
+
+// ArchiveEnvironmentVersion Archive the version provided as argument of the environment with the specified name
+func (s *ScopedWorkspace) ArchiveEnvironmentVersion(environmentName, version string) (*EnvironmentVersion, error) {
+	return s.workspace.ArchiveEnvironmentVersion(s.resourceGroup, s.name, environmentName, version)
+}
+
+func (s *ScopedWorkspace) ArchiveEnvironmentVersionWithContext(ctx context.Context, environmentName, version string) (*EnvironmentVersion, error) {
+	return s.workspace.ArchiveEnvironmentVersionWithContext(ctx, s.resourceGroup, s.name, environmentName, version)
+}
+
+// GetComputes Return the list of computes of the workspace.
+func (s *ScopedWorkspace) GetComputes() ([]Compute, error) {
+	return s.workspace.GetComputes(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetComputesWithContext(ctx context.Context) ([]Compute, error) {
+	return s.workspace.GetComputesWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewComputePager Return a pager for iterating page by page over the computes of the workspace.
+func (s *ScopedWorkspace) NewComputePager() *ComputePager {
+	return s.workspace.NewComputePager(s.resourceGroup, s.name)
+}
+
+// GetCompute Return the compute with the name provided as argument
+func (s *ScopedWorkspace) GetCompute(name string) (*Compute, error) {
+	return s.workspace.GetCompute(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetComputeWithContext(ctx context.Context, name string) (*Compute, error) {
+	return s.workspace.GetComputeWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateCompute Create or update the compute with the data provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateCompute(compute *Compute) (*Compute, error) {
+	return s.workspace.CreateOrUpdateCompute(s.resourceGroup, s.name, compute)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateComputeWithContext(ctx context.Context, compute *Compute) (*Compute, error) {
+	return s.workspace.CreateOrUpdateComputeWithContext(ctx, s.resourceGroup, s.name, compute)
+}
+
+// DeleteCompute Delete or detach the compute with the name provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteCompute(name string, action UnderlyingResourceAction) error {
+	return s.workspace.DeleteCompute(s.resourceGroup, s.name, name, action)
+}
+
+func (s *ScopedWorkspace) DeleteComputeWithContext(ctx context.Context, name string, action UnderlyingResourceAction) error {
+	return s.workspace.DeleteComputeWithContext(ctx, s.resourceGroup, s.name, name, action)
+}
+
+// StartComputeInstance Start the compute instance with the name provided as argument
+func (s *ScopedWorkspace) StartComputeInstance(name string) error {
+	return s.workspace.StartComputeInstance(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) StartComputeInstanceWithContext(ctx context.Context, name string) error {
+	return s.workspace.StartComputeInstanceWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// StopComputeInstance Stop the compute instance with the name provided as argument
+func (s *ScopedWorkspace) StopComputeInstance(name string) error {
+	return s.workspace.StopComputeInstance(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) StopComputeInstanceWithContext(ctx context.Context, name string) error {
+	return s.workspace.StopComputeInstanceWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// RestartComputeInstance Restart the compute instance with the name provided as argument
+func (s *ScopedWorkspace) RestartComputeInstance(name string) error {
+	return s.workspace.RestartComputeInstance(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) RestartComputeInstanceWithContext(ctx context.Context, name string) error {
+	return s.workspace.RestartComputeInstanceWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// ListComputeNodes Return the nodes of the AmlCompute cluster with the name provided as argument
+func (s *ScopedWorkspace) ListComputeNodes(name string) ([]ComputeNode, error) {
+	return s.workspace.ListComputeNodes(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) ListComputeNodesWithContext(ctx context.Context, name string) ([]ComputeNode, error) {
+	return s.workspace.ListComputeNodesWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetJobs Return the list of jobs of the workspace matching the filter provided as argument.
+func (s *ScopedWorkspace) GetJobs(filter JobFilter) ([]Job, error) {
+	return s.workspace.GetJobs(s.resourceGroup, s.name, filter)
+}
+
+func (s *ScopedWorkspace) GetJobsWithContext(ctx context.Context, filter JobFilter) ([]Job, error) {
+	return s.workspace.GetJobsWithContext(ctx, s.resourceGroup, s.name, filter)
+}
+
+// NewJobPager Return a pager for iterating page by page over the jobs of the workspace.
+func (s *ScopedWorkspace) NewJobPager(filter JobFilter) *JobPager {
+	return s.workspace.NewJobPager(s.resourceGroup, s.name, filter)
+}
+
+// GetJob Return the job with the name provided as argument
+func (s *ScopedWorkspace) GetJob(name string) (*Job, error) {
+	return s.workspace.GetJob(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetJobWithContext(ctx context.Context, name string) (*Job, error) {
+	return s.workspace.GetJobWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateJob Submit the job provided as argument, or update it if it already exists. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateJob(job *Job) (*Job, error) {
+	return s.workspace.CreateOrUpdateJob(s.resourceGroup, s.name, job)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateJobWithContext(ctx context.Context, job *Job) (*Job, error) {
+	return s.workspace.CreateOrUpdateJobWithContext(ctx, s.resourceGroup, s.name, job)
+}
+
+// CancelJob Request the cancellation of the job with the name provided as argument
+func (s *ScopedWorkspace) CancelJob(name string) error {
+	return s.workspace.CancelJob(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) CancelJobWithContext(ctx context.Context, name string) error {
+	return s.workspace.CancelJobWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// WaitForJob Wait until the job with the name provided as argument reaches a terminal status
+func (s *ScopedWorkspace) WaitForJob(name string, pollInterval time.Duration) (*Job, error) {
+	return s.workspace.WaitForJob(s.resourceGroup, s.name, name, pollInterval)
+}
+
+func (s *ScopedWorkspace) WaitForJobWithContext(ctx context.Context, name string, pollInterval time.Duration) (*Job, error) {
+	return s.workspace.WaitForJobWithContext(ctx, s.resourceGroup, s.name, name, pollInterval)
+}
+
+// WatchJob Poll the job with the name provided as argument until it is Completed, Failed or Canceled, then return
+// it. Every time the status of the job changes, onStatusChange is called with the new status, including the first
+// time the job is retrieved and the transitions to NotResponding or Paused. onStatusChange may be nil. If the job
+// stays NotResponding, Unknown or in a status not known by the SDK for longer than options.UnresponsiveTimeout, a
+// JobUnresponsiveError is returned.
+func (s *ScopedWorkspace) WatchJob(name string, options WatchJobOptions, onStatusChange func(change JobStatusChange)) (*Job, error) {
+	return s.workspace.WatchJob(s.resourceGroup, s.name, name, options, onStatusChange)
+}
+
+func (s *ScopedWorkspace) WatchJobWithContext(ctx context.Context, name string, options WatchJobOptions, onStatusChange func(change JobStatusChange)) (*Job, error) {
+	return s.workspace.WatchJobWithContext(ctx, s.resourceGroup, s.name, name, options, onStatusChange)
+}
+
+// GetComponents Return all the components of the workspace
+func (s *ScopedWorkspace) GetComponents() ([]Component, error) {
+	return s.workspace.GetComponents(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetComponentsWithContext(ctx context.Context) ([]Component, error) {
+	return s.workspace.GetComponentsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewComponentPager Return a pager for iterating page by page over the components of the workspace.
+func (s *ScopedWorkspace) NewComponentPager() *ComponentPager {
+	return s.workspace.NewComponentPager(s.resourceGroup, s.name)
+}
+
+// GetComponent Return the component with the name provided as argument
+func (s *ScopedWorkspace) GetComponent(name string) (*Component, error) {
+	return s.workspace.GetComponent(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetComponentWithContext(ctx context.Context, name string) (*Component, error) {
+	return s.workspace.GetComponentWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateComponent Create or update the component with the data provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateComponent(component *Component) (*Component, error) {
+	return s.workspace.CreateOrUpdateComponent(s.resourceGroup, s.name, component)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateComponentWithContext(ctx context.Context, component *Component) (*Component, error) {
+	return s.workspace.CreateOrUpdateComponentWithContext(ctx, s.resourceGroup, s.name, component)
+}
+
+// ArchiveComponent Archive the component with the name provided as argument
+func (s *ScopedWorkspace) ArchiveComponent(name string) (*Component, error) {
+	return s.workspace.ArchiveComponent(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) ArchiveComponentWithContext(ctx context.Context, name string) (*Component, error) {
+	return s.workspace.ArchiveComponentWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// DeleteComponent Delete the component (all its versions) with the name provided as argument. Block until the operation
+// completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteComponent(name string) error {
+	return s.workspace.DeleteComponent(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) DeleteComponentWithContext(ctx context.Context, name string) error {
+	return s.workspace.DeleteComponentWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetComponentVersions Return all the versions of the component with the name provided as argument
+func (s *ScopedWorkspace) GetComponentVersions(componentName string) ([]ComponentVersion, error) {
+	return s.workspace.GetComponentVersions(s.resourceGroup, s.name, componentName)
+}
+
+func (s *ScopedWorkspace) GetComponentVersionsWithContext(ctx context.Context, componentName string) ([]ComponentVersion, error) {
+	return s.workspace.GetComponentVersionsWithContext(ctx, s.resourceGroup, s.name, componentName)
+}
+
+// NewComponentVersionPager Return a pager for iterating page by page over the versions of the component
+// with the name provided as argument.
+func (s *ScopedWorkspace) NewComponentVersionPager(componentName string) *ComponentVersionPager {
+	return s.workspace.NewComponentVersionPager(s.resourceGroup, s.name, componentName)
+}
+
+// GetComponentVersion Return the component version with the name and version provided as argument
+func (s *ScopedWorkspace) GetComponentVersion(componentName, version string) (*ComponentVersion, error) {
+	return s.workspace.GetComponentVersion(s.resourceGroup, s.name, componentName, version)
+}
+
+func (s *ScopedWorkspace) GetComponentVersionWithContext(ctx context.Context, componentName, version string) (*ComponentVersion, error) {
+	return s.workspace.GetComponentVersionWithContext(ctx, s.resourceGroup, s.name, componentName, version)
+}
+
+// CreateOrUpdateComponentVersion Create or update the component version with the data provided as argument, checking
+// that every placeholder of the command references a declared input or output. Block until the operation completes,
+// checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) CreateOrUpdateComponentVersion(componentVersion *ComponentVersion) (*ComponentVersion, error) {
+	return s.workspace.CreateOrUpdateComponentVersion(s.resourceGroup, s.name, componentVersion)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateComponentVersionWithContext(ctx context.Context, componentVersion *ComponentVersion) (*ComponentVersion, error) {
+	return s.workspace.CreateOrUpdateComponentVersionWithContext(ctx, s.resourceGroup, s.name, componentVersion)
+}
+
+// ArchiveComponentVersion Archive the version provided as argument of the component with the specified name
+func (s *ScopedWorkspace) ArchiveComponentVersion(componentName, version string) (*ComponentVersion, error) {
+	return s.workspace.ArchiveComponentVersion(s.resourceGroup, s.name, componentName, version)
+}
+
+func (s *ScopedWorkspace) ArchiveComponentVersionWithContext(ctx context.Context, componentName, version string) (*ComponentVersion, error) {
+	return s.workspace.ArchiveComponentVersionWithContext(ctx, s.resourceGroup, s.name, componentName, version)
+}
+
+// DeleteComponentVersion Delete the version provided as argument of the component with the specified name. Block until
+// the operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) DeleteComponentVersion(componentName, version string) error {
+	return s.workspace.DeleteComponentVersion(s.resourceGroup, s.name, componentName, version)
+}
+
+func (s *ScopedWorkspace) DeleteComponentVersionWithContext(ctx context.Context, componentName, version string) error {
+	return s.workspace.DeleteComponentVersionWithContext(ctx, s.resourceGroup, s.name, componentName, version)
+}
+
+// GetOnlineEndpoints Return all the online endpoints of the workspace
+func (s *ScopedWorkspace) GetOnlineEndpoints() ([]OnlineEndpoint, error) {
+	return s.workspace.GetOnlineEndpoints(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetOnlineEndpointsWithContext(ctx context.Context) ([]OnlineEndpoint, error) {
+	return s.workspace.GetOnlineEndpointsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewOnlineEndpointPager Return a pager for iterating page by page over the online endpoints of the workspace.
+func (s *ScopedWorkspace) NewOnlineEndpointPager() *OnlineEndpointPager {
+	return s.workspace.NewOnlineEndpointPager(s.resourceGroup, s.name)
+}
+
+// GetOnlineEndpoint Return the online endpoint with the name provided as argument
+func (s *ScopedWorkspace) GetOnlineEndpoint(name string) (*OnlineEndpoint, error) {
+	return s.workspace.GetOnlineEndpoint(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetOnlineEndpointWithContext(ctx context.Context, name string) (*OnlineEndpoint, error) {
+	return s.workspace.GetOnlineEndpointWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateOnlineEndpoint Start the creation or the update of the online endpoint provided as argument
+func (s *ScopedWorkspace) CreateOrUpdateOnlineEndpoint(endpoint *OnlineEndpoint) (*OnlineEndpointPoller, error) {
+	return s.workspace.CreateOrUpdateOnlineEndpoint(s.resourceGroup, s.name, endpoint)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateOnlineEndpointWithContext(ctx context.Context, endpoint *OnlineEndpoint) (*OnlineEndpointPoller, error) {
+	return s.workspace.CreateOrUpdateOnlineEndpointWithContext(ctx, s.resourceGroup, s.name, endpoint)
+}
+
+// UpdateOnlineEndpointTraffic Start updating the traffic that the online endpoint routes to each deployment
+func (s *ScopedWorkspace) UpdateOnlineEndpointTraffic(name string, traffic map[string]int) (*OnlineEndpointPoller, error) {
+	return s.workspace.UpdateOnlineEndpointTraffic(s.resourceGroup, s.name, name, traffic)
+}
+
+func (s *ScopedWorkspace) UpdateOnlineEndpointTrafficWithContext(ctx context.Context, name string, traffic map[string]int) (*OnlineEndpointPoller, error) {
+	return s.workspace.UpdateOnlineEndpointTrafficWithContext(ctx, s.resourceGroup, s.name, name, traffic)
+}
+
+// DeleteOnlineEndpoint Start the deletion of the online endpoint with the name provided as argument
+func (s *ScopedWorkspace) DeleteOnlineEndpoint(name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteOnlineEndpoint(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) DeleteOnlineEndpointWithContext(ctx context.Context, name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteOnlineEndpointWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetOnlineDeployments Return all the deployments of the online endpoint with the name provided as argument
+func (s *ScopedWorkspace) GetOnlineDeployments(endpointName string) ([]OnlineDeployment, error) {
+	return s.workspace.GetOnlineDeployments(s.resourceGroup, s.name, endpointName)
+}
+
+func (s *ScopedWorkspace) GetOnlineDeploymentsWithContext(ctx context.Context, endpointName string) ([]OnlineDeployment, error) {
+	return s.workspace.GetOnlineDeploymentsWithContext(ctx, s.resourceGroup, s.name, endpointName)
+}
+
+// NewOnlineDeploymentPager Return a pager for iterating page by page over the deployments of the online endpoint
+// with the name provided as argument.
+func (s *ScopedWorkspace) NewOnlineDeploymentPager(endpointName string) *OnlineDeploymentPager {
+	return s.workspace.NewOnlineDeploymentPager(s.resourceGroup, s.name, endpointName)
+}
+
+// GetOnlineDeployment Return the deployment with the name provided as argument of the specified online endpoint
+func (s *ScopedWorkspace) GetOnlineDeployment(endpointName, name string) (*OnlineDeployment, error) {
+	return s.workspace.GetOnlineDeployment(s.resourceGroup, s.name, endpointName, name)
+}
+
+func (s *ScopedWorkspace) GetOnlineDeploymentWithContext(ctx context.Context, endpointName, name string) (*OnlineDeployment, error) {
+	return s.workspace.GetOnlineDeploymentWithContext(ctx, s.resourceGroup, s.name, endpointName, name)
+}
+
+// CreateOrUpdateOnlineDeployment Start the creation or the update of the online deployment provided as argument
+func (s *ScopedWorkspace) CreateOrUpdateOnlineDeployment(deployment *OnlineDeployment) (*OnlineDeploymentPoller, error) {
+	return s.workspace.CreateOrUpdateOnlineDeployment(s.resourceGroup, s.name, deployment)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateOnlineDeploymentWithContext(ctx context.Context, deployment *OnlineDeployment) (*OnlineDeploymentPoller, error) {
+	return s.workspace.CreateOrUpdateOnlineDeploymentWithContext(ctx, s.resourceGroup, s.name, deployment)
+}
+
+// DeleteOnlineDeployment Start the deletion of the deployment with the name provided as argument of the specified
+// online endpoint
+func (s *ScopedWorkspace) DeleteOnlineDeployment(endpointName, name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteOnlineDeployment(s.resourceGroup, s.name, endpointName, name)
+}
+
+func (s *ScopedWorkspace) DeleteOnlineDeploymentWithContext(ctx context.Context, endpointName, name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteOnlineDeploymentWithContext(ctx, s.resourceGroup, s.name, endpointName, name)
+}
+
+// GetOnlineEndpointKeys Return the keys of the online endpoint with the name provided as argument, which must use the
+// Key auth mode
+func (s *ScopedWorkspace) GetOnlineEndpointKeys(name string) (*EndpointKeys, error) {
+	return s.workspace.GetOnlineEndpointKeys(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetOnlineEndpointKeysWithContext(ctx context.Context, name string) (*EndpointKeys, error) {
+	return s.workspace.GetOnlineEndpointKeysWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetOnlineEndpointToken Return a new token for the online endpoint with the name provided as argument, which must use
+// the AMLToken auth mode
+func (s *ScopedWorkspace) GetOnlineEndpointToken(name string) (*EndpointToken, error) {
+	return s.workspace.GetOnlineEndpointToken(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetOnlineEndpointTokenWithContext(ctx context.Context, name string) (*EndpointToken, error) {
+	return s.workspace.GetOnlineEndpointTokenWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// NewScoringClient Return a client for sending scoring requests to the online endpoint with the name provided as
+// argument
+func (s *ScopedWorkspace) NewScoringClient(endpointName string) (*ScoringClient, error) {
+	return s.workspace.NewScoringClient(s.resourceGroup, s.name, endpointName)
+}
+
+func (s *ScopedWorkspace) NewScoringClientWithContext(ctx context.Context, endpointName string) (*ScoringClient, error) {
+	return s.workspace.NewScoringClientWithContext(ctx, s.resourceGroup, s.name, endpointName)
+}
+
+// GetBatchEndpoints Return all the batch endpoints of the workspace
+func (s *ScopedWorkspace) GetBatchEndpoints() ([]BatchEndpoint, error) {
+	return s.workspace.GetBatchEndpoints(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetBatchEndpointsWithContext(ctx context.Context) ([]BatchEndpoint, error) {
+	return s.workspace.GetBatchEndpointsWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// NewBatchEndpointPager Return a pager for iterating page by page over the batch endpoints of the workspace.
+func (s *ScopedWorkspace) NewBatchEndpointPager() *BatchEndpointPager {
+	return s.workspace.NewBatchEndpointPager(s.resourceGroup, s.name)
+}
+
+// GetBatchEndpoint Return the batch endpoint with the name provided as argument
+func (s *ScopedWorkspace) GetBatchEndpoint(name string) (*BatchEndpoint, error) {
+	return s.workspace.GetBatchEndpoint(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) GetBatchEndpointWithContext(ctx context.Context, name string) (*BatchEndpoint, error) {
+	return s.workspace.GetBatchEndpointWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// CreateOrUpdateBatchEndpoint Start the creation or the update of the batch endpoint provided as argument
+func (s *ScopedWorkspace) CreateOrUpdateBatchEndpoint(endpoint *BatchEndpoint) (*BatchEndpointPoller, error) {
+	return s.workspace.CreateOrUpdateBatchEndpoint(s.resourceGroup, s.name, endpoint)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateBatchEndpointWithContext(ctx context.Context, endpoint *BatchEndpoint) (*BatchEndpointPoller, error) {
+	return s.workspace.CreateOrUpdateBatchEndpointWithContext(ctx, s.resourceGroup, s.name, endpoint)
+}
+
+// DeleteBatchEndpoint Start the deletion of the batch endpoint with the name provided as argument
+func (s *ScopedWorkspace) DeleteBatchEndpoint(name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteBatchEndpoint(s.resourceGroup, s.name, name)
+}
+
+func (s *ScopedWorkspace) DeleteBatchEndpointWithContext(ctx context.Context, name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteBatchEndpointWithContext(ctx, s.resourceGroup, s.name, name)
+}
+
+// GetBatchDeployments Return all the deployments of the batch endpoint with the name provided as argument
+func (s *ScopedWorkspace) GetBatchDeployments(endpointName string) ([]BatchDeployment, error) {
+	return s.workspace.GetBatchDeployments(s.resourceGroup, s.name, endpointName)
+}
+
+func (s *ScopedWorkspace) GetBatchDeploymentsWithContext(ctx context.Context, endpointName string) ([]BatchDeployment, error) {
+	return s.workspace.GetBatchDeploymentsWithContext(ctx, s.resourceGroup, s.name, endpointName)
+}
+
+// NewBatchDeploymentPager Return a pager for iterating page by page over the deployments of a batch endpoint.
+func (s *ScopedWorkspace) NewBatchDeploymentPager(endpointName string) *BatchDeploymentPager {
+	return s.workspace.NewBatchDeploymentPager(s.resourceGroup, s.name, endpointName)
+}
+
+// GetBatchDeployment Return the deployment with the name provided as argument of the specified batch endpoint
+func (s *ScopedWorkspace) GetBatchDeployment(endpointName, name string) (*BatchDeployment, error) {
+	return s.workspace.GetBatchDeployment(s.resourceGroup, s.name, endpointName, name)
+}
+
+func (s *ScopedWorkspace) GetBatchDeploymentWithContext(ctx context.Context, endpointName, name string) (*BatchDeployment, error) {
+	return s.workspace.GetBatchDeploymentWithContext(ctx, s.resourceGroup, s.name, endpointName, name)
+}
+
+// CreateOrUpdateBatchDeployment Start the creation or the update of the batch deployment provided as argument
+func (s *ScopedWorkspace) CreateOrUpdateBatchDeployment(deployment *BatchDeployment) (*BatchDeploymentPoller, error) {
+	return s.workspace.CreateOrUpdateBatchDeployment(s.resourceGroup, s.name, deployment)
+}
+
+func (s *ScopedWorkspace) CreateOrUpdateBatchDeploymentWithContext(ctx context.Context, deployment *BatchDeployment) (*BatchDeploymentPoller, error) {
+	return s.workspace.CreateOrUpdateBatchDeploymentWithContext(ctx, s.resourceGroup, s.name, deployment)
+}
+
+// DeleteBatchDeployment Start the deletion of the deployment with the name provided as argument of the specified batch
+// endpoint
+func (s *ScopedWorkspace) DeleteBatchDeployment(endpointName, name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteBatchDeployment(s.resourceGroup, s.name, endpointName, name)
+}
+
+func (s *ScopedWorkspace) DeleteBatchDeploymentWithContext(ctx context.Context, endpointName, name string) (*DeletionPoller, error) {
+	return s.workspace.DeleteBatchDeploymentWithContext(ctx, s.resourceGroup, s.name, endpointName, name)
+}
+
+// InvokeBatchEndpoint Start a job scoring the data of the input provided as argument with the specified batch endpoint
+func (s *ScopedWorkspace) InvokeBatchEndpoint(endpointName string, input JobInput, options *BatchInvocationOptions) (*Job, error) {
+	return s.workspace.InvokeBatchEndpoint(s.resourceGroup, s.name, endpointName, input, options)
+}
+
+func (s *ScopedWorkspace) InvokeBatchEndpointWithContext(ctx context.Context, endpointName string, input JobInput, options *BatchInvocationOptions) (*Job, error) {
+	return s.workspace.InvokeBatchEndpointWithContext(ctx, s.resourceGroup, s.name, endpointName, input, options)
+}
+
+// BeginCreateOrUpdateCompute Start the creation or the update of the compute provided as argument, returning a poller
+// for waiting until the compute is provisioned
+func (s *ScopedWorkspace) BeginCreateOrUpdateCompute(compute *Compute) (*ComputePoller, error) {
+	return s.workspace.BeginCreateOrUpdateCompute(s.resourceGroup, s.name, compute)
+}
+
+func (s *ScopedWorkspace) BeginCreateOrUpdateComputeWithContext(ctx context.Context, compute *Compute) (*ComputePoller, error) {
+	return s.workspace.BeginCreateOrUpdateComputeWithContext(ctx, s.resourceGroup, s.name, compute)
+}
+
+// BeginDeleteCompute Start the deletion of the compute with the name provided as argument, returning a poller for
+// waiting until the compute is deleted
+func (s *ScopedWorkspace) BeginDeleteCompute(name string, action UnderlyingResourceAction) (*DeletionPoller, error) {
+	return s.workspace.BeginDeleteCompute(s.resourceGroup, s.name, name, action)
+}
+
+func (s *ScopedWorkspace) BeginDeleteComputeWithContext(ctx context.Context, name string, action UnderlyingResourceAction) (*DeletionPoller, error) {
+	return s.workspace.BeginDeleteComputeWithContext(ctx, s.resourceGroup, s.name, name, action)
+}
+
+// GetAmlWorkspace Return the workspace
+func (s *ScopedWorkspace) GetAmlWorkspace() (*AmlWorkspace, error) {
+	return s.workspace.GetAmlWorkspace(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetAmlWorkspaceWithContext(ctx context.Context) (*AmlWorkspace, error) {
+	return s.workspace.GetAmlWorkspaceWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// DeleteAmlWorkspace Start the deletion of the workspace, purging it if forceToPurge is true
+func (s *ScopedWorkspace) DeleteAmlWorkspace(forceToPurge bool) (*DeletionPoller, error) {
+	return s.workspace.DeleteAmlWorkspace(s.resourceGroup, s.name, forceToPurge)
+}
+
+func (s *ScopedWorkspace) DeleteAmlWorkspaceWithContext(ctx context.Context, forceToPurge bool) (*DeletionPoller, error) {
+	return s.workspace.DeleteAmlWorkspaceWithContext(ctx, s.resourceGroup, s.name, forceToPurge)
+}
+
+// GetAmlWorkspaceKeys Return the keys of the resources associated with the workspace
+func (s *ScopedWorkspace) GetAmlWorkspaceKeys() (*AmlWorkspaceKeys, error) {
+	return s.workspace.GetAmlWorkspaceKeys(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) GetAmlWorkspaceKeysWithContext(ctx context.Context) (*AmlWorkspaceKeys, error) {
+	return s.workspace.GetAmlWorkspaceKeysWithContext(ctx, s.resourceGroup, s.name)
+}
+
+// ResyncAmlWorkspaceKeys Resynchronize the keys of the resources associated with the workspace. Block until the
+// operation completes, checking it every DefaultOperationPollInterval if the APIs run it asynchronously.
+func (s *ScopedWorkspace) ResyncAmlWorkspaceKeys() error {
+	return s.workspace.ResyncAmlWorkspaceKeys(s.resourceGroup, s.name)
+}
+
+func (s *ScopedWorkspace) ResyncAmlWorkspaceKeysWithContext(ctx context.Context) error {
+	return s.workspace.ResyncAmlWorkspaceKeysWithContext(ctx, s.resourceGroup, s.name)
+}
@@ -0,0 +1,90 @@
+package workspace
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+// recordingClientBuilder Return the mocked client, recording the scopes of the clients it builds
+type recordingClientBuilder struct {
+	httpClient HttpClientAPI
+	scopes     []string
+}
+
+func (b *recordingClientBuilder) newClient(resourceGroupName, workspaceName string) HttpClientAPI {
+	b.scopes = append(b.scopes, resourceGroupName+"/"+workspaceName)
+	return b.httpClient
+}
+
+func (b *recordingClientBuilder) newWorkspaceCollectionClient(resourceGroupName string) HttpClientAPI {
+	b.scopes = append(b.scopes, resourceGroupName)
+	return b.httpClient
+}
+
+func TestWorkspace_Scope(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test scope validates the workspace",
+			testCase: func() {
+				ws := newWorkspace(MockedHttpClientBuilder{new(MockedHttpClient)}, l)
+				for _, invalid := range [][2]string{{"", "ws"}, {"rg", " "}, {"rg", "ws/datastores"}, {"rg?", "ws"}} {
+					scoped, err := ws.Scope(invalid[0], invalid[1])
+					a.Nil(scoped)
+					a.IsType(InvalidArgumentError{}, err, invalid)
+				}
+
+				scoped, err := ws.Scope("rg", "ws")
+				a.Nil(err)
+				a.Equal("rg", scoped.ResourceGroup())
+				a.Equal("ws", scoped.Name())
+			},
+		},
+		{
+			testCaseName: "Test scoped workspace reuses the same client",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores/datastore").Return(http.StatusOK, string(loadExampleResp("example_resp_get_datastore.json")), nil)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, string(loadExampleResp("example_resp_get_online_endpoint.json")), nil)
+				mockedHttpClient.On("doGet", "ws?api-version="+ApiVersion20231001).Return(http.StatusOK, string(loadExampleResp("example_resp_get_aml_workspace.json")), nil)
+				builder := &recordingClientBuilder{httpClient: mockedHttpClient}
+
+				ws := newWorkspace(builder, l)
+				scoped, err := ws.Scope("rg", "ws")
+				a.Nil(err)
+				_, err = scoped.GetDatastore("datastore")
+				a.Nil(err)
+				_, err = scoped.GetDatastore("datastore")
+				a.Nil(err)
+				endpoint, err := scoped.GetOnlineEndpoint("endpoint")
+				a.Nil(err)
+				a.Equal("endpoint", endpoint.Name)
+				a.Equal([]string{"rg/ws"}, builder.scopes)
+
+				workspace, err := scoped.GetAmlWorkspace()
+				a.Nil(err)
+				a.Equal("ws", workspace.Name)
+				a.Equal([]string{"rg/ws", "rg"}, builder.scopes)
+
+				// The original client is not affected by the scope
+				_, err = ws.GetDatastore("rg", "ws", "datastore")
+				a.Nil(err)
+				a.Len(builder.scopes, 3)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
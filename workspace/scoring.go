@@ -0,0 +1,241 @@
+package workspace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// deploymentHeader The header of the scoring requests selecting the deployment that scores them
+const deploymentHeader = "azureml-model-deployment"
+
+// endpointTokenRefreshMargin How long before its expiration an endpoint token is refreshed, if the APIs do not
+// specify when to refresh it
+const endpointTokenRefreshMargin = 5 * time.Minute
+
+// EndpointKeys The keys for authenticating with an online endpoint using the Key auth mode
+type EndpointKeys struct {
+	PrimaryKey   string
+	SecondaryKey string
+}
+
+// EndpointToken A token for authenticating with an online endpoint using the AMLToken auth mode
+type EndpointToken struct {
+	AccessToken  string
+	ExpiresOn    time.Time
+	RefreshAfter time.Time
+}
+
+// GetOnlineEndpointKeys Return the keys of the online endpoint with the name provided as argument, which must
+// use the Key auth mode
+func (w *Workspace) GetOnlineEndpointKeys(resourceGroup, workspace, name string) (*EndpointKeys, error) {
+	return w.GetOnlineEndpointKeysWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetOnlineEndpointKeysWithContext(ctx context.Context, resourceGroup, workspace, name string) (*EndpointKeys, error) {
+	path := fmt.Sprintf("onlineEndpoints/%s/listKeys", name)
+	body, err := w.postResource(ctx, resourceGroup, workspace, path, nil, &ResourceNotFoundError{"online endpoint", name})
+	if err != nil {
+		return nil, err
+	}
+	return &EndpointKeys{
+		PrimaryKey:   gjson.GetBytes(body, "primaryKey").Str,
+		SecondaryKey: gjson.GetBytes(body, "secondaryKey").Str,
+	}, nil
+}
+
+// GetOnlineEndpointToken Return a new token for the online endpoint with the name provided as argument, which must
+// use the AMLToken auth mode
+func (w *Workspace) GetOnlineEndpointToken(resourceGroup, workspace, name string) (*EndpointToken, error) {
+	return w.GetOnlineEndpointTokenWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetOnlineEndpointTokenWithContext(ctx context.Context, resourceGroup, workspace, name string) (*EndpointToken, error) {
+	path := fmt.Sprintf("onlineEndpoints/%s/token", name)
+	body, err := w.postResource(ctx, resourceGroup, workspace, path, nil, &ResourceNotFoundError{"online endpoint", name})
+	if err != nil {
+		return nil, err
+	}
+	token := &EndpointToken{
+		AccessToken: gjson.GetBytes(body, "accessToken").Str,
+		ExpiresOn:   time.Unix(gjson.GetBytes(body, "expiryTimeUtc").Int(), 0),
+	}
+	if refreshAfter := gjson.GetBytes(body, "refreshAfterTimeUtc").Int(); refreshAfter > 0 {
+		token.RefreshAfter = time.Unix(refreshAfter, 0)
+	} else {
+		token.RefreshAfter = token.ExpiresOn.Add(-endpointTokenRefreshMargin)
+	}
+	return token, nil
+}
+
+// NewScoringClient Return a client for sending scoring requests to the online endpoint with the name provided as
+// argument. The scoring URI and the credentials of the endpoint are resolved according to its auth mode: endpoints
+// using the Key auth mode are called with their primary key, the tokens of the endpoints using the AMLToken
+// auth mode are refreshed when needed, and the endpoints using the AADToken auth mode are called with the tokens
+// of the credential of the Workspace.
+func (w *Workspace) NewScoringClient(resourceGroup, workspace, endpointName string) (*ScoringClient, error) {
+	return w.NewScoringClientWithContext(context.Background(), resourceGroup, workspace, endpointName)
+}
+
+func (w *Workspace) NewScoringClientWithContext(ctx context.Context, resourceGroup, workspace, endpointName string) (*ScoringClient, error) {
+	endpoint, err := w.GetOnlineEndpointWithContext(ctx, resourceGroup, workspace, endpointName)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.ScoringUri == "" {
+		return nil, fmt.Errorf("online endpoint %s has no scoring URI, provisioning state is %q", endpointName, endpoint.ProvisioningState)
+	}
+
+	var authorizer scoringAuthorizer
+	switch endpoint.AuthMode {
+	case EndpointAuthModeKey:
+		keys, err := w.GetOnlineEndpointKeysWithContext(ctx, resourceGroup, workspace, endpointName)
+		if err != nil {
+			return nil, err
+		}
+		authorizer = func(context.Context) (string, error) {
+			return keys.PrimaryKey, nil
+		}
+	case EndpointAuthModeAMLToken:
+		authorizer = newEndpointTokenAuthorizer(func(ctx context.Context) (*EndpointToken, error) {
+			return w.GetOnlineEndpointTokenWithContext(ctx, resourceGroup, workspace, endpointName)
+		})
+	case EndpointAuthModeAADToken:
+		if authorizer, err = w.newAadTokenAuthorizer(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, InvalidArgumentError{fmt.Sprintf("auth mode %q of online endpoint %s is not supported by the scoring client", endpoint.AuthMode, endpointName)}
+	}
+	return newScoringClient(endpoint.ScoringUri, w.scoringHttpClient, w.scoringRetrier, w.logger, authorizer), nil
+}
+
+// scoringAuthorizer Return the credential sent as bearer token with the scoring requests
+type scoringAuthorizer func(ctx context.Context) (string, error)
+
+// newEndpointTokenAuthorizer Return an authorizer retrieving the endpoint tokens with the function provided as
+// argument, caching each token until it has to be refreshed
+func newEndpointTokenAuthorizer(getToken func(ctx context.Context) (*EndpointToken, error)) scoringAuthorizer {
+	var mutex sync.Mutex
+	var token *EndpointToken
+	return func(ctx context.Context) (string, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if token == nil || !time.Now().Before(token.RefreshAfter) {
+			newToken, err := getToken(ctx)
+			if err != nil {
+				return "", err
+			}
+			token = newToken
+		}
+		return token.AccessToken, nil
+	}
+}
+
+// newAadTokenAuthorizer Return an authorizer using the tokens of the credential of the Workspace, valid for the
+// data plane of the workspaces
+func (w *Workspace) newAadTokenAuthorizer() (scoringAuthorizer, error) {
+	if w.credential == nil {
+		return nil, errors.New("the workspace has no credential for authenticating with the AADToken auth mode")
+	}
+	scopes := []string{w.machineLearningScope}
+	return func(ctx context.Context) (string, error) {
+		token, err := w.credential.GetToken(ctx, scopes)
+		if err != nil {
+			return "", err
+		}
+		return token.Token, nil
+	}, nil
+}
+
+// ScoringOptions The options of a scoring request
+type ScoringOptions struct {
+	// Deployment The name of the deployment scoring the request, regardless of the traffic of the endpoint.
+	// If empty, the endpoint routes the request according to its traffic.
+	Deployment string
+
+	// Headers Additional headers sent with the request
+	Headers map[string]string
+}
+
+// ScoringClient Send scoring requests to an online endpoint. The requests are retried according to the retry
+// policy of the Workspace which created the client.
+type ScoringClient struct {
+	scoringUri string
+	httpClient *http.Client
+	retrier    *retrier
+	logger     *zap.SugaredLogger
+	authorizer scoringAuthorizer
+}
+
+func newScoringClient(scoringUri string, httpClient *http.Client, retrier *retrier, logger *zap.SugaredLogger, authorizer scoringAuthorizer) *ScoringClient {
+	return &ScoringClient{
+		scoringUri: scoringUri,
+		httpClient: httpClient,
+		retrier:    retrier,
+		logger:     logger,
+		authorizer: authorizer,
+	}
+}
+
+// ScoringUri Return the URI to which the scoring requests are sent
+func (c *ScoringClient) ScoringUri() string {
+	return c.scoringUri
+}
+
+// Score Send the request provided as argument, encoded as JSON, and return the body of the response
+func (c *ScoringClient) Score(ctx context.Context, request interface{}, options *ScoringOptions) ([]byte, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return c.ScoreBinary(ctx, "application/json", payload, options)
+}
+
+// ScoreBinary Send the payload provided as argument with the specified content type, e.g. image/png, and return
+// the body of the response
+func (c *ScoringClient) ScoreBinary(ctx context.Context, contentType string, payload []byte, options *ScoringOptions) ([]byte, error) {
+	credential, err := c.authorizer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.scoringUri, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", credential))
+	request.Header.Set("Content-Type", contentType)
+	if options != nil {
+		for name, value := range options.Headers {
+			request.Header.Set(name, value)
+		}
+		if options.Deployment != "" {
+			request.Header.Set(deploymentHeader, options.Deployment)
+		}
+	}
+
+	c.logger.Infof("POST > %s", request.URL)
+	resp, err := c.retrier.do(c.httpClient, request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newHttpResponseError(resp, body)
+	}
+	return body, nil
+}
@@ -0,0 +1,269 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// scoringRequest A request received by the test scoring server
+type scoringRequest struct {
+	authorization string
+	contentType   string
+	deployment    string
+	body          string
+}
+
+// newScoringServer Return a test server recording the scoring requests and replying with the status codes
+// provided as argument, in order. Once the status codes are over, the server replies with 200 OK.
+func newScoringServer(requests *[]scoringRequest, statusCodes ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		*requests = append(*requests, scoringRequest{
+			authorization: r.Header.Get("Authorization"),
+			contentType:   r.Header.Get("Content-Type"),
+			deployment:    r.Header.Get(deploymentHeader),
+			body:          string(body),
+		})
+		if len(*requests) <= len(statusCodes) {
+			w.WriteHeader(statusCodes[len(*requests)-1])
+			_, _ = w.Write([]byte("{\"error\": {\"code\": \"BadRequest\", \"message\": \"invalid input\"}}"))
+			return
+		}
+		_, _ = w.Write([]byte("[0.93]"))
+	}))
+}
+
+func TestScoringClient_Score(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	authorizer := func(context.Context) (string, error) { return "key", nil }
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test score JSON request",
+			testCase: func() {
+				var requests []scoringRequest
+				server := newScoringServer(&requests)
+				defer server.Close()
+
+				client := newScoringClient(server.URL+"/score", server.Client(), newTestRetrier(DefaultRetryPolicy(), new([]time.Duration)), logger, authorizer)
+				resp, err := client.Score(context.Background(), map[string]interface{}{"data": []int{1, 2}}, nil)
+				a.Nil(err)
+				a.Equal("[0.93]", string(resp))
+				a.Equal([]scoringRequest{{authorization: "Bearer key", contentType: "application/json", body: "{\"data\":[1,2]}"}}, requests)
+			},
+		},
+		{
+			testCaseName: "Test score binary request targeting a deployment",
+			testCase: func() {
+				var requests []scoringRequest
+				server := newScoringServer(&requests)
+				defer server.Close()
+
+				client := newScoringClient(server.URL+"/score", server.Client(), newTestRetrier(DefaultRetryPolicy(), new([]time.Duration)), logger, authorizer)
+				_, err := client.ScoreBinary(context.Background(), "image/png", []byte{0x89, 0x50}, &ScoringOptions{Deployment: "blue"})
+				a.Nil(err)
+				a.Len(requests, 1)
+				a.Equal("image/png", requests[0].contentType)
+				a.Equal("blue", requests[0].deployment)
+				a.Equal(string([]byte{0x89, 0x50}), requests[0].body)
+			},
+		},
+		{
+			testCaseName: "Test score retried when throttled",
+			testCase: func() {
+				var requests []scoringRequest
+				var delays []time.Duration
+				server := newScoringServer(&requests, http.StatusTooManyRequests)
+				defer server.Close()
+
+				client := newScoringClient(server.URL+"/score", server.Client(), newTestRetrier(DefaultRetryPolicy(), &delays), logger, authorizer)
+				resp, err := client.Score(context.Background(), []int{1}, nil)
+				a.Nil(err)
+				a.Equal("[0.93]", string(resp))
+				a.Len(requests, 2)
+				a.Equal(requests[0].body, requests[1].body)
+				a.Len(delays, 1)
+			},
+		},
+		{
+			testCaseName: "Test score error",
+			testCase: func() {
+				var requests []scoringRequest
+				server := newScoringServer(&requests, http.StatusBadRequest)
+				defer server.Close()
+
+				client := newScoringClient(server.URL+"/score", server.Client(), newTestRetrier(DefaultRetryPolicy(), new([]time.Duration)), logger, authorizer)
+				resp, err := client.Score(context.Background(), []int{1}, nil)
+				a.Nil(resp)
+				var respErr *HttpResponseError
+				a.True(errors.As(err, &respErr))
+				a.Equal(http.StatusBadRequest, respErr.StatusCode)
+				a.Equal("BadRequest", respErr.Code)
+				a.Len(requests, 1)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
+func TestNewEndpointTokenAuthorizer(t *testing.T) {
+	a := assert.New(t)
+
+	retrievals := 0
+	refreshAfter := time.Now().Add(time.Hour)
+	authorizer := newEndpointTokenAuthorizer(func(ctx context.Context) (*EndpointToken, error) {
+		retrievals++
+		return &EndpointToken{AccessToken: fmt.Sprintf("token-%d", retrievals), RefreshAfter: refreshAfter}, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		token, err := authorizer(context.Background())
+		a.Nil(err)
+		a.Equal("token-1", token)
+	}
+
+	// The token is refreshed once the refresh time has passed
+	refreshAfter = time.Now().Add(-time.Second)
+	authorizer = newEndpointTokenAuthorizer(func(ctx context.Context) (*EndpointToken, error) {
+		retrievals++
+		return &EndpointToken{AccessToken: fmt.Sprintf("token-%d", retrievals), RefreshAfter: refreshAfter}, nil
+	})
+	_, _ = authorizer(context.Background())
+	token, err := authorizer(context.Background())
+	a.Nil(err)
+	a.Equal("token-3", token)
+}
+
+func TestWorkspace_NewScoringClient(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+
+	var requests []scoringRequest
+	server := newScoringServer(&requests)
+	defer server.Close()
+	endpointResp := func(authMode EndpointAuthMode) string {
+		return fmt.Sprintf("{\"name\": \"endpoint\", \"properties\": {\"authMode\": \"%s\", \"scoringUri\": \"%s/score\"}}", authMode, server.URL)
+	}
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test scoring client of endpoint with key auth mode",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, endpointResp(EndpointAuthModeKey), nil)
+				mockedHttpClient.On("doPost", "onlineEndpoints/endpoint/listKeys", nil).Return(
+					http.StatusOK, "{\"primaryKey\": \"primary\", \"secondaryKey\": \"secondary\"}", nil,
+				)
+
+				requests = nil
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				client, err := ws.NewScoringClient("rg", "ws", "endpoint")
+				a.Nil(err)
+				a.Equal(server.URL+"/score", client.ScoringUri())
+				_, err = client.Score(context.Background(), []int{1}, nil)
+				a.Nil(err)
+				a.Equal("Bearer primary", requests[0].authorization)
+			},
+		},
+		{
+			testCaseName: "Test scoring client of endpoint with AML token auth mode",
+			testCase: func() {
+				expiry := time.Now().Add(time.Hour).Unix()
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, endpointResp(EndpointAuthModeAMLToken), nil)
+				mockedHttpClient.On("doPost", "onlineEndpoints/endpoint/token", nil).Return(
+					http.StatusOK, fmt.Sprintf("{\"accessToken\": \"token\", \"expiryTimeUtc\": %d, \"tokenType\": \"Bearer\"}", expiry), nil,
+				).Once()
+
+				requests = nil
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				client, err := ws.NewScoringClient("rg", "ws", "endpoint")
+				a.Nil(err)
+				for i := 0; i < 2; i++ {
+					_, err = client.Score(context.Background(), []int{1}, nil)
+					a.Nil(err)
+				}
+				a.Len(requests, 2)
+				a.Equal("Bearer token", requests[1].authorization)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test scoring client of endpoint with AAD token auth mode",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(http.StatusOK, endpointResp(EndpointAuthModeAADToken), nil)
+
+				requests = nil
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				_, err := ws.NewScoringClient("rg", "ws", "endpoint")
+				a.NotNil(err)
+
+				credential := &fakeTokenCredential{token: AccessToken{Token: "aad-token"}}
+				ws.credential = credential
+				client, err := ws.NewScoringClient("rg", "ws", "endpoint")
+				a.Nil(err)
+				_, err = client.Score(context.Background(), []int{1}, nil)
+				a.Nil(err)
+				a.Equal("Bearer aad-token", requests[0].authorization)
+				a.Equal([]string{AzurePublicCloud.MachineLearningScope}, credential.scopes)
+			},
+		},
+		{
+			testCaseName: "Test scoring client of endpoint not provisioned",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "onlineEndpoints/endpoint").Return(
+					http.StatusOK, "{\"properties\": {\"authMode\": \"Key\", \"provisioningState\": \"Creating\"}}", nil,
+				)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				client, err := ws.NewScoringClient("rg", "ws", "endpoint")
+				a.Nil(client)
+				a.NotNil(err)
+			},
+		},
+		{
+			testCaseName: "Test get online endpoint token",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doPost", "onlineEndpoints/endpoint/token", nil).Return(
+					http.StatusOK, "{\"accessToken\": \"token\", \"expiryTimeUtc\": 1654080000, \"refreshAfterTimeUtc\": 1654070000}", nil,
+				)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				token, err := ws.GetOnlineEndpointToken("rg", "ws", "endpoint")
+				a.Nil(err)
+				a.Equal("token", token.AccessToken)
+				a.Equal(int64(1654080000), token.ExpiresOn.Unix())
+				a.Equal(int64(1654070000), token.RefreshAfter.Unix())
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
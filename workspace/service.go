@@ -3,8 +3,6 @@ package workspace
 import (
 	"context"
 	"fmt"
-	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/confidential"
-	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 	"io/ioutil"
 	"net/http"
@@ -13,9 +11,28 @@ import (
 )
 
 type Workspace struct {
-	httpClientBuilder HttpClientBuilderAPI
-	logger            *zap.SugaredLogger
-	datasetConverter  *DatasetConverter
+	httpClientBuilder     HttpClientBuilderAPI
+	logger                *zap.SugaredLogger
+	datasetConverter      *DatasetConverter
+	datastoreConverter    datastoreConverter
+	modelConverter        *ModelConverter
+	computeConverter      *ComputeConverter
+	jobConverter          *JobConverter
+	endpointConverter     *EndpointConverter
+	storageEndpointSuffix string
+
+	// workspacesApiVersion The version of the APIs used for the operations on the workspaces themselves
+	workspacesApiVersion string
+
+	// scoringHttpClient, scoringRetrier Used by the scoring clients for sending the requests to the online
+	// endpoints, applying the same retry policy of the requests to the AML APIs
+	scoringHttpClient *http.Client
+	scoringRetrier    *retrier
+
+	// credential, machineLearningScope Used for authenticating the requests to the endpoints using the
+	// AADToken auth mode, such as the batch endpoints
+	credential           TokenCredential
+	machineLearningScope string
 }
 
 type Config struct {
@@ -23,6 +40,27 @@ type Config struct {
 	ClientSecret   string
 	TenantId       string
 	SubscriptionId string
+
+	// Credential The credential used for authenticating with Azure. If nil, a ClientSecretCredential is created
+	// from ClientId, ClientSecret and TenantId.
+	Credential TokenCredential
+
+	// Cloud The endpoints of the Azure cloud hosting the workspaces, e.g. AzureChinaCloud. Empty endpoints
+	// default to the ones of the Azure public cloud.
+	Cloud Cloud
+
+	// RetryPolicy The policy used for retrying the requests failed with transient errors. If nil, the policy
+	// returned by DefaultRetryPolicy is used.
+	RetryPolicy *RetryPolicy
+
+	// ApiVersions The versions of the AML APIs used for the requests, which can be set for the whole client and
+	// for specific resource types. If empty, 2021-03-01-preview is used for all the resource types.
+	ApiVersions ApiVersions
+
+	// ResourceGroup, WorkspaceName The workspace of the client returned by NewScoped, e.g. read from the
+	// config.json file of the workspace. They are ignored by New.
+	ResourceGroup string
+	WorkspaceName string
 }
 
 func New(config Config, debug bool) (*Workspace, error) {
@@ -33,57 +71,95 @@ func New(config Config, debug bool) (*Workspace, error) {
 		logger, _ = zap.NewProduction()
 	}
 
-	credential, err := confidential.NewCredFromSecret(config.ClientSecret)
-	if err != nil {
-		return &Workspace{}, err
+	cloud := config.Cloud.withDefaults()
+	credential := config.Credential
+	if credential == nil {
+		credentialOptions := &CredentialOptions{AuthorityHost: cloud.ActiveDirectoryAuthorityHost}
+		secretCredential, err := NewClientSecretCredential(config.TenantId, config.ClientId, config.ClientSecret, credentialOptions)
+		if err != nil {
+			return &Workspace{}, err
+		}
+		credential = secretCredential
 	}
 
-	authority := fmt.Sprintf("https://login.microsoftonline.com/%s", config.TenantId)
-	msalClient, err := confidential.New(config.ClientId, credential, confidential.WithAuthority(authority))
-	if err != nil {
-		return &Workspace{}, err
+	retryPolicy := DefaultRetryPolicy()
+	if config.RetryPolicy != nil {
+		retryPolicy = *config.RetryPolicy
 	}
 
 	httpClientBuilder := newHttpClientBuilder(
 		logger.Sugar(),
-		msalClient,
+		credential,
 		config.SubscriptionId,
+		cloud,
+		retryPolicy,
+		config.ApiVersions,
 	)
 
-	return newWorkspace(httpClientBuilder, logger), nil
+	workspace := newWorkspaceWithApiVersions(httpClientBuilder, logger, config.ApiVersions)
+	workspace.storageEndpointSuffix = cloud.StorageEndpointSuffix
+	workspace.scoringRetrier = newRetrier(retryPolicy, logger.Sugar())
+	workspace.credential = credential
+	workspace.machineLearningScope = cloud.MachineLearningScope
+	return workspace, nil
 }
 
 func newWorkspace(clientBuilder HttpClientBuilderAPI, logger *zap.Logger) *Workspace {
+	return newWorkspaceWithApiVersions(clientBuilder, logger, ApiVersions{})
+}
+
+// newWorkspaceWithApiVersions Create a workspace whose converters use the payloads of the API versions provided as argument.
+func newWorkspaceWithApiVersions(clientBuilder HttpClientBuilderAPI, logger *zap.Logger, apiVersions ApiVersions) *Workspace {
 	sugarLogger := logger.Sugar()
 	return &Workspace{
 		httpClientBuilder: clientBuilder,
 		logger:            sugarLogger,
-		datasetConverter:  &DatasetConverter{sugarLogger},
+		datasetConverter: &DatasetConverter{
+			logger:     sugarLogger,
+			apiVersion: apiVersions.forResourceType(ResourceTypeDatasets),
+		},
+		datastoreConverter:    datastoreConverter{apiVersions.forResourceType(ResourceTypeDatastores)},
+		modelConverter:        &ModelConverter{sugarLogger},
+		computeConverter:      &ComputeConverter{sugarLogger},
+		jobConverter:          &JobConverter{sugarLogger},
+		endpointConverter:     &EndpointConverter{sugarLogger},
+		storageEndpointSuffix: AzurePublicCloud.StorageEndpointSuffix,
+		workspacesApiVersion:  apiVersions.forResourceType(ResourceTypeWorkspaces),
+		scoringHttpClient:     &http.Client{},
+		scoringRetrier:        newRetrier(DefaultRetryPolicy(), sugarLogger),
+		machineLearningScope:  AzurePublicCloud.MachineLearningScope,
 	}
 }
 
 func (w *Workspace) GetDatastores(resourceGroup, workspace string) ([]Datastore, error) {
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet("datastores")
-	if err != nil {
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+	return w.GetDatastoresWithContext(context.Background(), resourceGroup, workspace)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
+func (w *Workspace) GetDatastoresWithContext(ctx context.Context, resourceGroup, workspace string) ([]Datastore, error) {
+	pager := w.NewDatastorePager(resourceGroup, workspace)
+	result := make([]Datastore, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
 	}
+	return result, nil
+}
 
-	return unmarshalDatastoreArray(body), err
+// NewDatastorePager Return a pager for iterating page by page over the datastores of the workspace.
+func (w *Workspace) NewDatastorePager(resourceGroup, workspace string) *DatastorePager {
+	return newTypedPager(newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), "datastores"), w.datastoreConverter.unmarshalDatastoreArray)
 }
 
 func (w *Workspace) GetDatastore(resourceGroup, workspace, datastoreName string) (*Datastore, error) {
+	return w.GetDatastoreWithContext(context.Background(), resourceGroup, workspace, datastoreName)
+}
+
+func (w *Workspace) GetDatastoreWithContext(ctx context.Context, resourceGroup, workspace, datastoreName string) (*Datastore, error) {
 	path := fmt.Sprintf("datastores/%s", datastoreName)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(path)
+	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -98,61 +174,47 @@ func (w *Workspace) GetDatastore(resourceGroup, workspace, datastoreName string)
 		return nil, &ResourceNotFoundError{"datastore", datastoreName}
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
+		return nil, newHttpResponseError(resp, body)
 	}
 
-	return unmarshalDatastore(body), err
+	return w.datastoreConverter.unmarshalDatastore(body), err
 }
 
 func (w *Workspace) DeleteDatastore(resourceGroup, workspace, datastoreName string) error {
-	path := fmt.Sprintf("datastores/%s", datastoreName)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doDelete(path)
-
-	if err != nil {
-		return err
-	}
-
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
+	return w.DeleteDatastoreWithContext(context.Background(), resourceGroup, workspace, datastoreName)
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return &ResourceNotFoundError{"datastore", datastoreName}
-	}
-	if resp.StatusCode != http.StatusOK {
-		return &HttpResponseError{resp.StatusCode, string(body)}
-	}
-	return nil
+func (w *Workspace) DeleteDatastoreWithContext(ctx context.Context, resourceGroup, workspace, datastoreName string) error {
+	path := fmt.Sprintf("datastores/%s", datastoreName)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"datastore", datastoreName})
 }
 
 func (w *Workspace) CreateOrUpdateDatastore(resourceGroup, workspace string, datastore *Datastore) (*Datastore, error) {
+	return w.CreateOrUpdateDatastoreWithContext(context.Background(), resourceGroup, workspace, datastore)
+}
+
+func (w *Workspace) CreateOrUpdateDatastoreWithContext(ctx context.Context, resourceGroup, workspace string, datastore *Datastore) (*Datastore, error) {
 	if strings.TrimSpace(datastore.Name) == "" {
 		return nil, InvalidArgumentError{"the datastore name cannot be empty"}
 	}
-
-	path := fmt.Sprintf("datastores/%s", datastore.Name)
-	schema := toWriteDatastoreSchema(datastore)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doPut(path, schema)
-	if err != nil {
+	if err := w.datastoreConverter.validateDatastore(datastore); err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	path := fmt.Sprintf("datastores/%s", datastore.Name)
+	schema := w.datastoreConverter.toWriteDatastoreSchema(datastore, w.storageEndpointSuffix)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, schema)
 	if err != nil {
 		return nil, err
 	}
-
-	if resp.StatusCode >= http.StatusBadRequest {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
-	}
-
-	return unmarshalDatastore(body), err
+	return w.datastoreConverter.unmarshalDatastore(body), nil
 }
 
 func (w *Workspace) CreateOrUpdateDataset(resourceGroup, workspace string, dataset *Dataset) (*Dataset, error) {
+	return w.CreateOrUpdateDatasetWithContext(context.Background(), resourceGroup, workspace, dataset)
+}
+
+func (w *Workspace) CreateOrUpdateDatasetWithContext(ctx context.Context, resourceGroup, workspace string, dataset *Dataset) (*Dataset, error) {
 	if strings.TrimSpace(dataset.Name) == "" {
 		return nil, InvalidArgumentError{"the dataset name cannot be empty"}
 	}
@@ -160,105 +222,130 @@ func (w *Workspace) CreateOrUpdateDataset(resourceGroup, workspace string, datas
 		return nil, InvalidArgumentError{"the dataset must have at least one path"}
 	}
 
-	path := fmt.Sprintf("datasets/%s/versions/%d", dataset.Name, dataset.Version)
-	schema := toWriteDatasetSchema(dataset)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doPut(path, schema)
+	schema, err := w.datasetConverter.toWriteDatasetSchema(dataset)
 	if err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	path := w.datasetConverter.resourcePath("/%s/versions/%d", dataset.Name, dataset.Version)
+	body, err := w.putResource(ctx, resourceGroup, workspace, path, schema)
 	if err != nil {
 		return nil, err
 	}
-
-	if resp.StatusCode >= http.StatusBadRequest {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
-	}
-
-	return w.datasetConverter.unmarshalDatasetVersion(dataset.Name, body), err
+	return w.datasetConverter.unmarshalDatasetVersion(dataset.Name, body), nil
 }
 
 func (w *Workspace) GetDatasets(resourceGroup, workspace string) ([]Dataset, error) {
-	datasetNames, err := w.getDatasetNames(resourceGroup, workspace)
+	return w.GetDatasetsWithContext(context.Background(), resourceGroup, workspace)
+}
+
+func (w *Workspace) GetDatasetsWithContext(ctx context.Context, resourceGroup, workspace string) ([]Dataset, error) {
+	datasetNames, err := w.getDatasetNames(ctx, resourceGroup, workspace)
 	if err != nil {
 		return nil, err
 	}
-	return w.retrieveLatestDatasetsVersions(resourceGroup, workspace, datasetNames)
+	return w.retrieveLatestDatasetsVersions(ctx, resourceGroup, workspace, datasetNames)
 }
 
 func (w *Workspace) GetDatasetVersions(resourceGroup, workspace, datasetName string) ([]Dataset, error) {
-	path := fmt.Sprintf("datasets/%s/versions", datasetName)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(path)
-	if err != nil {
-		return nil, err
-	}
+	return w.GetDatasetVersionsWithContext(context.Background(), resourceGroup, workspace, datasetName)
+}
 
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+func (w *Workspace) GetDatasetVersionsWithContext(ctx context.Context, resourceGroup, workspace, datasetName string) ([]Dataset, error) {
+	pager := w.NewDatasetVersionPager(resourceGroup, workspace, datasetName)
+	result := make([]Dataset, 0)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
 	}
+	return result, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
-	}
+// NewDatasetVersionPager Return a pager for iterating page by page over the versions of the dataset
+// with the name provided as argument.
+func (w *Workspace) NewDatasetVersionPager(resourceGroup, workspace, datasetName string) *DatasetVersionPager {
+	path := w.datasetConverter.resourcePath("/%s/versions", datasetName)
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), path)
+	pager.notFoundErr = &ResourceNotFoundError{"dataset", datasetName}
+	return newTypedPager(pager, func(body []byte) []Dataset {
+		return w.datasetConverter.unmarshalDatasetVersionArray(datasetName, body)
+	})
+}
 
-	return w.datasetConverter.unmarshalDatasetVersionArray(datasetName, body), nil
+// NewDatasetPager Return a pager for iterating page by page over the datasets of the workspace. For each
+// dataset, only its latest version is returned.
+func (w *Workspace) NewDatasetPager(resourceGroup, workspace string) *DatasetPager {
+	return &DatasetPager{
+		pager: newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), w.datasetConverter.resourcePath("")),
+		unmarshal: func(ctx context.Context, body []byte) ([]Dataset, error) {
+			return w.retrieveLatestDatasetsVersions(ctx, resourceGroup, workspace, unmarshalDatasetNames(body))
+		},
+	}
 }
 
 // retrieveLatestDatasetsVersions For each of the dataset names provided as argument, return the respective latest version
-func (w *Workspace) retrieveLatestDatasetsVersions(resourceGroup, workspaceName string, datasetNames []string) ([]Dataset, error) {
-	var result []Dataset
+func (w *Workspace) retrieveLatestDatasetsVersions(ctx context.Context, resourceGroup, workspaceName string, datasetNames []string) ([]Dataset, error) {
+	result := make([]Dataset, len(datasetNames))
+	err := forEachConcurrently(ctx, len(datasetNames), func(ctx context.Context, i int) error {
+		d, err := w.getLatestDatasetVersion(ctx, resourceGroup, workspaceName, datasetNames[i])
+		if err != nil {
+			return err
+		}
+		result[i] = *d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-	latestVersionChan := make(chan *Dataset, len(datasetNames))
-	errChan := make(chan error, len(datasetNames))
+// forEachConcurrently Call fn for each index in [0, n), running at most NConcurrentWorkers calls at the same time.
+// The first error returned by fn cancels the context of the remaining calls and is returned.
+func forEachConcurrently(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error {
+	errChan := make(chan error, n)
 	sem := make(chan int, NConcurrentWorkers)
-	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(datasetNames))
+	wg.Add(n)
 	defer cancel()
 
-	for _, datasetName := range datasetNames {
-		go func(dataset string) {
+	for i := 0; i < n; i++ {
+		go func(i int) {
 			defer wg.Done()
 			select {
 			case <-ctx.Done():
 				return
 			case sem <- 1: // acquire lock
-				d, err := w.getLatestDatasetVersion(resourceGroup, workspaceName, dataset)
-				if err != nil {
+				if err := fn(ctx, i); err != nil {
 					errChan <- err
 					cancel()
-				} else {
-					latestVersionChan <- d
 				}
 				<-sem // release lock
 			}
-		}(datasetName)
+		}(i)
 	}
 
 	wg.Wait()
 
 	select {
 	case err := <-errChan:
-		return nil, err
+		return err
 	default:
-		close(latestVersionChan)
-		for d := range latestVersionChan {
-			result = append(result, *d)
-		}
-		return result, nil
 	}
+
+	// The context can only be done at this point if the parent context was cancelled
+	return ctx.Err()
 }
 
 // getLatestDatasetVersion Return the latest version of the dataset with the name provided as argument
-func (w *Workspace) getLatestDatasetVersion(resourceGroup, workspace, datasetName string) (*Dataset, error) {
+func (w *Workspace) getLatestDatasetVersion(ctx context.Context, resourceGroup, workspace, datasetName string) (*Dataset, error) {
 	w.logger.Debugf("Fetching latest version of dataset %q", datasetName)
-	versions, err := w.GetDatasetVersions(resourceGroup, workspace, datasetName)
+	versions, err := w.GetDatasetVersionsWithContext(ctx, resourceGroup, workspace, datasetName)
 	if err != nil {
 		return nil, err
 	}
@@ -274,9 +361,27 @@ func (w *Workspace) getLatestDatasetVersion(resourceGroup, workspace, datasetNam
 }
 
 // Return the names of the datasets of the workspace provided as argument.
-func (w *Workspace) getDatasetNames(resourceGroup, workspace string) ([]string, error) {
+func (w *Workspace) getDatasetNames(ctx context.Context, resourceGroup, workspace string) ([]string, error) {
 	w.logger.Debugf("Retrieving dataset names of workspace %q in resource group %q", workspace, resourceGroup)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet("datasets")
+	pager := newPager(w.httpClientBuilder.newClient(resourceGroup, workspace), w.datasetConverter.resourcePath(""))
+	result := make([]string, 0)
+	for pager.more() {
+		body, err := pager.nextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, unmarshalDatasetNames(body)...)
+	}
+	return result, nil
+}
+
+func (w *Workspace) GetDataset(resourceGroup, workspace, name string, version int) (*Dataset, error) {
+	return w.GetDatasetWithContext(context.Background(), resourceGroup, workspace, name, version)
+}
+
+func (w *Workspace) GetDatasetWithContext(ctx context.Context, resourceGroup, workspace, name string, version int) (*Dataset, error) {
+	path := w.datasetConverter.resourcePath("/%s/versions/%d", name, version)
+	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -287,21 +392,75 @@ func (w *Workspace) getDatasetNames(resourceGroup, workspace string) ([]string,
 		return nil, err
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &ResourceNotFoundError{"dataset", datasetVersionIdentifier(name, version)}
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
+		return nil, newHttpResponseError(resp, body)
+	}
+
+	return w.datasetConverter.unmarshalDatasetVersion(name, body), nil
+}
+
+func (w *Workspace) GetDatasetNextVersion(resourceGroup, workspace, name string) (int, error) {
+	return w.GetDatasetNextVersionWithContext(context.Background(), resourceGroup, workspace, name)
+}
+
+func (w *Workspace) GetDatasetNextVersionWithContext(ctx context.Context, resourceGroup, workspace, name string) (int, error) {
+	path := w.datasetConverter.nextVersionPath(name)
+	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(ctx, path)
+	if err != nil {
+		return -1, err
 	}
 
-	jsonDatastoreArray := gjson.GetBytes(body, "value").Array()
-	result := make([]string, len(jsonDatastoreArray))
-	for i, value := range jsonDatastoreArray {
-		result[i] = value.Get("name").Str
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return -1, err
 	}
-	return result, nil
+
+	if resp.StatusCode == http.StatusNotFound {
+		return -1, &ResourceNotFoundError{"dataset", name}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return -1, newHttpResponseError(resp, body)
+	}
+
+	return w.datasetConverter.unmarshalDatasetNextVersion(body), nil
 }
 
-func (w *Workspace) GetDataset(resourceGroup, workspace, name string, version int) (*Dataset, error) {
-	path := fmt.Sprintf("datasets/%s/versions/%d", name, version)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(path)
+func (w *Workspace) DeleteDataset(resourceGroup, workspace, datasetName string) error {
+	return w.DeleteDatasetWithContext(context.Background(), resourceGroup, workspace, datasetName)
+}
+
+func (w *Workspace) DeleteDatasetWithContext(ctx context.Context, resourceGroup, workspace, datasetName string) error {
+	path := w.datasetConverter.resourcePath("/%s", datasetName)
+	return w.deleteResource(ctx, resourceGroup, workspace, path, &ResourceNotFoundError{"dataset", datasetName})
+}
+
+func (w *Workspace) DeleteDatasetVersion(resourceGroup, workspace, datasetName string, version int) error {
+	return w.DeleteDatasetVersionWithContext(context.Background(), resourceGroup, workspace, datasetName, version)
+}
+
+func (w *Workspace) DeleteDatasetVersionWithContext(ctx context.Context, resourceGroup, workspace, datasetName string, version int) error {
+	path := w.datasetConverter.resourcePath("/%s/versions/%d", datasetName, version)
+	notFoundErr := &ResourceNotFoundError{"dataset", datasetVersionIdentifier(datasetName, version)}
+	return w.deleteResource(ctx, resourceGroup, workspace, path, notFoundErr)
+}
+
+// datasetVersionIdentifier Return the identifier of a specific version of a dataset, in the format <name>:<version>
+func datasetVersionIdentifier(name string, version int) string {
+	return fmt.Sprintf("%s:%d", name, version)
+}
+
+// getResource Return the body of the resource at the path provided as argument, or notFoundErr if it does not exist
+func (w *Workspace) getResource(ctx context.Context, resourceGroup, workspace, path string, notFoundErr error) ([]byte, error) {
+	return w.getResourceWith(ctx, w.httpClientBuilder.newClient(resourceGroup, workspace), path, notFoundErr)
+}
+
+// getResourceWith Same as getResource, sending the request with the client provided as argument
+func (w *Workspace) getResourceWith(ctx context.Context, client HttpClientAPI, path string, notFoundErr error) ([]byte, error) {
+	resp, err := client.doGet(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -312,69 +471,159 @@ func (w *Workspace) GetDataset(resourceGroup, workspace, name string, version in
 		return nil, err
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFoundErr
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HttpResponseError{resp.StatusCode, string(body)}
+		return nil, newHttpResponseError(resp, body)
 	}
+	return body, nil
+}
 
-	return w.datasetConverter.unmarshalDatasetVersion(name, body), nil
+// putResource Create or update the resource at the path provided as argument, waiting until the operation
+// completes, and return the body of the resource. Asynchronous operations are checked every
+// DefaultOperationPollInterval, unless the APIs request otherwise with Retry-After.
+func (w *Workspace) putResource(ctx context.Context, resourceGroup, workspace, path string, schema interface{}) ([]byte, error) {
+	operation, err := w.beginPutResource(ctx, resourceGroup, workspace, path, schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := operation.pollUntilDone(ctx, DefaultOperationPollInterval); err != nil {
+		return nil, err
+	}
+	return operation.result, nil
 }
 
-func (w *Workspace) GetDatasetNextVersion(resourceGroup, workspace, name string) (int, error) {
-	path := fmt.Sprintf("datasets/%s/versions", name)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doGet(path)
+// postResource Perform the action at the path provided as argument, returning the body of the response or
+// notFoundErr if the resource does not exist
+func (w *Workspace) postResource(ctx context.Context, resourceGroup, workspace, path string, requestBody interface{}, notFoundErr error) ([]byte, error) {
+	return w.postResourceWith(ctx, w.httpClientBuilder.newClient(resourceGroup, workspace), path, requestBody, notFoundErr)
+}
+
+// postResourceWith Same as postResource, sending the request with the client provided as argument
+func (w *Workspace) postResourceWith(ctx context.Context, client HttpClientAPI, path string, requestBody interface{}, notFoundErr error) ([]byte, error) {
+	resp, err := client.doPost(ctx, path, requestBody)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return -1, &HttpResponseError{resp.StatusCode, string(body)}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFoundErr
 	}
-
-	return w.datasetConverter.unmarshalDatasetNextVersion(body), nil
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newHttpResponseError(resp, body)
+	}
+	return body, nil
 }
 
-func (w *Workspace) DeleteDataset(resourceGroup, workspace, datasetName string) error {
-	path := fmt.Sprintf("datasets/%s", datasetName)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doDelete(path)
+// deleteResource Delete the resource at the path provided as argument, waiting until the operation completes,
+// or return notFoundErr if it does not exist. Asynchronous operations are checked as in putResource.
+func (w *Workspace) deleteResource(ctx context.Context, resourceGroup, workspace, path string, notFoundErr error) error {
+	operation, err := w.beginDeleteResource(ctx, resourceGroup, workspace, path, notFoundErr)
 	if err != nil {
 		return err
 	}
+	return operation.pollUntilDone(ctx, DefaultOperationPollInterval)
+}
+
+// beginPutResource Start the creation or the update of the resource at the path provided as argument, returning
+// the long-running operation tracking it
+func (w *Workspace) beginPutResource(ctx context.Context, resourceGroup, workspace, path string, schema interface{}) (*operation, error) {
+	operation, err := w.beginPutResourceWith(ctx, w.httpClientBuilder.newClient(resourceGroup, workspace), path, schema)
+	if err != nil {
+		return nil, err
+	}
+	operation.resourceGroup, operation.workspace = resourceGroup, workspace
+	return operation, nil
+}
+
+// beginPutResourceWith Same as beginPutResource, sending the requests with the client provided as argument
+func (w *Workspace) beginPutResourceWith(ctx context.Context, client HttpClientAPI, path string, schema interface{}) (*operation, error) {
+	resp, err := client.doPut(ctx, path, schema)
+	if err != nil {
+		return nil, err
+	}
 
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return &HttpResponseError{resp.StatusCode, string(body)}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newHttpResponseError(resp, body)
 	}
+	return newOperation(client, w.logger, path, resp, body), nil
+}
 
-	return nil
+// beginPostResource Perform the long-running action at the path provided as argument, returning the operation
+// tracking it or notFoundErr if the resource does not exist
+func (w *Workspace) beginPostResource(ctx context.Context, resourceGroup, workspace, path string, requestBody interface{}, notFoundErr error) (*operation, error) {
+	operation, err := w.beginPostResourceWith(ctx, w.httpClientBuilder.newClient(resourceGroup, workspace), path, requestBody, notFoundErr)
+	if err != nil {
+		return nil, err
+	}
+	operation.resourceGroup, operation.workspace = resourceGroup, workspace
+	return operation, nil
 }
 
-func (w *Workspace) DeleteDatasetVersion(resourceGroup, workspace, datasetName string, version int) error {
-	path := fmt.Sprintf("datasets/%s/versions/%d", datasetName, version)
-	resp, err := w.httpClientBuilder.newClient(resourceGroup, workspace).doDelete(path)
+// beginPostResourceWith Same as beginPostResource, sending the requests with the client provided as argument
+func (w *Workspace) beginPostResourceWith(ctx context.Context, client HttpClientAPI, path string, requestBody interface{}, notFoundErr error) (*operation, error) {
+	resp, err := client.doPost(ctx, path, requestBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return &HttpResponseError{resp.StatusCode, string(body)}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFoundErr
 	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newHttpResponseError(resp, body)
+	}
+	return newOperation(client, w.logger, "", resp, body), nil
+}
 
-	return nil
+// beginDeleteResource Start the deletion of the resource at the path provided as argument, returning the
+// long-running operation tracking it or notFoundErr if the resource does not exist
+func (w *Workspace) beginDeleteResource(ctx context.Context, resourceGroup, workspace, path string, notFoundErr error) (*operation, error) {
+	operation, err := w.beginDeleteResourceWith(ctx, w.httpClientBuilder.newClient(resourceGroup, workspace), path, notFoundErr)
+	if err != nil {
+		return nil, err
+	}
+	operation.resourceGroup, operation.workspace = resourceGroup, workspace
+	return operation, nil
+}
+
+// beginDeleteResourceWith Same as beginDeleteResource, sending the requests with the client provided as argument
+func (w *Workspace) beginDeleteResourceWith(ctx context.Context, client HttpClientAPI, path string, notFoundErr error) (*operation, error) {
+	resp, err := client.doDelete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFoundErr
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent {
+		return nil, newHttpResponseError(resp, body)
+	}
+	return newOperation(client, w.logger, "", resp, body), nil
 }
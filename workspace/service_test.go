@@ -1,6 +1,7 @@
 package workspace
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -88,7 +89,7 @@ func TestWorkspace_GetDatastore(t *testing.T) {
 			"foo",
 			"example_resp_empty.json",
 			http.StatusBadRequest,
-			&HttpResponseError{http.StatusBadRequest, ""},
+			&HttpResponseError{StatusCode: http.StatusBadRequest, ResponseContent: ""},
 			nil,
 			nil,
 		},
@@ -198,7 +199,7 @@ func TestWorkspace_GetDatastores(t *testing.T) {
 			"HTTP != 200",
 			"example_resp_empty.json",
 			http.StatusInternalServerError,
-			&HttpResponseError{http.StatusInternalServerError, ""},
+			&HttpResponseError{StatusCode: http.StatusInternalServerError, ResponseContent: ""},
 			nil,
 			nil,
 		},
@@ -251,6 +252,20 @@ func TestWorkspace_DeleteDatastore(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"HTTP 202 Accepted",
+			"foo",
+			http.StatusAccepted,
+			nil,
+			nil,
+		},
+		{
+			"HTTP 204 No Content",
+			"foo",
+			http.StatusNoContent,
+			nil,
+			nil,
+		},
 		{
 			"HTTP 404 - Datastore not found",
 			"foo",
@@ -263,14 +278,14 @@ func TestWorkspace_DeleteDatastore(t *testing.T) {
 			"foo",
 			http.StatusInternalServerError,
 			nil,
-			&HttpResponseError{http.StatusInternalServerError, ""},
+			&HttpResponseError{StatusCode: http.StatusInternalServerError, ResponseContent: ""},
 		},
 		{
 			"HTTP Client error",
 			"foo",
 			http.StatusOK,
-			&exec.Error{"", nil},
-			&exec.Error{"", nil},
+			&exec.Error{Name: "", Err: nil},
+			&exec.Error{Name: "", Err: nil},
 		},
 	}
 
@@ -307,6 +322,22 @@ func TestWorkspace_CreateOrUpdateDatastore(t *testing.T) {
 			nil,
 			InvalidArgumentError{"the datastore name cannot be empty"},
 		},
+		{
+			"Invalid input: blob contents without container",
+			&Datastore{Name: "foo", Contents: AzureBlobContents{AccountName: "account"}},
+			http.StatusOK,
+			"example_resp_empty.json",
+			nil,
+			InvalidArgumentError{"the container name of the AzureBlob datastore cannot be empty"},
+		},
+		{
+			"Invalid input: service principal credentials without secret",
+			&Datastore{Name: "foo", Credentials: ServicePrincipalCredentials{TenantId: "tenant", ClientId: "client"}},
+			http.StatusOK,
+			"example_resp_empty.json",
+			nil,
+			InvalidArgumentError{"the client secret of the ServicePrincipal credentials cannot be empty"},
+		},
 		{
 			"HTTP 201 - Created",
 			&Datastore{Name: "foo"},
@@ -321,15 +352,15 @@ func TestWorkspace_CreateOrUpdateDatastore(t *testing.T) {
 			http.StatusInternalServerError,
 			"example_resp_empty.json",
 			nil,
-			&HttpResponseError{http.StatusInternalServerError, ""},
+			&HttpResponseError{StatusCode: http.StatusInternalServerError, ResponseContent: ""},
 		},
 		{
 			"HTTP Client error",
 			&Datastore{Name: "foo"},
 			http.StatusOK,
 			"example_resp_empty.json",
-			&exec.Error{"", nil},
-			&exec.Error{"", nil},
+			&exec.Error{Name: "", Err: nil},
+			&exec.Error{Name: "", Err: nil},
 		},
 	}
 
@@ -354,6 +385,115 @@ func TestWorkspace_CreateOrUpdateDatastore(t *testing.T) {
 	}
 }
 
+func TestWorkspace_CreateOrUpdateDatastore_ReadModifyWrite(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	logger := l.Sugar()
+	blobDatastoreResp := `{"name": "foo", "properties": {"contents": {
+		"contentsType": "AzureBlob", "accountName": "account", "containerName": "container",
+		"endpoint": "core.chinacloudapi.cn", "protocol": "https"
+	}}}`
+
+	testCases := []struct {
+		testCaseName string
+		testCase     func()
+	}{
+		{
+			testCaseName: "Test changes to the deprecated storage fields are written",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores/foo").Return(http.StatusOK, blobDatastoreResp, nil)
+				mockedHttpClient.On("doPut", "datastores/foo", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					contents := schema.Properties.(WriteDatastoreSchemaProperties).Contents
+					return contents.StorageAccountName == "account" && contents.StorageContainerName == "other" &&
+						contents.Endpoint == "core.chinacloudapi.cn"
+				})).Return(http.StatusOK, blobDatastoreResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				datastore, err := ws.GetDatastore("rg", "ws", "foo")
+				a.Nil(err)
+				a.Equal(AzureBlobContents{AccountName: "account", ContainerName: "container", Endpoint: "core.chinacloudapi.cn", Protocol: "https"}, datastore.Contents)
+				a.Equal("container", datastore.StorageContainerName)
+
+				datastore.StorageContainerName = "other"
+				_, err = ws.CreateOrUpdateDatastore("rg", "ws", datastore)
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test change of the deprecated storage type is written",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores/foo").Return(http.StatusOK, blobDatastoreResp, nil)
+				mockedHttpClient.On("doPut", "datastores/foo", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					contents := schema.Properties.(WriteDatastoreSchemaProperties).Contents
+					return contents.ContentsType == "AzureFile" && contents.StorageContainerName == "share"
+				})).Return(http.StatusOK, blobDatastoreResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				datastore, err := ws.GetDatastore("rg", "ws", "foo")
+				a.Nil(err)
+				datastore.StorageType, datastore.StorageContainerName = "AzureFile", "share"
+				_, err = ws.CreateOrUpdateDatastore("rg", "ws", datastore)
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test contents replaced after reading the datastore are written",
+			testCase: func() {
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores/foo").Return(http.StatusOK, blobDatastoreResp, nil)
+				mockedHttpClient.On("doPut", "datastores/foo", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					contents := schema.Properties.(WriteDatastoreSchemaProperties).Contents
+					return contents.ContentsType == "AzureBlob" && contents.StorageAccountName == "acc2" &&
+						contents.StorageContainerName == "container2"
+				})).Return(http.StatusOK, blobDatastoreResp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				datastore, err := ws.GetDatastore("rg", "ws", "foo")
+				a.Nil(err)
+				datastore.Contents = AzureBlobContents{AccountName: "acc2", ContainerName: "container2"}
+				_, err = ws.CreateOrUpdateDatastore("rg", "ws", datastore)
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+		{
+			testCaseName: "Test changes to the auth of a datastore read without secrets are written",
+			testCase: func() {
+				resp := `{"name": "foo", "properties": {"contents": {
+					"contentsType": "AzureBlob", "accountName": "account", "containerName": "container",
+					"credentials": {"credentialsType": "AccountKey"}
+				}}}`
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", "datastores/foo").Return(http.StatusOK, resp, nil)
+				mockedHttpClient.On("doPut", "datastores/foo", mock.MatchedBy(func(schema *SchemaWrapper) bool {
+					credentials := schema.Properties.(WriteDatastoreSchemaProperties).Contents.Credentials
+					return credentials.CredentialsType == "AccountKey" && credentials.Secrets.AccountKey == "key"
+				})).Return(http.StatusOK, resp, nil)
+
+				ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+				datastore, err := ws.GetDatastore("rg", "ws", "foo")
+				a.Nil(err)
+				a.Nil(datastore.Credentials)
+				a.Equal("AccountKey", datastore.Auth.CredentialsType)
+
+				datastore.Auth.AccountKey = "key"
+				_, err = ws.CreateOrUpdateDatastore("rg", "ws", datastore)
+				a.Nil(err)
+				mockedHttpClient.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		logger.Infof("Running test case %q", test.testCaseName)
+		test.testCase()
+	}
+}
+
 func TestWorkspace_RetrieveLatestDatasetsVersions(t *testing.T) {
 	a := assert.New(t)
 	l, _ := zap.NewDevelopment()
@@ -369,7 +509,7 @@ func TestWorkspace_RetrieveLatestDatasetsVersions(t *testing.T) {
 				mockedHttpClient := new(MockedHttpClient)
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersions, err := ws.retrieveLatestDatasetsVersions("", "", []string{})
+				latestVersions, err := ws.retrieveLatestDatasetsVersions(context.Background(), "", "", []string{})
 				a.Nil(err)
 				a.Empty(latestVersions)
 			},
@@ -385,9 +525,9 @@ func TestWorkspace_RetrieveLatestDatasetsVersions(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersions, err := ws.retrieveLatestDatasetsVersions("", "", mockedDatasetList)
+				latestVersions, err := ws.retrieveLatestDatasetsVersions(context.Background(), "", "", mockedDatasetList)
 				a.Nil(latestVersions)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: mockedResponseBody}, err)
 			},
 		},
 		{
@@ -402,11 +542,28 @@ func TestWorkspace_RetrieveLatestDatasetsVersions(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersions, err := ws.retrieveLatestDatasetsVersions("", "", mockedDatasetList)
+				latestVersions, err := ws.retrieveLatestDatasetsVersions(context.Background(), "", "", mockedDatasetList)
 				a.Nil(latestVersions)
 				a.Equal(mockedError, err)
 			},
 		},
+		{
+			testCaseName: "Test retrieve latest datasets versions with cancelled context",
+			testCase: func() {
+				mockedResponseBody := string(loadExampleResp("example_resp_get_dataset_versions.json"))
+				mockedHttpClient := new(MockedHttpClient)
+				mockedHttpClient.On("doGet", mock.Anything).Return(http.StatusOK, mockedResponseBody, nil)
+				mockedDatasetList := getMockedDatasetNames(NConcurrentWorkers * 2)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				builder := MockedHttpClientBuilder{mockedHttpClient}
+				ws := newWorkspace(builder, l)
+				latestVersions, err := ws.retrieveLatestDatasetsVersions(ctx, "", "", mockedDatasetList)
+				a.Nil(latestVersions)
+				a.Equal(context.Canceled, err)
+			},
+		},
 		{
 			testCaseName: "Test retrieve latest datasets versions success",
 			testCase: func() {
@@ -418,7 +575,7 @@ func TestWorkspace_RetrieveLatestDatasetsVersions(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersions, err := ws.retrieveLatestDatasetsVersions("", "", mockedDatasetList)
+				latestVersions, err := ws.retrieveLatestDatasetsVersions(context.Background(), "", "", mockedDatasetList)
 				a.NotEmpty(latestVersions)
 				a.Nil(err)
 			},
@@ -449,7 +606,7 @@ func TestWorkspace_GetLatestDatasetVersion(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersion, err := ws.getLatestDatasetVersion("", "", "")
+				latestVersion, err := ws.getLatestDatasetVersion(context.Background(), "", "", "")
 				a.Nil(err)
 				a.Empty(latestVersion)
 			},
@@ -464,9 +621,9 @@ func TestWorkspace_GetLatestDatasetVersion(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersion, err := ws.getLatestDatasetVersion("", "", "")
+				latestVersion, err := ws.getLatestDatasetVersion(context.Background(), "", "", "")
 				a.Empty(latestVersion)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: mockedResponseBody}, err)
 			},
 		},
 		{
@@ -479,7 +636,7 @@ func TestWorkspace_GetLatestDatasetVersion(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				latestVersion, err := ws.getLatestDatasetVersion("", "", "")
+				latestVersion, err := ws.getLatestDatasetVersion(context.Background(), "", "", "")
 				a.Nil(err)
 				a.Equal(4, latestVersion.Version)
 			},
@@ -511,9 +668,9 @@ func TestWorkspace_getDatasetNames(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				names, err := ws.getDatasetNames("", "")
+				names, err := ws.getDatasetNames(context.Background(), "", "")
 				a.Empty(names)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: mockedResponseBody}, err)
 			},
 		},
 		{
@@ -527,7 +684,7 @@ func TestWorkspace_getDatasetNames(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				names, err := ws.getDatasetNames("", "")
+				names, err := ws.getDatasetNames(context.Background(), "", "")
 				a.Empty(names)
 				a.Equal(mockedError, err)
 			},
@@ -542,7 +699,7 @@ func TestWorkspace_getDatasetNames(t *testing.T) {
 
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
-				names, err := ws.getDatasetNames("", "")
+				names, err := ws.getDatasetNames(context.Background(), "", "")
 				a.Nil(err)
 				a.Len(names, 3)
 			},
@@ -600,7 +757,7 @@ func TestWorkspace_CreateOrUpdateDataset(t *testing.T) {
 				ws := newWorkspace(builder, l)
 				latestVersion, err := ws.CreateOrUpdateDataset("", "", getMockedDataset())
 				a.Empty(latestVersion)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: mockedResponseBody}, err)
 			},
 		},
 		{
@@ -665,7 +822,7 @@ func TestWorkspace_GetDataset(t *testing.T) {
 				ws := newWorkspace(builder, l)
 				dataset, err := ws.GetDataset("", "", "", 1)
 				a.Nil(dataset)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&ResourceNotFoundError{"dataset", ":1"}, err)
 			},
 		},
 		{
@@ -680,7 +837,7 @@ func TestWorkspace_GetDataset(t *testing.T) {
 				ws := newWorkspace(builder, l)
 				dataset, err := ws.GetDataset("rg", "ws", "dataset", 1)
 				a.Empty(dataset)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: mockedResponseBody}, err)
 			},
 		},
 		{
@@ -743,7 +900,7 @@ func TestWorkspace_GetDatasetNextVersion(t *testing.T) {
 				ws := newWorkspace(builder, l)
 				nextVersion, err := ws.GetDatasetNextVersion("rg", "ws", "dataset")
 				a.Equal(-1, nextVersion)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&ResourceNotFoundError{"dataset", "dataset"}, err)
 			},
 		},
 		{
@@ -758,7 +915,7 @@ func TestWorkspace_GetDatasetNextVersion(t *testing.T) {
 				ws := newWorkspace(builder, l)
 				nextVersion, err := ws.GetDatasetNextVersion("rg", "ws", "dataset")
 				a.Equal(-1, nextVersion)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, mockedResponseBody}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: mockedResponseBody}, err)
 			},
 		},
 		{
@@ -831,7 +988,7 @@ func TestWorkspace_DeleteDataset(t *testing.T) {
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
 				err := ws.DeleteDataset("rg", "ws", "dataset")
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, ""}, err)
+				a.Equal(&ResourceNotFoundError{"dataset", "dataset"}, err)
 			},
 		},
 		{
@@ -845,7 +1002,7 @@ func TestWorkspace_DeleteDataset(t *testing.T) {
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
 				err := ws.DeleteDataset("rg", "ws", "dataset")
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, ""}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: ""}, err)
 			},
 		},
 		{
@@ -902,7 +1059,7 @@ func TestWorkspace_DeleteDatasetVersion(t *testing.T) {
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
 				err := ws.DeleteDatasetVersion("rg", "ws", "dataset", 1)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, ""}, err)
+				a.Equal(&ResourceNotFoundError{"dataset", "dataset:1"}, err)
 			},
 		},
 		{
@@ -916,7 +1073,7 @@ func TestWorkspace_DeleteDatasetVersion(t *testing.T) {
 				builder := MockedHttpClientBuilder{mockedHttpClient}
 				ws := newWorkspace(builder, l)
 				err := ws.DeleteDatasetVersion("rg", "ws", "dataset", 1)
-				a.Equal(&HttpResponseError{mockedResponseStatusCode, ""}, err)
+				a.Equal(&HttpResponseError{StatusCode: mockedResponseStatusCode, ResponseContent: ""}, err)
 			},
 		},
 		{
@@ -0,0 +1,316 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const searchSpacePlaceholderPrefix = "search_space."
+
+// placeholderRegex Match the placeholders of a command, such as ${{inputs.data}}
+var placeholderRegex = regexp.MustCompile(`\$\{\{\s*([^}\s]+)\s*\}\}`)
+
+// SearchDistribution The distribution from which the values of a hyperparameter are sampled. Use Choice, Uniform,
+// LogUniform, Normal and RandInt for creating the distributions.
+type SearchDistribution interface {
+	// searchSpaceExpression Return the expression of the distribution in the search space, e.g. ["uniform", [0, 1]]
+	searchSpaceExpression() []interface{}
+	validate() error
+}
+
+// ChoiceDistribution Sample the hyperparameter among a discrete set of values
+type ChoiceDistribution struct {
+	Values []interface{}
+}
+
+func Choice(values ...interface{}) ChoiceDistribution {
+	return ChoiceDistribution{Values: values}
+}
+
+func (d ChoiceDistribution) searchSpaceExpression() []interface{} {
+	return []interface{}{"choice", []interface{}{d.Values}}
+}
+
+func (d ChoiceDistribution) validate() error {
+	if len(d.Values) == 0 {
+		return InvalidArgumentError{"a choice distribution must have at least one value"}
+	}
+	return nil
+}
+
+// UniformDistribution Sample the hyperparameter uniformly between Min and Max
+type UniformDistribution struct {
+	Min float64
+	Max float64
+}
+
+func Uniform(min, max float64) UniformDistribution {
+	return UniformDistribution{Min: min, Max: max}
+}
+
+func (d UniformDistribution) searchSpaceExpression() []interface{} {
+	return []interface{}{"uniform", []interface{}{d.Min, d.Max}}
+}
+
+func (d UniformDistribution) validate() error {
+	if d.Min >= d.Max {
+		return InvalidArgumentError{fmt.Sprintf("invalid uniform distribution range [%v, %v]", d.Min, d.Max)}
+	}
+	return nil
+}
+
+// LogUniformDistribution Sample the hyperparameter as exp(x), with x sampled uniformly between Min and Max
+type LogUniformDistribution struct {
+	Min float64
+	Max float64
+}
+
+func LogUniform(min, max float64) LogUniformDistribution {
+	return LogUniformDistribution{Min: min, Max: max}
+}
+
+func (d LogUniformDistribution) searchSpaceExpression() []interface{} {
+	return []interface{}{"loguniform", []interface{}{d.Min, d.Max}}
+}
+
+func (d LogUniformDistribution) validate() error {
+	if d.Min >= d.Max {
+		return InvalidArgumentError{fmt.Sprintf("invalid loguniform distribution range [%v, %v]", d.Min, d.Max)}
+	}
+	return nil
+}
+
+// NormalDistribution Sample the hyperparameter from a normal distribution with mean Mu and standard deviation Sigma
+type NormalDistribution struct {
+	Mu    float64
+	Sigma float64
+}
+
+func Normal(mu, sigma float64) NormalDistribution {
+	return NormalDistribution{Mu: mu, Sigma: sigma}
+}
+
+func (d NormalDistribution) searchSpaceExpression() []interface{} {
+	return []interface{}{"normal", []interface{}{d.Mu, d.Sigma}}
+}
+
+func (d NormalDistribution) validate() error {
+	if d.Sigma <= 0 {
+		return InvalidArgumentError{fmt.Sprintf("the sigma of a normal distribution must be positive, got %v", d.Sigma)}
+	}
+	return nil
+}
+
+// RandIntDistribution Sample the hyperparameter among the integers in [0, Upper)
+type RandIntDistribution struct {
+	Upper int
+}
+
+func RandInt(upper int) RandIntDistribution {
+	return RandIntDistribution{Upper: upper}
+}
+
+func (d RandIntDistribution) searchSpaceExpression() []interface{} {
+	return []interface{}{"randint", []interface{}{d.Upper}}
+}
+
+func (d RandIntDistribution) validate() error {
+	if d.Upper <= 0 {
+		return InvalidArgumentError{fmt.Sprintf("the upper bound of a randint distribution must be positive, got %d", d.Upper)}
+	}
+	return nil
+}
+
+// SweepJobBuilder Build a sweep job step by step. The job is validated when calling Build.
+type SweepJobBuilder struct {
+	job   Job
+	sweep SweepJob
+}
+
+// NewSweepJobBuilder Return a builder of a sweep job with the name provided as argument, running the trial
+// command provided as argument. By default, the hyperparameters are sampled randomly.
+func NewSweepJobBuilder(name string, trial *CommandJob) *SweepJobBuilder {
+	return &SweepJobBuilder{
+		job: Job{Name: name, JobType: JobTypeSweep},
+		sweep: SweepJob{
+			SearchSpace:       make(map[string]SearchDistribution),
+			SamplingAlgorithm: SamplingAlgorithmRandom,
+			Trial:             trial,
+		},
+	}
+}
+
+func (b *SweepJobBuilder) WithDisplayName(displayName string) *SweepJobBuilder {
+	b.job.DisplayName = displayName
+	return b
+}
+
+func (b *SweepJobBuilder) WithExperimentName(experimentName string) *SweepJobBuilder {
+	b.job.ExperimentName = experimentName
+	return b
+}
+
+// WithCompute Set the ARM resource ID of the compute on which the trials run
+func (b *SweepJobBuilder) WithCompute(computeId string) *SweepJobBuilder {
+	b.job.ComputeId = computeId
+	return b
+}
+
+// WithHyperparameter Add to the search space the hyperparameter provided as argument, which can be referenced by
+// the trial command as ${{search_space.<name>}}
+func (b *SweepJobBuilder) WithHyperparameter(name string, distribution SearchDistribution) *SweepJobBuilder {
+	b.sweep.SearchSpace[name] = distribution
+	return b
+}
+
+func (b *SweepJobBuilder) WithSamplingAlgorithm(samplingAlgorithm SamplingAlgorithm) *SweepJobBuilder {
+	b.sweep.SamplingAlgorithm = samplingAlgorithm
+	return b
+}
+
+func (b *SweepJobBuilder) WithObjective(primaryMetric string, goal Goal) *SweepJobBuilder {
+	b.sweep.Objective = Objective{PrimaryMetric: primaryMetric, Goal: goal}
+	return b
+}
+
+func (b *SweepJobBuilder) WithEarlyTermination(policy EarlyTerminationPolicy) *SweepJobBuilder {
+	b.sweep.EarlyTermination = &policy
+	return b
+}
+
+func (b *SweepJobBuilder) WithLimits(limits SweepLimits) *SweepJobBuilder {
+	b.sweep.Limits = limits
+	return b
+}
+
+// Build Validate and return the sweep job, ready to be submitted with CreateOrUpdateJob
+func (b *SweepJobBuilder) Build() (*Job, error) {
+	sweep := b.sweep
+	sweep.SearchSpace = make(map[string]SearchDistribution, len(b.sweep.SearchSpace))
+	for name, distribution := range b.sweep.SearchSpace {
+		sweep.SearchSpace[name] = distribution
+	}
+	job := b.job
+	job.Sweep = &sweep
+
+	if err := validateJob(&job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func validateSweepJob(sweep *SweepJob) error {
+	if sweep.Trial == nil {
+		return InvalidArgumentError{"the trial of the sweep job cannot be empty"}
+	}
+	if err := validateCommandJob(sweep.Trial); err != nil {
+		return err
+	}
+	if err := validateSearchSpace(sweep.SearchSpace, sweep.SamplingAlgorithm); err != nil {
+		return err
+	}
+	for _, placeholder := range commandPlaceholders(sweep.Trial.Command) {
+		if strings.HasPrefix(placeholder, searchSpacePlaceholderPrefix) {
+			if _, ok := sweep.SearchSpace[strings.TrimPrefix(placeholder, searchSpacePlaceholderPrefix)]; !ok {
+				return InvalidArgumentError{fmt.Sprintf("the trial command references the undefined hyperparameter %q", placeholder)}
+			}
+		}
+	}
+
+	if strings.TrimSpace(sweep.Objective.PrimaryMetric) == "" {
+		return InvalidArgumentError{"the primary metric of the sweep job cannot be empty"}
+	}
+	if sweep.Objective.Goal != GoalMinimize && sweep.Objective.Goal != GoalMaximize {
+		return InvalidArgumentError{fmt.Sprintf("invalid objective goal %q", sweep.Objective.Goal)}
+	}
+
+	if policy := sweep.EarlyTermination; policy != nil {
+		if sweep.SamplingAlgorithm == SamplingAlgorithmBayesian {
+			return InvalidArgumentError{"the bayesian sampling does not support early termination policies"}
+		}
+		if err := validateEarlyTerminationPolicy(policy); err != nil {
+			return err
+		}
+	}
+
+	limits := sweep.Limits
+	if limits.MaxTotalTrials < 0 || limits.MaxConcurrentTrials < 0 || limits.Timeout < 0 {
+		return InvalidArgumentError{"the limits of the sweep job cannot be negative"}
+	}
+	if limits.MaxTotalTrials > 0 && limits.MaxConcurrentTrials > limits.MaxTotalTrials {
+		return InvalidArgumentError{fmt.Sprintf(
+			"the max concurrent trials (%d) cannot exceed the max total trials (%d)", limits.MaxConcurrentTrials, limits.MaxTotalTrials,
+		)}
+	}
+	return nil
+}
+
+// validateSearchSpace Validate the distributions of the search space, checking that they are supported by the
+// sampling algorithm provided as argument
+func validateSearchSpace(searchSpace map[string]SearchDistribution, samplingAlgorithm SamplingAlgorithm) error {
+	if len(searchSpace) == 0 {
+		return InvalidArgumentError{"the search space of the sweep job cannot be empty"}
+	}
+	for name, distribution := range searchSpace {
+		if distribution == nil {
+			return InvalidArgumentError{fmt.Sprintf("the distribution of hyperparameter %s cannot be empty", name)}
+		}
+		if err := distribution.validate(); err != nil {
+			message := err.Error()
+			var invalidArgument InvalidArgumentError
+			if errors.As(err, &invalidArgument) {
+				message = invalidArgument.message
+			}
+			return InvalidArgumentError{fmt.Sprintf("invalid hyperparameter %s: %s", name, message)}
+		}
+
+		switch samplingAlgorithm {
+		case SamplingAlgorithmRandom:
+		case SamplingAlgorithmGrid:
+			if _, ok := distribution.(ChoiceDistribution); !ok {
+				return InvalidArgumentError{fmt.Sprintf("the grid sampling only supports choice distributions, hyperparameter %s is not", name)}
+			}
+		case SamplingAlgorithmBayesian:
+			switch distribution.(type) {
+			case ChoiceDistribution, UniformDistribution:
+			default:
+				return InvalidArgumentError{fmt.Sprintf("the bayesian sampling only supports choice and uniform distributions, hyperparameter %s is not", name)}
+			}
+		default:
+			return InvalidArgumentError{fmt.Sprintf("invalid sampling algorithm %q", samplingAlgorithm)}
+		}
+	}
+	return nil
+}
+
+func validateEarlyTerminationPolicy(policy *EarlyTerminationPolicy) error {
+	if policy.EvaluationInterval < 0 || policy.DelayEvaluation < 0 {
+		return InvalidArgumentError{"the evaluation interval and delay of the early termination policy cannot be negative"}
+	}
+	switch policy.PolicyType {
+	case EarlyTerminationPolicyTypeBandit:
+		if (policy.SlackFactor > 0) == (policy.SlackAmount > 0) {
+			return InvalidArgumentError{"a bandit policy must have either a positive slack factor or a positive slack amount"}
+		}
+	case EarlyTerminationPolicyTypeMedianStopping:
+	case EarlyTerminationPolicyTypeTruncation:
+		if policy.TruncationPercentage < 1 || policy.TruncationPercentage > 99 {
+			return InvalidArgumentError{fmt.Sprintf("the truncation percentage must be between 1 and 99, got %d", policy.TruncationPercentage)}
+		}
+	default:
+		return InvalidArgumentError{fmt.Sprintf("invalid early termination policy type %q", policy.PolicyType)}
+	}
+	return nil
+}
+
+// commandPlaceholders Return the references contained in the placeholders of the command, e.g. inputs.data
+func commandPlaceholders(command string) []string {
+	matches := placeholderRegex.FindAllStringSubmatch(command, -1)
+	result := make([]string, len(matches))
+	for i, match := range matches {
+		result[i] = match[1]
+	}
+	return result
+}
@@ -0,0 +1,205 @@
+package workspace
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func getMockedSweepJobBuilder() *SweepJobBuilder {
+	trial := &CommandJob{
+		Command:       "python train.py --data ${{inputs.data}} --lr ${{search_space.lr}} --batch-size ${{ search_space.batch_size }}",
+		EnvironmentId: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/environments/env/versions/1",
+		Inputs:        map[string]JobInput{"data": {InputType: JobInputTypeUriFolder, Uri: "azureml:data:1"}},
+		Timeout:       20 * time.Minute,
+	}
+	return NewSweepJobBuilder("sweep-1", trial).
+		WithCompute("cluster-id").
+		WithHyperparameter("lr", LogUniform(-6, -1)).
+		WithHyperparameter("batch_size", Choice(16, 32, 64)).
+		WithObjective("accuracy", GoalMaximize).
+		WithEarlyTermination(EarlyTerminationPolicy{PolicyType: EarlyTerminationPolicyTypeBandit, SlackFactor: 0.1, EvaluationInterval: 2}).
+		WithLimits(SweepLimits{MaxTotalTrials: 20, MaxConcurrentTrials: 4, Timeout: 2 * time.Hour})
+}
+
+func TestSweepJobBuilder_Build(t *testing.T) {
+	a := assert.New(t)
+
+	job, err := getMockedSweepJobBuilder().Build()
+	a.Nil(err)
+	a.Equal("sweep-1", job.Name)
+	a.Equal(JobTypeSweep, job.JobType)
+	a.Equal("cluster-id", job.ComputeId)
+	a.Equal(SamplingAlgorithmRandom, job.Sweep.SamplingAlgorithm)
+	a.Equal(LogUniform(-6, -1), job.Sweep.SearchSpace["lr"])
+	a.Equal(Objective{PrimaryMetric: "accuracy", Goal: GoalMaximize}, job.Sweep.Objective)
+
+	// Jobs built previously are not affected by further changes to the builder
+	builder := getMockedSweepJobBuilder()
+	first, _ := builder.Build()
+	builder.WithHyperparameter("dropout", Uniform(0.1, 0.5))
+	a.Len(first.Sweep.SearchSpace, 2)
+}
+
+func TestSweepJobBuilder_BuildInvalid(t *testing.T) {
+	a := assert.New(t)
+
+	invalidBuilders := []func(b *SweepJobBuilder){
+		func(b *SweepJobBuilder) { b.sweep.SearchSpace = map[string]SearchDistribution{} },
+		func(b *SweepJobBuilder) { b.WithHyperparameter("lr", Uniform(1, 0)) },
+		func(b *SweepJobBuilder) { b.WithHyperparameter("lr", LogUniform(-1, -1)) },
+		func(b *SweepJobBuilder) { b.WithHyperparameter("lr", Normal(0, 0)) },
+		func(b *SweepJobBuilder) { b.WithHyperparameter("lr", RandInt(0)) },
+		func(b *SweepJobBuilder) { b.WithHyperparameter("lr", Choice()) },
+		func(b *SweepJobBuilder) { b.WithHyperparameter("lr", nil) },
+		func(b *SweepJobBuilder) { b.WithSamplingAlgorithm("foo") },
+		func(b *SweepJobBuilder) { b.WithSamplingAlgorithm(SamplingAlgorithmGrid) },
+		func(b *SweepJobBuilder) {
+			b.WithHyperparameter("lr", Uniform(0.01, 0.1)).WithHyperparameter("seed", RandInt(10))
+			b.sweep.EarlyTermination = nil
+			b.WithSamplingAlgorithm(SamplingAlgorithmBayesian)
+		},
+		func(b *SweepJobBuilder) {
+			b.WithHyperparameter("lr", Uniform(0.01, 0.1)).WithSamplingAlgorithm(SamplingAlgorithmBayesian)
+		},
+		func(b *SweepJobBuilder) { delete(b.sweep.SearchSpace, "batch_size") },
+		func(b *SweepJobBuilder) { b.WithObjective("", GoalMaximize) },
+		func(b *SweepJobBuilder) { b.WithObjective("accuracy", "foo") },
+		func(b *SweepJobBuilder) {
+			b.WithEarlyTermination(EarlyTerminationPolicy{PolicyType: EarlyTerminationPolicyTypeBandit})
+		},
+		func(b *SweepJobBuilder) {
+			b.WithEarlyTermination(EarlyTerminationPolicy{PolicyType: EarlyTerminationPolicyTypeBandit, SlackFactor: 0.1, SlackAmount: 0.2})
+		},
+		func(b *SweepJobBuilder) {
+			b.WithEarlyTermination(EarlyTerminationPolicy{PolicyType: EarlyTerminationPolicyTypeTruncation, TruncationPercentage: 100})
+		},
+		func(b *SweepJobBuilder) {
+			b.WithEarlyTermination(EarlyTerminationPolicy{PolicyType: EarlyTerminationPolicyTypeMedianStopping, DelayEvaluation: -1})
+		},
+		func(b *SweepJobBuilder) { b.WithEarlyTermination(EarlyTerminationPolicy{PolicyType: "foo"}) },
+		func(b *SweepJobBuilder) { b.WithLimits(SweepLimits{MaxTotalTrials: 2, MaxConcurrentTrials: 4}) },
+		func(b *SweepJobBuilder) { b.WithLimits(SweepLimits{Timeout: -time.Second}) },
+		func(b *SweepJobBuilder) { b.sweep.Trial = nil },
+		func(b *SweepJobBuilder) { b.sweep.Trial.EnvironmentId = "" },
+	}
+	for i, invalidate := range invalidBuilders {
+		builder := getMockedSweepJobBuilder()
+		invalidate(builder)
+		job, err := builder.Build()
+		a.Nil(job, i)
+		a.IsType(InvalidArgumentError{}, err, i)
+	}
+
+	valid := getMockedSweepJobBuilder().
+		WithHyperparameter("lr", Uniform(0.01, 0.1)).
+		WithSamplingAlgorithm(SamplingAlgorithmBayesian)
+	valid.sweep.EarlyTermination = nil
+	_, err := valid.Build()
+	a.Nil(err)
+
+	_, err = getMockedSweepJobBuilder().
+		WithHyperparameter("lr", Choice(0.01, 0.1)).
+		WithSamplingAlgorithm(SamplingAlgorithmGrid).
+		Build()
+	a.Nil(err)
+
+	_, err = getMockedSweepJobBuilder().WithHyperparameter("lr", Uniform(0.1, 0.01)).Build()
+	a.Equal("Invalid argument: invalid hyperparameter lr: invalid uniform distribution range [0.1, 0.01]", err.Error())
+}
+
+func TestToWriteSweepJobSchema(t *testing.T) {
+	a := assert.New(t)
+
+	job, _ := getMockedSweepJobBuilder().
+		WithHyperparameter("momentum", Normal(0.9, 0.05)).
+		WithHyperparameter("seed", RandInt(100)).
+		Build()
+	schema, err := json.Marshal(toWriteJobSchema(job))
+	a.Nil(err)
+
+	a.JSONEq(`{
+		"properties": {
+			"jobType": "Sweep",
+			"computeId": "cluster-id",
+			"isArchived": false,
+			"searchSpace": {
+				"lr": ["loguniform", [-6, -1]],
+				"batch_size": ["choice", [[16, 32, 64]]],
+				"momentum": ["normal", [0.9, 0.05]],
+				"seed": ["randint", [100]]
+			},
+			"samplingAlgorithm": {"samplingAlgorithmType": "Random"},
+			"objective": {"primaryMetric": "accuracy", "goal": "Maximize"},
+			"earlyTermination": {"policyType": "Bandit", "evaluationInterval": 2, "slackFactor": 0.1},
+			"limits": {
+				"jobLimitsType": "Sweep",
+				"maxTotalTrials": 20,
+				"maxConcurrentTrials": 4,
+				"timeout": "PT7200S",
+				"trialTimeout": "PT1200S"
+			},
+			"trial": {
+				"command": "python train.py --data ${{inputs.data}} --lr ${{search_space.lr}} --batch-size ${{ search_space.batch_size }}",
+				"environmentId": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.MachineLearningServices/workspaces/ws/environments/env/versions/1"
+			},
+			"inputs": {"data": {"jobInputType": "uri_folder", "uri": "azureml:data:1"}}
+		}
+	}`, string(schema))
+}
+
+func TestUnmarshalSweepJob(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+	converter := &JobConverter{l.Sugar()}
+
+	job := converter.unmarshalJob(loadExampleResp("example_resp_get_sweep_job.json"))
+	a.Equal(JobTypeSweep, job.JobType)
+	a.Nil(job.Command)
+
+	sweep := job.Sweep
+	a.Equal(map[string]SearchDistribution{
+		"lr":         LogUniform(-6, -1),
+		"batch_size": Choice(float64(16), float64(32), float64(64)),
+		"optimizer":  Choice("sgd", "adam"),
+		"dropout":    Uniform(0.1, 0.5),
+		"momentum":   Normal(0.9, 0.05),
+		"seed":       RandInt(100),
+	}, sweep.SearchSpace)
+	a.Equal(SamplingAlgorithmRandom, sweep.SamplingAlgorithm)
+	a.Equal(Objective{PrimaryMetric: "accuracy", Goal: GoalMaximize}, sweep.Objective)
+	a.Equal(&EarlyTerminationPolicy{PolicyType: EarlyTerminationPolicyTypeBandit, EvaluationInterval: 2, DelayEvaluation: 5, SlackFactor: 0.1}, sweep.EarlyTermination)
+	a.Equal(SweepLimits{MaxTotalTrials: 20, MaxConcurrentTrials: 4, Timeout: 2 * time.Hour}, sweep.Limits)
+	a.Equal("python train.py --data ${{inputs.data}} --lr ${{search_space.lr}}", sweep.Trial.Command)
+	a.Equal(20*time.Minute, sweep.Trial.Timeout)
+	a.Equal(1, sweep.Trial.Resources.InstanceCount)
+	a.Equal(JobInput{InputType: JobInputTypeUriFolder, Uri: "azureml:data:1", Mode: DataModeReadOnlyMount}, sweep.Trial.Inputs["data"])
+}
+
+func TestCommandPlaceholders(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]string{"inputs.data", "search_space.lr", "outputs.model"}, commandPlaceholders("python train.py ${{inputs.data}} ${{ search_space.lr }} ${{outputs.model}} ${inputs.foo}"))
+	a.Empty(commandPlaceholders("python train.py"))
+}
+
+func TestWorkspace_CreateOrUpdateSweepJob(t *testing.T) {
+	a := assert.New(t)
+	l, _ := zap.NewDevelopment()
+
+	job, err := getMockedSweepJobBuilder().Build()
+	a.Nil(err)
+
+	mockedHttpClient := new(MockedHttpClient)
+	mockedHttpClient.On("doPut", "jobs/sweep-1", toWriteJobSchema(job)).Return(http.StatusCreated, string(loadExampleResp("example_resp_get_sweep_job.json")), nil)
+
+	ws := newWorkspace(MockedHttpClientBuilder{mockedHttpClient}, l)
+	result, err := ws.CreateOrUpdateJob("rg", "ws", job)
+	a.Nil(err)
+	a.Equal("sweep-1", result.Name)
+	a.Equal(JobTypeSweep, result.JobType)
+	mockedHttpClient.AssertExpectations(t)
+}
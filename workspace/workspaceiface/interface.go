@@ -1,38 +1,1197 @@
 package workspaceiface
 
-import "github.com/orobix/azureml-go-sdk/workspace"
+import (
+	"context"
+	"github.com/orobix/azureml-go-sdk/workspace"
+	"time"
+)
 
 type WorkspaceAPI interface {
 	// GetDatastores Return the list of datastore of the AML Workspace provided as argument.
 	GetDatastores(resourceGroup, workspace string) ([]workspace.Datastore, error)
 
+	// GetDatastoresWithContext Same as GetDatastores, using the provided context for the underlying requests.
+	GetDatastoresWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.Datastore, error)
+
+	// NewDatastorePager Return a pager for iterating page by page over the datastores of the AML Workspace.
+	NewDatastorePager(resourceGroup, workspace string) *workspace.DatastorePager
+
 	// GetDatastore Return the datastore with the name provided as argument.
 	GetDatastore(resourceGroup, workspace, datastoreName string) (*workspace.Datastore, error)
 
-	// DeleteDatastore Delete the datastore with the name provided as argument
+	// GetDatastoreWithContext Same as GetDatastore, using the provided context for the underlying requests.
+	GetDatastoreWithContext(ctx context.Context, resourceGroup, workspace, datastoreName string) (*workspace.Datastore, error)
+
+	// DeleteDatastore Delete the datastore with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
 	DeleteDatastore(resourceGroup, workspace, datastoreName string) error
 
-	// CreateOrUpdateDatastore Create or update the datastore with the data provided as argument
+	// DeleteDatastoreWithContext Same as DeleteDatastore, using the provided context for the underlying requests.
+	DeleteDatastoreWithContext(ctx context.Context, resourceGroup, workspace, datastoreName string) error
+
+	// CreateOrUpdateDatastore Create or update the datastore with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
 	CreateOrUpdateDatastore(resourceGroup, workspace string, datastore *workspace.Datastore) (*workspace.Datastore, error)
 
+	// CreateOrUpdateDatastoreWithContext Same as CreateOrUpdateDatastore, using the provided context for the underlying requests.
+	CreateOrUpdateDatastoreWithContext(ctx context.Context, resourceGroup, workspace string, datastore *workspace.Datastore) (*workspace.Datastore, error)
+
 	// GetDatasets Return the list of datasets of the AML Workspace. For each dataset, only its latest version is returned.
 	GetDatasets(resourceGroup, workspace string) ([]workspace.Dataset, error)
 
+	// GetDatasetsWithContext Same as GetDatasets, using the provided context for the underlying requests.
+	GetDatasetsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.Dataset, error)
+
+	// NewDatasetPager Return a pager for iterating page by page over the datasets of the AML Workspace.
+	// For each dataset, only its latest version is returned.
+	NewDatasetPager(resourceGroup, workspace string) *workspace.DatasetPager
+
 	// GetDataset Return the dataset with the name and version provided as argument
 	GetDataset(resourceGroup, workspace, name string, version int) (*workspace.Dataset, error)
 
+	// GetDatasetWithContext Same as GetDataset, using the provided context for the underlying requests.
+	GetDatasetWithContext(ctx context.Context, resourceGroup, workspace, name string, version int) (*workspace.Dataset, error)
+
 	// GetDatasetNextVersion Return the next version of the dataset with the name provided as argument
 	GetDatasetNextVersion(resourceGroup, workspace, name string) (int, error)
 
+	// GetDatasetNextVersionWithContext Same as GetDatasetNextVersion, using the provided context for the underlying requests.
+	GetDatasetNextVersionWithContext(ctx context.Context, resourceGroup, workspace, name string) (int, error)
+
 	// GetDatasetVersions Return all the versions of the dataset with the name provided as argument
 	GetDatasetVersions(resourceGroup, workspace, datasetName string) ([]workspace.Dataset, error)
 
-	// CreateOrUpdateDataset Create or update the dataset with the data provided as argument
+	// GetDatasetVersionsWithContext Same as GetDatasetVersions, using the provided context for the underlying requests.
+	GetDatasetVersionsWithContext(ctx context.Context, resourceGroup, workspace, datasetName string) ([]workspace.Dataset, error)
+
+	// NewDatasetVersionPager Return a pager for iterating page by page over the versions of the dataset with the
+	// name provided as argument
+	NewDatasetVersionPager(resourceGroup, workspace, datasetName string) *workspace.DatasetVersionPager
+
+	// CreateOrUpdateDataset Create or update the dataset with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
 	CreateOrUpdateDataset(resourceGroup, workspace string, dataset *workspace.Dataset) (*workspace.Dataset, error)
 
-	// DeleteDataset Delete the dataset (all its versions) with the name provided as argument
+	// CreateOrUpdateDatasetWithContext Same as CreateOrUpdateDataset, using the provided context for the underlying requests.
+	CreateOrUpdateDatasetWithContext(ctx context.Context, resourceGroup, workspace string, dataset *workspace.Dataset) (*workspace.Dataset, error)
+
+	// DeleteDataset Delete the dataset (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
 	DeleteDataset(resourceGroup, workspace, datasetName string) error
 
-	// DeleteDatasetVersion Delete the version provided as argument of the dataset with the specified name
+	// DeleteDatasetWithContext Same as DeleteDataset, using the provided context for the underlying requests.
+	DeleteDatasetWithContext(ctx context.Context, resourceGroup, workspace, datasetName string) error
+
+	// DeleteDatasetVersion Delete the version provided as argument of the dataset with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
 	DeleteDatasetVersion(resourceGroup, workspace, datasetName string, version int) error
+
+	// DeleteDatasetVersionWithContext Same as DeleteDatasetVersion, using the provided context for the underlying requests.
+	DeleteDatasetVersionWithContext(ctx context.Context, resourceGroup, workspace, datasetName string, version int) error
+
+	// GetDataAssets Return the list of data assets of the AML Workspace. For each data asset, only its latest version is returned.
+	GetDataAssets(resourceGroup, workspace string) ([]workspace.DataAsset, error)
+
+	// GetDataAssetsWithContext Same as GetDataAssets, using the provided context for the underlying requests.
+	GetDataAssetsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.DataAsset, error)
+
+	// NewDataAssetPager Return a pager for iterating page by page over the data assets of the AML Workspace.
+	// For each data asset, only its latest version is returned.
+	NewDataAssetPager(resourceGroup, workspace string) *workspace.DataAssetPager
+
+	// GetDataAssetVersions Return all the versions of the data asset with the name provided as argument
+	GetDataAssetVersions(resourceGroup, workspace, name string) ([]workspace.DataAsset, error)
+
+	// GetDataAssetVersionsWithContext Same as GetDataAssetVersions, using the provided context for the underlying requests.
+	GetDataAssetVersionsWithContext(ctx context.Context, resourceGroup, workspace, name string) ([]workspace.DataAsset, error)
+
+	// NewDataAssetVersionPager Return a pager for iterating page by page over the versions of the data asset with the
+	// name provided as argument
+	NewDataAssetVersionPager(resourceGroup, workspace, name string) *workspace.DataAssetVersionPager
+
+	// GetDataAsset Return the data asset with the name and version provided as argument
+	GetDataAsset(resourceGroup, workspace, name, version string) (*workspace.DataAsset, error)
+
+	// GetDataAssetWithContext Same as GetDataAsset, using the provided context for the underlying requests.
+	GetDataAssetWithContext(ctx context.Context, resourceGroup, workspace, name, version string) (*workspace.DataAsset, error)
+
+	// CreateOrUpdateDataAsset Create or update the data asset version with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateDataAsset(resourceGroup, workspace string, dataAsset *workspace.DataAsset) (*workspace.DataAsset, error)
+
+	// CreateOrUpdateDataAssetWithContext Same as CreateOrUpdateDataAsset, using the provided context for the underlying requests.
+	CreateOrUpdateDataAssetWithContext(ctx context.Context, resourceGroup, workspace string, dataAsset *workspace.DataAsset) (*workspace.DataAsset, error)
+
+	// DeleteDataAsset Delete the data asset (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDataAsset(resourceGroup, workspace, name string) error
+
+	// DeleteDataAssetWithContext Same as DeleteDataAsset, using the provided context for the underlying requests.
+	DeleteDataAssetWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// DeleteDataAssetVersion Delete the version provided as argument of the data asset with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDataAssetVersion(resourceGroup, workspace, name, version string) error
+
+	// DeleteDataAssetVersionWithContext Same as DeleteDataAssetVersion, using the provided context for the underlying requests.
+	DeleteDataAssetVersionWithContext(ctx context.Context, resourceGroup, workspace, name, version string) error
+
+	// GetModels Return the list of registered models of the AML Workspace.
+	GetModels(resourceGroup, workspace string) ([]workspace.Model, error)
+
+	// GetModelsWithContext Same as GetModels, using the provided context for the underlying requests.
+	GetModelsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.Model, error)
+
+	// NewModelPager Return a pager for iterating page by page over the registered models of the AML Workspace.
+	NewModelPager(resourceGroup, workspace string) *workspace.ModelPager
+
+	// GetModel Return the registered model with the name provided as argument
+	GetModel(resourceGroup, workspace, name string) (*workspace.Model, error)
+
+	// GetModelWithContext Same as GetModel, using the provided context for the underlying requests.
+	GetModelWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Model, error)
+
+	// CreateOrUpdateModel Create or update the registered model with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateModel(resourceGroup, workspace string, model *workspace.Model) (*workspace.Model, error)
+
+	// CreateOrUpdateModelWithContext Same as CreateOrUpdateModel, using the provided context for the underlying requests.
+	CreateOrUpdateModelWithContext(ctx context.Context, resourceGroup, workspace string, model *workspace.Model) (*workspace.Model, error)
+
+	// ArchiveModel Archive the registered model with the name provided as argument
+	ArchiveModel(resourceGroup, workspace, name string) (*workspace.Model, error)
+
+	// ArchiveModelWithContext Same as ArchiveModel, using the provided context for the underlying requests.
+	ArchiveModelWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Model, error)
+
+	// DeleteModel Delete the registered model (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteModel(resourceGroup, workspace, name string) error
+
+	// DeleteModelWithContext Same as DeleteModel, using the provided context for the underlying requests.
+	DeleteModelWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// GetModelVersions Return all the versions of the model with the name provided as argument
+	GetModelVersions(resourceGroup, workspace, modelName string) ([]workspace.ModelVersion, error)
+
+	// GetModelVersionsWithContext Same as GetModelVersions, using the provided context for the underlying requests.
+	GetModelVersionsWithContext(ctx context.Context, resourceGroup, workspace, modelName string) ([]workspace.ModelVersion, error)
+
+	// NewModelVersionPager Return a pager for iterating page by page over the versions of the model with the
+	// name provided as argument
+	NewModelVersionPager(resourceGroup, workspace, modelName string) *workspace.ModelVersionPager
+
+	// GetModelVersion Return the model version with the name and version provided as argument
+	GetModelVersion(resourceGroup, workspace, modelName, version string) (*workspace.ModelVersion, error)
+
+	// GetModelVersionWithContext Same as GetModelVersion, using the provided context for the underlying requests.
+	GetModelVersionWithContext(ctx context.Context, resourceGroup, workspace, modelName, version string) (*workspace.ModelVersion, error)
+
+	// CreateOrUpdateModelVersion Create or update the model version with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateModelVersion(resourceGroup, workspace string, modelVersion *workspace.ModelVersion) (*workspace.ModelVersion, error)
+
+	// CreateOrUpdateModelVersionWithContext Same as CreateOrUpdateModelVersion, using the provided context for the underlying requests.
+	CreateOrUpdateModelVersionWithContext(ctx context.Context, resourceGroup, workspace string, modelVersion *workspace.ModelVersion) (*workspace.ModelVersion, error)
+
+	// ArchiveModelVersion Archive the version provided as argument of the model with the specified name
+	ArchiveModelVersion(resourceGroup, workspace, modelName, version string) (*workspace.ModelVersion, error)
+
+	// ArchiveModelVersionWithContext Same as ArchiveModelVersion, using the provided context for the underlying requests.
+	ArchiveModelVersionWithContext(ctx context.Context, resourceGroup, workspace, modelName, version string) (*workspace.ModelVersion, error)
+
+	// DeleteModelVersion Delete the version provided as argument of the model with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteModelVersion(resourceGroup, workspace, modelName, version string) error
+
+	// DeleteModelVersionWithContext Same as DeleteModelVersion, using the provided context for the underlying requests.
+	DeleteModelVersionWithContext(ctx context.Context, resourceGroup, workspace, modelName, version string) error
+
+	// GetEnvironments Return the list of environments of the AML Workspace.
+	GetEnvironments(resourceGroup, workspace string) ([]workspace.Environment, error)
+
+	// GetEnvironmentsWithContext Same as GetEnvironments, using the provided context for the underlying requests.
+	GetEnvironmentsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.Environment, error)
+
+	// NewEnvironmentPager Return a pager for iterating page by page over the environments of the AML Workspace.
+	NewEnvironmentPager(resourceGroup, workspace string) *workspace.EnvironmentPager
+
+	// GetEnvironment Return the environment with the name provided as argument
+	GetEnvironment(resourceGroup, workspace, name string) (*workspace.Environment, error)
+
+	// GetEnvironmentWithContext Same as GetEnvironment, using the provided context for the underlying requests.
+	GetEnvironmentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Environment, error)
+
+	// CreateOrUpdateEnvironment Create or update the environment with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateEnvironment(resourceGroup, workspace string, environment *workspace.Environment) (*workspace.Environment, error)
+
+	// CreateOrUpdateEnvironmentWithContext Same as CreateOrUpdateEnvironment, using the provided context for the underlying requests.
+	CreateOrUpdateEnvironmentWithContext(ctx context.Context, resourceGroup, workspace string, environment *workspace.Environment) (*workspace.Environment, error)
+
+	// ArchiveEnvironment Archive the environment with the name provided as argument
+	ArchiveEnvironment(resourceGroup, workspace, name string) (*workspace.Environment, error)
+
+	// ArchiveEnvironmentWithContext Same as ArchiveEnvironment, using the provided context for the underlying requests.
+	ArchiveEnvironmentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Environment, error)
+
+	// GetEnvironmentVersions Return all the versions of the environment with the name provided as argument
+	GetEnvironmentVersions(resourceGroup, workspace, environmentName string) ([]workspace.EnvironmentVersion, error)
+
+	// GetEnvironmentVersionsWithContext Same as GetEnvironmentVersions, using the provided context for the underlying requests.
+	GetEnvironmentVersionsWithContext(ctx context.Context, resourceGroup, workspace, environmentName string) ([]workspace.EnvironmentVersion, error)
+
+	// NewEnvironmentVersionPager Return a pager for iterating page by page over the versions of the environment with the
+	// name provided as argument
+	NewEnvironmentVersionPager(resourceGroup, workspace, environmentName string) *workspace.EnvironmentVersionPager
+
+	// GetEnvironmentVersion Return the environment version with the name and version provided as argument
+	GetEnvironmentVersion(resourceGroup, workspace, environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// GetEnvironmentVersionWithContext Same as GetEnvironmentVersion, using the provided context for the underlying requests.
+	GetEnvironmentVersionWithContext(ctx context.Context, resourceGroup, workspace, environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// CreateOrUpdateEnvironmentVersion Create or update the environment version with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateEnvironmentVersion(resourceGroup, workspace string, environmentVersion *workspace.EnvironmentVersion) (*workspace.EnvironmentVersion, error)
+
+	// CreateOrUpdateEnvironmentVersionWithContext Same as CreateOrUpdateEnvironmentVersion, using the provided context for the underlying requests.
+	CreateOrUpdateEnvironmentVersionWithContext(ctx context.Context, resourceGroup, workspace string, environmentVersion *workspace.EnvironmentVersion) (*workspace.EnvironmentVersion, error)
+
+	// ArchiveEnvironmentVersion Archive the version provided as argument of the environment with the specified name
+	ArchiveEnvironmentVersion(resourceGroup, workspace, environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// ArchiveEnvironmentVersionWithContext Same as ArchiveEnvironmentVersion, using the provided context for the underlying requests.
+	ArchiveEnvironmentVersionWithContext(ctx context.Context, resourceGroup, workspace, environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// GetComputes Return the list of computes of the AML Workspace.
+	GetComputes(resourceGroup, workspace string) ([]workspace.Compute, error)
+
+	// GetComputesWithContext Same as GetComputes, using the provided context for the underlying requests.
+	GetComputesWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.Compute, error)
+
+	// NewComputePager Return a pager for iterating page by page over the computes of the AML Workspace.
+	NewComputePager(resourceGroup, workspace string) *workspace.ComputePager
+
+	// GetCompute Return the compute with the name provided as argument
+	GetCompute(resourceGroup, workspace, name string) (*workspace.Compute, error)
+
+	// GetComputeWithContext Same as GetCompute, using the provided context for the underlying requests.
+	GetComputeWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Compute, error)
+
+	// CreateOrUpdateCompute Create or update the compute with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateCompute(resourceGroup, workspace string, compute *workspace.Compute) (*workspace.Compute, error)
+
+	// CreateOrUpdateComputeWithContext Same as CreateOrUpdateCompute, using the provided context for the underlying requests.
+	CreateOrUpdateComputeWithContext(ctx context.Context, resourceGroup, workspace string, compute *workspace.Compute) (*workspace.Compute, error)
+
+	// DeleteCompute Delete or detach the compute with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteCompute(resourceGroup, workspace, name string, action workspace.UnderlyingResourceAction) error
+
+	// DeleteComputeWithContext Same as DeleteCompute, using the provided context for the underlying requests.
+	DeleteComputeWithContext(ctx context.Context, resourceGroup, workspace, name string, action workspace.UnderlyingResourceAction) error
+
+	// StartComputeInstance Start the compute instance with the name provided as argument
+	StartComputeInstance(resourceGroup, workspace, name string) error
+
+	// StartComputeInstanceWithContext Same as StartComputeInstance, using the provided context for the underlying requests.
+	StartComputeInstanceWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// StopComputeInstance Stop the compute instance with the name provided as argument
+	StopComputeInstance(resourceGroup, workspace, name string) error
+
+	// StopComputeInstanceWithContext Same as StopComputeInstance, using the provided context for the underlying requests.
+	StopComputeInstanceWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// RestartComputeInstance Restart the compute instance with the name provided as argument
+	RestartComputeInstance(resourceGroup, workspace, name string) error
+
+	// RestartComputeInstanceWithContext Same as RestartComputeInstance, using the provided context for the underlying requests.
+	RestartComputeInstanceWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// ListComputeNodes Return the nodes of the AmlCompute cluster with the name provided as argument
+	ListComputeNodes(resourceGroup, workspace, name string) ([]workspace.ComputeNode, error)
+
+	// ListComputeNodesWithContext Same as ListComputeNodes, using the provided context for the underlying requests.
+	ListComputeNodesWithContext(ctx context.Context, resourceGroup, workspace, name string) ([]workspace.ComputeNode, error)
+	// GetJobs Return the list of jobs of the AML Workspace matching the filter provided as argument.
+	GetJobs(resourceGroup, workspace string, filter workspace.JobFilter) ([]workspace.Job, error)
+
+	// GetJobsWithContext Same as GetJobs, using the provided context for the underlying requests.
+	GetJobsWithContext(ctx context.Context, resourceGroup, workspace string, filter workspace.JobFilter) ([]workspace.Job, error)
+
+	// NewJobPager Return a pager for iterating page by page over the jobs of the AML Workspace.
+	NewJobPager(resourceGroup, workspace string, filter workspace.JobFilter) *workspace.JobPager
+
+	// GetJob Return the job with the name provided as argument
+	GetJob(resourceGroup, workspace, name string) (*workspace.Job, error)
+
+	// GetJobWithContext Same as GetJob, using the provided context for the underlying requests.
+	GetJobWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Job, error)
+
+	// CreateOrUpdateJob Submit the job provided as argument, or update it if it already exists. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateJob(resourceGroup, workspace string, job *workspace.Job) (*workspace.Job, error)
+
+	// CreateOrUpdateJobWithContext Same as CreateOrUpdateJob, using the provided context for the underlying requests.
+	CreateOrUpdateJobWithContext(ctx context.Context, resourceGroup, workspace string, job *workspace.Job) (*workspace.Job, error)
+
+	// CancelJob Request the cancellation of the job with the name provided as argument
+	CancelJob(resourceGroup, workspace, name string) error
+
+	// CancelJobWithContext Same as CancelJob, using the provided context for the underlying requests.
+	CancelJobWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// WaitForJob Wait until the job with the name provided as argument reaches a terminal status
+	WaitForJob(resourceGroup, workspace, name string, pollInterval time.Duration) (*workspace.Job, error)
+
+	// WaitForJobWithContext Same as WaitForJob, using the provided context for the underlying requests.
+	WaitForJobWithContext(ctx context.Context, resourceGroup, workspace, name string, pollInterval time.Duration) (*workspace.Job, error)
+
+	// WatchJob Poll the job with the name provided as argument until it reaches a terminal status, calling
+	// onStatusChange every time its status changes. Give up if the job stays unresponsive for longer than
+	// options.UnresponsiveTimeout.
+	WatchJob(resourceGroup, workspace, name string, options workspace.WatchJobOptions, onStatusChange func(change workspace.JobStatusChange)) (*workspace.Job, error)
+
+	// WatchJobWithContext Same as WatchJob, using the provided context for the underlying requests.
+	WatchJobWithContext(ctx context.Context, resourceGroup, workspace, name string, options workspace.WatchJobOptions, onStatusChange func(change workspace.JobStatusChange)) (*workspace.Job, error)
+	// GetComponents Return all the components of the AML Workspace
+	GetComponents(resourceGroup, workspace string) ([]workspace.Component, error)
+
+	// GetComponentsWithContext Same as GetComponents, using the provided context for the underlying requests.
+	GetComponentsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.Component, error)
+
+	// NewComponentPager Return a pager for iterating page by page over the components of the AML Workspace.
+	NewComponentPager(resourceGroup, workspace string) *workspace.ComponentPager
+
+	// GetComponent Return the component with the name provided as argument
+	GetComponent(resourceGroup, workspace, name string) (*workspace.Component, error)
+
+	// GetComponentWithContext Same as GetComponent, using the provided context for the underlying requests.
+	GetComponentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Component, error)
+
+	// CreateOrUpdateComponent Create or update the component with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateComponent(resourceGroup, workspace string, component *workspace.Component) (*workspace.Component, error)
+
+	// CreateOrUpdateComponentWithContext Same as CreateOrUpdateComponent, using the provided context for the underlying requests.
+	CreateOrUpdateComponentWithContext(ctx context.Context, resourceGroup, workspace string, component *workspace.Component) (*workspace.Component, error)
+
+	// ArchiveComponent Archive the component with the name provided as argument
+	ArchiveComponent(resourceGroup, workspace, name string) (*workspace.Component, error)
+
+	// ArchiveComponentWithContext Same as ArchiveComponent, using the provided context for the underlying requests.
+	ArchiveComponentWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.Component, error)
+
+	// DeleteComponent Delete the component (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteComponent(resourceGroup, workspace, name string) error
+
+	// DeleteComponentWithContext Same as DeleteComponent, using the provided context for the underlying requests.
+	DeleteComponentWithContext(ctx context.Context, resourceGroup, workspace, name string) error
+
+	// GetComponentVersions Return all the versions of the component with the name provided as argument
+	GetComponentVersions(resourceGroup, workspace, componentName string) ([]workspace.ComponentVersion, error)
+
+	// GetComponentVersionsWithContext Same as GetComponentVersions, using the provided context for the underlying requests.
+	GetComponentVersionsWithContext(ctx context.Context, resourceGroup, workspace, componentName string) ([]workspace.ComponentVersion, error)
+
+	// NewComponentVersionPager Return a pager for iterating page by page over the versions of the component with the
+	// name provided as argument.
+	NewComponentVersionPager(resourceGroup, workspace, componentName string) *workspace.ComponentVersionPager
+
+	// GetComponentVersion Return the component version with the name and version provided as argument
+	GetComponentVersion(resourceGroup, workspace, componentName, version string) (*workspace.ComponentVersion, error)
+
+	// GetComponentVersionWithContext Same as GetComponentVersion, using the provided context for the underlying requests.
+	GetComponentVersionWithContext(ctx context.Context, resourceGroup, workspace, componentName, version string) (*workspace.ComponentVersion, error)
+
+	// CreateOrUpdateComponentVersion Create or update the component version with the data provided as argument, checking
+	// that every placeholder of the command references a declared input or output. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateComponentVersion(resourceGroup, workspace string, componentVersion *workspace.ComponentVersion) (*workspace.ComponentVersion, error)
+
+	// CreateOrUpdateComponentVersionWithContext Same as CreateOrUpdateComponentVersion, using the provided context for the underlying requests.
+	CreateOrUpdateComponentVersionWithContext(ctx context.Context, resourceGroup, workspace string, componentVersion *workspace.ComponentVersion) (*workspace.ComponentVersion, error)
+
+	// ArchiveComponentVersion Archive the version provided as argument of the component with the specified name
+	ArchiveComponentVersion(resourceGroup, workspace, componentName, version string) (*workspace.ComponentVersion, error)
+
+	// ArchiveComponentVersionWithContext Same as ArchiveComponentVersion, using the provided context for the underlying requests.
+	ArchiveComponentVersionWithContext(ctx context.Context, resourceGroup, workspace, componentName, version string) (*workspace.ComponentVersion, error)
+
+	// DeleteComponentVersion Delete the version provided as argument of the component with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteComponentVersion(resourceGroup, workspace, componentName, version string) error
+
+	// DeleteComponentVersionWithContext Same as DeleteComponentVersion, using the provided context for the underlying requests.
+	DeleteComponentVersionWithContext(ctx context.Context, resourceGroup, workspace, componentName, version string) error
+	// GetOnlineEndpoints Return all the online endpoints of the AML Workspace
+	GetOnlineEndpoints(resourceGroup, workspace string) ([]workspace.OnlineEndpoint, error)
+
+	// GetOnlineEndpointsWithContext Same as GetOnlineEndpoints, using the provided context for the underlying requests.
+	GetOnlineEndpointsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.OnlineEndpoint, error)
+
+	// NewOnlineEndpointPager Return a pager for iterating page by page over the online endpoints of the AML Workspace.
+	NewOnlineEndpointPager(resourceGroup, workspace string) *workspace.OnlineEndpointPager
+
+	// GetOnlineEndpoint Return the online endpoint with the name provided as argument
+	GetOnlineEndpoint(resourceGroup, workspace, name string) (*workspace.OnlineEndpoint, error)
+
+	// GetOnlineEndpointWithContext Same as GetOnlineEndpoint, using the provided context for the underlying requests.
+	GetOnlineEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.OnlineEndpoint, error)
+
+	// CreateOrUpdateOnlineEndpoint Start the creation or the update of the online endpoint provided as argument
+	CreateOrUpdateOnlineEndpoint(resourceGroup, workspace string, endpoint *workspace.OnlineEndpoint) (*workspace.OnlineEndpointPoller, error)
+
+	// CreateOrUpdateOnlineEndpointWithContext Same as CreateOrUpdateOnlineEndpoint, using the provided context for the underlying requests.
+	CreateOrUpdateOnlineEndpointWithContext(ctx context.Context, resourceGroup, workspace string, endpoint *workspace.OnlineEndpoint) (*workspace.OnlineEndpointPoller, error)
+
+	// UpdateOnlineEndpointTraffic Start updating the traffic that the online endpoint routes to each deployment
+	UpdateOnlineEndpointTraffic(resourceGroup, workspace, name string, traffic map[string]int) (*workspace.OnlineEndpointPoller, error)
+
+	// UpdateOnlineEndpointTrafficWithContext Same as UpdateOnlineEndpointTraffic, using the provided context for the underlying requests.
+	UpdateOnlineEndpointTrafficWithContext(ctx context.Context, resourceGroup, workspace, name string, traffic map[string]int) (*workspace.OnlineEndpointPoller, error)
+
+	// DeleteOnlineEndpoint Start the deletion of the online endpoint with the name provided as argument
+	DeleteOnlineEndpoint(resourceGroup, workspace, name string) (*workspace.DeletionPoller, error)
+
+	// DeleteOnlineEndpointWithContext Same as DeleteOnlineEndpoint, using the provided context for the underlying requests.
+	DeleteOnlineEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.DeletionPoller, error)
+
+	// GetOnlineDeployments Return all the deployments of the online endpoint with the name provided as argument
+	GetOnlineDeployments(resourceGroup, workspace, endpointName string) ([]workspace.OnlineDeployment, error)
+
+	// GetOnlineDeploymentsWithContext Same as GetOnlineDeployments, using the provided context for the underlying requests.
+	GetOnlineDeploymentsWithContext(ctx context.Context, resourceGroup, workspace, endpointName string) ([]workspace.OnlineDeployment, error)
+
+	// NewOnlineDeploymentPager Return a pager for iterating page by page over the deployments of the online endpoint
+	// with the name provided as argument.
+	NewOnlineDeploymentPager(resourceGroup, workspace, endpointName string) *workspace.OnlineDeploymentPager
+
+	// GetOnlineDeployment Return the deployment with the name provided as argument of the specified online endpoint
+	GetOnlineDeployment(resourceGroup, workspace, endpointName, name string) (*workspace.OnlineDeployment, error)
+
+	// GetOnlineDeploymentWithContext Same as GetOnlineDeployment, using the provided context for the underlying requests.
+	GetOnlineDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*workspace.OnlineDeployment, error)
+
+	// CreateOrUpdateOnlineDeployment Start the creation or the update of the online deployment provided as argument
+	CreateOrUpdateOnlineDeployment(resourceGroup, workspace string, deployment *workspace.OnlineDeployment) (*workspace.OnlineDeploymentPoller, error)
+
+	// CreateOrUpdateOnlineDeploymentWithContext Same as CreateOrUpdateOnlineDeployment, using the provided context for the underlying requests.
+	CreateOrUpdateOnlineDeploymentWithContext(ctx context.Context, resourceGroup, workspace string, deployment *workspace.OnlineDeployment) (*workspace.OnlineDeploymentPoller, error)
+
+	// DeleteOnlineDeployment Start the deletion of the deployment with the name provided as argument of the specified online endpoint
+	DeleteOnlineDeployment(resourceGroup, workspace, endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// DeleteOnlineDeploymentWithContext Same as DeleteOnlineDeployment, using the provided context for the underlying requests.
+	DeleteOnlineDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*workspace.DeletionPoller, error)
+	// GetOnlineEndpointKeys Return the keys of the online endpoint with the name provided as argument, which must use the Key auth mode
+	GetOnlineEndpointKeys(resourceGroup, workspace, name string) (*workspace.EndpointKeys, error)
+
+	// GetOnlineEndpointKeysWithContext Same as GetOnlineEndpointKeys, using the provided context for the underlying requests.
+	GetOnlineEndpointKeysWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.EndpointKeys, error)
+
+	// GetOnlineEndpointToken Return a new token for the online endpoint with the name provided as argument, which must use the AMLToken auth mode
+	GetOnlineEndpointToken(resourceGroup, workspace, name string) (*workspace.EndpointToken, error)
+
+	// GetOnlineEndpointTokenWithContext Same as GetOnlineEndpointToken, using the provided context for the underlying requests.
+	GetOnlineEndpointTokenWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.EndpointToken, error)
+
+	// NewScoringClient Return a client for sending scoring requests to the online endpoint with the name provided as argument
+	NewScoringClient(resourceGroup, workspace, endpointName string) (*workspace.ScoringClient, error)
+
+	// NewScoringClientWithContext Same as NewScoringClient, using the provided context for the underlying requests.
+	NewScoringClientWithContext(ctx context.Context, resourceGroup, workspace, endpointName string) (*workspace.ScoringClient, error)
+	// GetBatchEndpoints Return all the batch endpoints of the AML Workspace
+	GetBatchEndpoints(resourceGroup, workspace string) ([]workspace.BatchEndpoint, error)
+
+	// GetBatchEndpointsWithContext Same as GetBatchEndpoints, using the provided context for the underlying requests.
+	GetBatchEndpointsWithContext(ctx context.Context, resourceGroup, workspace string) ([]workspace.BatchEndpoint, error)
+
+	// NewBatchEndpointPager Return a pager for iterating page by page over the batch endpoints of the AML Workspace.
+	NewBatchEndpointPager(resourceGroup, workspace string) *workspace.BatchEndpointPager
+
+	// GetBatchEndpoint Return the batch endpoint with the name provided as argument
+	GetBatchEndpoint(resourceGroup, workspace, name string) (*workspace.BatchEndpoint, error)
+
+	// GetBatchEndpointWithContext Same as GetBatchEndpoint, using the provided context for the underlying requests.
+	GetBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.BatchEndpoint, error)
+
+	// CreateOrUpdateBatchEndpoint Start the creation or the update of the batch endpoint provided as argument
+	CreateOrUpdateBatchEndpoint(resourceGroup, workspace string, endpoint *workspace.BatchEndpoint) (*workspace.BatchEndpointPoller, error)
+
+	// CreateOrUpdateBatchEndpointWithContext Same as CreateOrUpdateBatchEndpoint, using the provided context for the underlying requests.
+	CreateOrUpdateBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace string, endpoint *workspace.BatchEndpoint) (*workspace.BatchEndpointPoller, error)
+
+	// DeleteBatchEndpoint Start the deletion of the batch endpoint with the name provided as argument
+	DeleteBatchEndpoint(resourceGroup, workspace, name string) (*workspace.DeletionPoller, error)
+
+	// DeleteBatchEndpointWithContext Same as DeleteBatchEndpoint, using the provided context for the underlying requests.
+	DeleteBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace, name string) (*workspace.DeletionPoller, error)
+
+	// GetBatchDeployments Return all the deployments of the batch endpoint with the name provided as argument
+	GetBatchDeployments(resourceGroup, workspace, endpointName string) ([]workspace.BatchDeployment, error)
+
+	// GetBatchDeploymentsWithContext Same as GetBatchDeployments, using the provided context for the underlying requests.
+	GetBatchDeploymentsWithContext(ctx context.Context, resourceGroup, workspace, endpointName string) ([]workspace.BatchDeployment, error)
+
+	// NewBatchDeploymentPager Return a pager for iterating page by page over the deployments of a batch endpoint.
+	NewBatchDeploymentPager(resourceGroup, workspace, endpointName string) *workspace.BatchDeploymentPager
+
+	// GetBatchDeployment Return the deployment with the name provided as argument of the specified batch endpoint
+	GetBatchDeployment(resourceGroup, workspace, endpointName, name string) (*workspace.BatchDeployment, error)
+
+	// GetBatchDeploymentWithContext Same as GetBatchDeployment, using the provided context for the underlying requests.
+	GetBatchDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*workspace.BatchDeployment, error)
+
+	// CreateOrUpdateBatchDeployment Start the creation or the update of the batch deployment provided as argument
+	CreateOrUpdateBatchDeployment(resourceGroup, workspace string, deployment *workspace.BatchDeployment) (*workspace.BatchDeploymentPoller, error)
+
+	// CreateOrUpdateBatchDeploymentWithContext Same as CreateOrUpdateBatchDeployment, using the provided context for the underlying requests.
+	CreateOrUpdateBatchDeploymentWithContext(ctx context.Context, resourceGroup, workspace string, deployment *workspace.BatchDeployment) (*workspace.BatchDeploymentPoller, error)
+
+	// DeleteBatchDeployment Start the deletion of the deployment with the name provided as argument of the specified batch endpoint
+	DeleteBatchDeployment(resourceGroup, workspace, endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// DeleteBatchDeploymentWithContext Same as DeleteBatchDeployment, using the provided context for the underlying requests.
+	DeleteBatchDeploymentWithContext(ctx context.Context, resourceGroup, workspace, endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// InvokeBatchEndpoint Start a job scoring the data of the input provided as argument with the specified batch endpoint
+	InvokeBatchEndpoint(resourceGroup, workspace, endpointName string, input workspace.JobInput, options *workspace.BatchInvocationOptions) (*workspace.Job, error)
+
+	// InvokeBatchEndpointWithContext Same as InvokeBatchEndpoint, using the provided context for the underlying requests.
+	InvokeBatchEndpointWithContext(ctx context.Context, resourceGroup, workspace, endpointName string, input workspace.JobInput, options *workspace.BatchInvocationOptions) (*workspace.Job, error)
+	// BeginCreateOrUpdateCompute Start the creation or the update of the compute provided as argument, returning a poller for waiting until the compute is provisioned
+	BeginCreateOrUpdateCompute(resourceGroup, workspace string, compute *workspace.Compute) (*workspace.ComputePoller, error)
+
+	// BeginCreateOrUpdateComputeWithContext Same as BeginCreateOrUpdateCompute, using the provided context for the underlying requests.
+	BeginCreateOrUpdateComputeWithContext(ctx context.Context, resourceGroup, workspace string, compute *workspace.Compute) (*workspace.ComputePoller, error)
+
+	// BeginDeleteCompute Start the deletion of the compute with the name provided as argument, returning a poller for waiting until the compute is deleted
+	BeginDeleteCompute(resourceGroup, workspace, name string, action workspace.UnderlyingResourceAction) (*workspace.DeletionPoller, error)
+
+	// BeginDeleteComputeWithContext Same as BeginDeleteCompute, using the provided context for the underlying requests.
+	BeginDeleteComputeWithContext(ctx context.Context, resourceGroup, workspace, name string, action workspace.UnderlyingResourceAction) (*workspace.DeletionPoller, error)
+	// GetAmlWorkspacesBySubscription Return all the AML workspaces of the subscription of the client
+	GetAmlWorkspacesBySubscription() ([]workspace.AmlWorkspace, error)
+
+	// GetAmlWorkspacesBySubscriptionWithContext Same as GetAmlWorkspacesBySubscription, using the provided context for the underlying requests.
+	GetAmlWorkspacesBySubscriptionWithContext(ctx context.Context) ([]workspace.AmlWorkspace, error)
+
+	// GetAmlWorkspaces Return all the AML workspaces of the resource group provided as argument
+	GetAmlWorkspaces(resourceGroup string) ([]workspace.AmlWorkspace, error)
+
+	// GetAmlWorkspacesWithContext Same as GetAmlWorkspaces, using the provided context for the underlying requests.
+	GetAmlWorkspacesWithContext(ctx context.Context, resourceGroup string) ([]workspace.AmlWorkspace, error)
+
+	// NewAmlWorkspacePager Return a pager for iterating page by page over the AML workspaces of the resource group, or of the subscription if the resource group is empty.
+	NewAmlWorkspacePager(resourceGroup string) *workspace.AmlWorkspacePager
+
+	// GetAmlWorkspace Return the AML workspace with the name provided as argument
+	GetAmlWorkspace(resourceGroup, name string) (*workspace.AmlWorkspace, error)
+
+	// GetAmlWorkspaceWithContext Same as GetAmlWorkspace, using the provided context for the underlying requests.
+	GetAmlWorkspaceWithContext(ctx context.Context, resourceGroup, name string) (*workspace.AmlWorkspace, error)
+
+	// CreateOrUpdateAmlWorkspace Start the creation or the update of the AML workspace provided as argument
+	CreateOrUpdateAmlWorkspace(resourceGroup string, workspace *workspace.AmlWorkspace) (*workspace.AmlWorkspacePoller, error)
+
+	// CreateOrUpdateAmlWorkspaceWithContext Same as CreateOrUpdateAmlWorkspace, using the provided context for the underlying requests.
+	CreateOrUpdateAmlWorkspaceWithContext(ctx context.Context, resourceGroup string, workspace *workspace.AmlWorkspace) (*workspace.AmlWorkspacePoller, error)
+
+	// DeleteAmlWorkspace Start the deletion of the AML workspace with the name provided as argument, purging it if forceToPurge is true
+	DeleteAmlWorkspace(resourceGroup, name string, forceToPurge bool) (*workspace.DeletionPoller, error)
+
+	// DeleteAmlWorkspaceWithContext Same as DeleteAmlWorkspace, using the provided context for the underlying requests.
+	DeleteAmlWorkspaceWithContext(ctx context.Context, resourceGroup, name string, forceToPurge bool) (*workspace.DeletionPoller, error)
+
+	// GetAmlWorkspaceKeys Return the keys of the resources associated with the AML workspace with the name provided as argument
+	GetAmlWorkspaceKeys(resourceGroup, name string) (*workspace.AmlWorkspaceKeys, error)
+
+	// GetAmlWorkspaceKeysWithContext Same as GetAmlWorkspaceKeys, using the provided context for the underlying requests.
+	GetAmlWorkspaceKeysWithContext(ctx context.Context, resourceGroup, name string) (*workspace.AmlWorkspaceKeys, error)
+
+	// ResyncAmlWorkspaceKeys Resynchronize the keys of the resources associated with the AML workspace with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	ResyncAmlWorkspaceKeys(resourceGroup, name string) error
+
+	// ResyncAmlWorkspaceKeysWithContext Same as ResyncAmlWorkspaceKeys, using the provided context for the underlying requests.
+	ResyncAmlWorkspaceKeysWithContext(ctx context.Context, resourceGroup, name string) error
+	// Scope Return a client bound to the AML Workspace with the resource group and the name provided as argument
+	Scope(resourceGroup, name string) (*workspace.ScopedWorkspace, error)
 }
+
+var _ WorkspaceAPI = (*workspace.Workspace)(nil)
+
+// ScopedWorkspaceAPI The operations of a client bound to a single AML Workspace
+type ScopedWorkspaceAPI interface {
+	// ResourceGroup Return the resource group of the workspace
+	ResourceGroup() string
+
+	// Name Return the name of the workspace
+	Name() string
+
+	// Workspace Return the client of the whole subscription
+	Workspace() *workspace.Workspace
+
+	// GetDatastores Return the list of datastore of the workspace.
+	GetDatastores() ([]workspace.Datastore, error)
+
+	// GetDatastoresWithContext Same as GetDatastores, using the provided context for the underlying requests.
+	GetDatastoresWithContext(ctx context.Context) ([]workspace.Datastore, error)
+
+	// NewDatastorePager Return a pager for iterating page by page over the datastores of the workspace.
+	NewDatastorePager() *workspace.DatastorePager
+
+	// GetDatastore Return the datastore with the name provided as argument.
+	GetDatastore(datastoreName string) (*workspace.Datastore, error)
+
+	// GetDatastoreWithContext Same as GetDatastore, using the provided context for the underlying requests.
+	GetDatastoreWithContext(ctx context.Context, datastoreName string) (*workspace.Datastore, error)
+
+	// DeleteDatastore Delete the datastore with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDatastore(datastoreName string) error
+
+	// DeleteDatastoreWithContext Same as DeleteDatastore, using the provided context for the underlying requests.
+	DeleteDatastoreWithContext(ctx context.Context, datastoreName string) error
+
+	// CreateOrUpdateDatastore Create or update the datastore with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateDatastore(datastore *workspace.Datastore) (*workspace.Datastore, error)
+
+	// CreateOrUpdateDatastoreWithContext Same as CreateOrUpdateDatastore, using the provided context for the underlying requests.
+	CreateOrUpdateDatastoreWithContext(ctx context.Context, datastore *workspace.Datastore) (*workspace.Datastore, error)
+
+	// GetDatasets Return the list of datasets of the workspace. For each dataset, only its latest version is returned.
+	GetDatasets() ([]workspace.Dataset, error)
+
+	// GetDatasetsWithContext Same as GetDatasets, using the provided context for the underlying requests.
+	GetDatasetsWithContext(ctx context.Context) ([]workspace.Dataset, error)
+
+	// NewDatasetPager Return a pager for iterating page by page over the datasets of the AML Workspace.
+	// For each dataset, only its latest version is returned.
+	NewDatasetPager() *workspace.DatasetPager
+
+	// GetDataset Return the dataset with the name and version provided as argument
+	GetDataset(name string, version int) (*workspace.Dataset, error)
+
+	// GetDatasetWithContext Same as GetDataset, using the provided context for the underlying requests.
+	GetDatasetWithContext(ctx context.Context, name string, version int) (*workspace.Dataset, error)
+
+	// GetDatasetNextVersion Return the next version of the dataset with the name provided as argument
+	GetDatasetNextVersion(name string) (int, error)
+
+	// GetDatasetNextVersionWithContext Same as GetDatasetNextVersion, using the provided context for the underlying requests.
+	GetDatasetNextVersionWithContext(ctx context.Context, name string) (int, error)
+
+	// GetDatasetVersions Return all the versions of the dataset with the name provided as argument
+	GetDatasetVersions(datasetName string) ([]workspace.Dataset, error)
+
+	// GetDatasetVersionsWithContext Same as GetDatasetVersions, using the provided context for the underlying requests.
+	GetDatasetVersionsWithContext(ctx context.Context, datasetName string) ([]workspace.Dataset, error)
+
+	// NewDatasetVersionPager Return a pager for iterating page by page over the versions of the dataset with the
+	// name provided as argument
+	NewDatasetVersionPager(datasetName string) *workspace.DatasetVersionPager
+
+	// CreateOrUpdateDataset Create or update the dataset with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateDataset(dataset *workspace.Dataset) (*workspace.Dataset, error)
+
+	// CreateOrUpdateDatasetWithContext Same as CreateOrUpdateDataset, using the provided context for the underlying requests.
+	CreateOrUpdateDatasetWithContext(ctx context.Context, dataset *workspace.Dataset) (*workspace.Dataset, error)
+
+	// DeleteDataset Delete the dataset (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDataset(datasetName string) error
+
+	// DeleteDatasetWithContext Same as DeleteDataset, using the provided context for the underlying requests.
+	DeleteDatasetWithContext(ctx context.Context, datasetName string) error
+
+	// DeleteDatasetVersion Delete the version provided as argument of the dataset with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDatasetVersion(datasetName string, version int) error
+
+	// DeleteDatasetVersionWithContext Same as DeleteDatasetVersion, using the provided context for the underlying requests.
+	DeleteDatasetVersionWithContext(ctx context.Context, datasetName string, version int) error
+
+	// GetDataAssets Return the list of data assets of the workspace. For each data asset, only its latest version is returned.
+	GetDataAssets() ([]workspace.DataAsset, error)
+
+	// GetDataAssetsWithContext Same as GetDataAssets, using the provided context for the underlying requests.
+	GetDataAssetsWithContext(ctx context.Context) ([]workspace.DataAsset, error)
+
+	// NewDataAssetPager Return a pager for iterating page by page over the data assets of the AML Workspace.
+	// For each data asset, only its latest version is returned.
+	NewDataAssetPager() *workspace.DataAssetPager
+
+	// GetDataAssetVersions Return all the versions of the data asset with the name provided as argument
+	GetDataAssetVersions(name string) ([]workspace.DataAsset, error)
+
+	// GetDataAssetVersionsWithContext Same as GetDataAssetVersions, using the provided context for the underlying requests.
+	GetDataAssetVersionsWithContext(ctx context.Context, name string) ([]workspace.DataAsset, error)
+
+	// NewDataAssetVersionPager Return a pager for iterating page by page over the versions of the data asset with the
+	// name provided as argument
+	NewDataAssetVersionPager(name string) *workspace.DataAssetVersionPager
+
+	// GetDataAsset Return the data asset with the name and version provided as argument
+	GetDataAsset(name, version string) (*workspace.DataAsset, error)
+
+	// GetDataAssetWithContext Same as GetDataAsset, using the provided context for the underlying requests.
+	GetDataAssetWithContext(ctx context.Context, name, version string) (*workspace.DataAsset, error)
+
+	// CreateOrUpdateDataAsset Create or update the data asset version with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateDataAsset(dataAsset *workspace.DataAsset) (*workspace.DataAsset, error)
+
+	// CreateOrUpdateDataAssetWithContext Same as CreateOrUpdateDataAsset, using the provided context for the underlying requests.
+	CreateOrUpdateDataAssetWithContext(ctx context.Context, dataAsset *workspace.DataAsset) (*workspace.DataAsset, error)
+
+	// DeleteDataAsset Delete the data asset (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDataAsset(name string) error
+
+	// DeleteDataAssetWithContext Same as DeleteDataAsset, using the provided context for the underlying requests.
+	DeleteDataAssetWithContext(ctx context.Context, name string) error
+
+	// DeleteDataAssetVersion Delete the version provided as argument of the data asset with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteDataAssetVersion(name, version string) error
+
+	// DeleteDataAssetVersionWithContext Same as DeleteDataAssetVersion, using the provided context for the underlying requests.
+	DeleteDataAssetVersionWithContext(ctx context.Context, name, version string) error
+
+	// GetModels Return the list of registered models of the workspace.
+	GetModels() ([]workspace.Model, error)
+
+	// GetModelsWithContext Same as GetModels, using the provided context for the underlying requests.
+	GetModelsWithContext(ctx context.Context) ([]workspace.Model, error)
+
+	// NewModelPager Return a pager for iterating page by page over the registered models of the workspace.
+	NewModelPager() *workspace.ModelPager
+
+	// GetModel Return the registered model with the name provided as argument
+	GetModel(name string) (*workspace.Model, error)
+
+	// GetModelWithContext Same as GetModel, using the provided context for the underlying requests.
+	GetModelWithContext(ctx context.Context, name string) (*workspace.Model, error)
+
+	// CreateOrUpdateModel Create or update the registered model with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateModel(model *workspace.Model) (*workspace.Model, error)
+
+	// CreateOrUpdateModelWithContext Same as CreateOrUpdateModel, using the provided context for the underlying requests.
+	CreateOrUpdateModelWithContext(ctx context.Context, model *workspace.Model) (*workspace.Model, error)
+
+	// ArchiveModel Archive the registered model with the name provided as argument
+	ArchiveModel(name string) (*workspace.Model, error)
+
+	// ArchiveModelWithContext Same as ArchiveModel, using the provided context for the underlying requests.
+	ArchiveModelWithContext(ctx context.Context, name string) (*workspace.Model, error)
+
+	// DeleteModel Delete the registered model (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteModel(name string) error
+
+	// DeleteModelWithContext Same as DeleteModel, using the provided context for the underlying requests.
+	DeleteModelWithContext(ctx context.Context, name string) error
+
+	// GetModelVersions Return all the versions of the model with the name provided as argument
+	GetModelVersions(modelName string) ([]workspace.ModelVersion, error)
+
+	// GetModelVersionsWithContext Same as GetModelVersions, using the provided context for the underlying requests.
+	GetModelVersionsWithContext(ctx context.Context, modelName string) ([]workspace.ModelVersion, error)
+
+	// NewModelVersionPager Return a pager for iterating page by page over the versions of the model with the
+	// name provided as argument
+	NewModelVersionPager(modelName string) *workspace.ModelVersionPager
+
+	// GetModelVersion Return the model version with the name and version provided as argument
+	GetModelVersion(modelName, version string) (*workspace.ModelVersion, error)
+
+	// GetModelVersionWithContext Same as GetModelVersion, using the provided context for the underlying requests.
+	GetModelVersionWithContext(ctx context.Context, modelName, version string) (*workspace.ModelVersion, error)
+
+	// CreateOrUpdateModelVersion Create or update the model version with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateModelVersion(modelVersion *workspace.ModelVersion) (*workspace.ModelVersion, error)
+
+	// CreateOrUpdateModelVersionWithContext Same as CreateOrUpdateModelVersion, using the provided context for the underlying requests.
+	CreateOrUpdateModelVersionWithContext(ctx context.Context, modelVersion *workspace.ModelVersion) (*workspace.ModelVersion, error)
+
+	// ArchiveModelVersion Archive the version provided as argument of the model with the specified name
+	ArchiveModelVersion(modelName, version string) (*workspace.ModelVersion, error)
+
+	// ArchiveModelVersionWithContext Same as ArchiveModelVersion, using the provided context for the underlying requests.
+	ArchiveModelVersionWithContext(ctx context.Context, modelName, version string) (*workspace.ModelVersion, error)
+
+	// DeleteModelVersion Delete the version provided as argument of the model with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteModelVersion(modelName, version string) error
+
+	// DeleteModelVersionWithContext Same as DeleteModelVersion, using the provided context for the underlying requests.
+	DeleteModelVersionWithContext(ctx context.Context, modelName, version string) error
+
+	// GetEnvironments Return the list of environments of the workspace.
+	GetEnvironments() ([]workspace.Environment, error)
+
+	// GetEnvironmentsWithContext Same as GetEnvironments, using the provided context for the underlying requests.
+	GetEnvironmentsWithContext(ctx context.Context) ([]workspace.Environment, error)
+
+	// NewEnvironmentPager Return a pager for iterating page by page over the environments of the workspace.
+	NewEnvironmentPager() *workspace.EnvironmentPager
+
+	// GetEnvironment Return the environment with the name provided as argument
+	GetEnvironment(name string) (*workspace.Environment, error)
+
+	// GetEnvironmentWithContext Same as GetEnvironment, using the provided context for the underlying requests.
+	GetEnvironmentWithContext(ctx context.Context, name string) (*workspace.Environment, error)
+
+	// CreateOrUpdateEnvironment Create or update the environment with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateEnvironment(environment *workspace.Environment) (*workspace.Environment, error)
+
+	// CreateOrUpdateEnvironmentWithContext Same as CreateOrUpdateEnvironment, using the provided context for the underlying requests.
+	CreateOrUpdateEnvironmentWithContext(ctx context.Context, environment *workspace.Environment) (*workspace.Environment, error)
+
+	// ArchiveEnvironment Archive the environment with the name provided as argument
+	ArchiveEnvironment(name string) (*workspace.Environment, error)
+
+	// ArchiveEnvironmentWithContext Same as ArchiveEnvironment, using the provided context for the underlying requests.
+	ArchiveEnvironmentWithContext(ctx context.Context, name string) (*workspace.Environment, error)
+
+	// GetEnvironmentVersions Return all the versions of the environment with the name provided as argument
+	GetEnvironmentVersions(environmentName string) ([]workspace.EnvironmentVersion, error)
+
+	// GetEnvironmentVersionsWithContext Same as GetEnvironmentVersions, using the provided context for the underlying requests.
+	GetEnvironmentVersionsWithContext(ctx context.Context, environmentName string) ([]workspace.EnvironmentVersion, error)
+
+	// NewEnvironmentVersionPager Return a pager for iterating page by page over the versions of the environment with the
+	// name provided as argument
+	NewEnvironmentVersionPager(environmentName string) *workspace.EnvironmentVersionPager
+
+	// GetEnvironmentVersion Return the environment version with the name and version provided as argument
+	GetEnvironmentVersion(environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// GetEnvironmentVersionWithContext Same as GetEnvironmentVersion, using the provided context for the underlying requests.
+	GetEnvironmentVersionWithContext(ctx context.Context, environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// CreateOrUpdateEnvironmentVersion Create or update the environment version with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateEnvironmentVersion(environmentVersion *workspace.EnvironmentVersion) (*workspace.EnvironmentVersion, error)
+
+	// CreateOrUpdateEnvironmentVersionWithContext Same as CreateOrUpdateEnvironmentVersion, using the provided context for the underlying requests.
+	CreateOrUpdateEnvironmentVersionWithContext(ctx context.Context, environmentVersion *workspace.EnvironmentVersion) (*workspace.EnvironmentVersion, error)
+
+	// ArchiveEnvironmentVersion Archive the version provided as argument of the environment with the specified name
+	ArchiveEnvironmentVersion(environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// ArchiveEnvironmentVersionWithContext Same as ArchiveEnvironmentVersion, using the provided context for the underlying requests.
+	ArchiveEnvironmentVersionWithContext(ctx context.Context, environmentName, version string) (*workspace.EnvironmentVersion, error)
+
+	// GetComputes Return the list of computes of the workspace.
+	GetComputes() ([]workspace.Compute, error)
+
+	// GetComputesWithContext Same as GetComputes, using the provided context for the underlying requests.
+	GetComputesWithContext(ctx context.Context) ([]workspace.Compute, error)
+
+	// NewComputePager Return a pager for iterating page by page over the computes of the workspace.
+	NewComputePager() *workspace.ComputePager
+
+	// GetCompute Return the compute with the name provided as argument
+	GetCompute(name string) (*workspace.Compute, error)
+
+	// GetComputeWithContext Same as GetCompute, using the provided context for the underlying requests.
+	GetComputeWithContext(ctx context.Context, name string) (*workspace.Compute, error)
+
+	// CreateOrUpdateCompute Create or update the compute with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateCompute(compute *workspace.Compute) (*workspace.Compute, error)
+
+	// CreateOrUpdateComputeWithContext Same as CreateOrUpdateCompute, using the provided context for the underlying requests.
+	CreateOrUpdateComputeWithContext(ctx context.Context, compute *workspace.Compute) (*workspace.Compute, error)
+
+	// DeleteCompute Delete or detach the compute with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteCompute(name string, action workspace.UnderlyingResourceAction) error
+
+	// DeleteComputeWithContext Same as DeleteCompute, using the provided context for the underlying requests.
+	DeleteComputeWithContext(ctx context.Context, name string, action workspace.UnderlyingResourceAction) error
+
+	// StartComputeInstance Start the compute instance with the name provided as argument
+	StartComputeInstance(name string) error
+
+	// StartComputeInstanceWithContext Same as StartComputeInstance, using the provided context for the underlying requests.
+	StartComputeInstanceWithContext(ctx context.Context, name string) error
+
+	// StopComputeInstance Stop the compute instance with the name provided as argument
+	StopComputeInstance(name string) error
+
+	// StopComputeInstanceWithContext Same as StopComputeInstance, using the provided context for the underlying requests.
+	StopComputeInstanceWithContext(ctx context.Context, name string) error
+
+	// RestartComputeInstance Restart the compute instance with the name provided as argument
+	RestartComputeInstance(name string) error
+
+	// RestartComputeInstanceWithContext Same as RestartComputeInstance, using the provided context for the underlying requests.
+	RestartComputeInstanceWithContext(ctx context.Context, name string) error
+
+	// ListComputeNodes Return the nodes of the AmlCompute cluster with the name provided as argument
+	ListComputeNodes(name string) ([]workspace.ComputeNode, error)
+
+	// ListComputeNodesWithContext Same as ListComputeNodes, using the provided context for the underlying requests.
+	ListComputeNodesWithContext(ctx context.Context, name string) ([]workspace.ComputeNode, error)
+
+	// GetJobs Return the list of jobs of the workspace matching the filter provided as argument.
+	GetJobs(filter workspace.JobFilter) ([]workspace.Job, error)
+
+	// GetJobsWithContext Same as GetJobs, using the provided context for the underlying requests.
+	GetJobsWithContext(ctx context.Context, filter workspace.JobFilter) ([]workspace.Job, error)
+
+	// NewJobPager Return a pager for iterating page by page over the jobs of the workspace.
+	NewJobPager(filter workspace.JobFilter) *workspace.JobPager
+
+	// GetJob Return the job with the name provided as argument
+	GetJob(name string) (*workspace.Job, error)
+
+	// GetJobWithContext Same as GetJob, using the provided context for the underlying requests.
+	GetJobWithContext(ctx context.Context, name string) (*workspace.Job, error)
+
+	// CreateOrUpdateJob Submit the job provided as argument, or update it if it already exists. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateJob(job *workspace.Job) (*workspace.Job, error)
+
+	// CreateOrUpdateJobWithContext Same as CreateOrUpdateJob, using the provided context for the underlying requests.
+	CreateOrUpdateJobWithContext(ctx context.Context, job *workspace.Job) (*workspace.Job, error)
+
+	// CancelJob Request the cancellation of the job with the name provided as argument
+	CancelJob(name string) error
+
+	// CancelJobWithContext Same as CancelJob, using the provided context for the underlying requests.
+	CancelJobWithContext(ctx context.Context, name string) error
+
+	// WaitForJob Wait until the job with the name provided as argument reaches a terminal status
+	WaitForJob(name string, pollInterval time.Duration) (*workspace.Job, error)
+
+	// WaitForJobWithContext Same as WaitForJob, using the provided context for the underlying requests.
+	WaitForJobWithContext(ctx context.Context, name string, pollInterval time.Duration) (*workspace.Job, error)
+
+	// WatchJob Poll the job with the name provided as argument until it reaches a terminal status, calling
+	// onStatusChange every time its status changes. Give up if the job stays unresponsive for longer than
+	// options.UnresponsiveTimeout.
+	WatchJob(name string, options workspace.WatchJobOptions, onStatusChange func(change workspace.JobStatusChange)) (*workspace.Job, error)
+
+	// WatchJobWithContext Same as WatchJob, using the provided context for the underlying requests.
+	WatchJobWithContext(ctx context.Context, name string, options workspace.WatchJobOptions, onStatusChange func(change workspace.JobStatusChange)) (*workspace.Job, error)
+
+	// GetComponents Return all the components of the workspace
+	GetComponents() ([]workspace.Component, error)
+
+	// GetComponentsWithContext Same as GetComponents, using the provided context for the underlying requests.
+	GetComponentsWithContext(ctx context.Context) ([]workspace.Component, error)
+
+	// NewComponentPager Return a pager for iterating page by page over the components of the workspace.
+	NewComponentPager() *workspace.ComponentPager
+
+	// GetComponent Return the component with the name provided as argument
+	GetComponent(name string) (*workspace.Component, error)
+
+	// GetComponentWithContext Same as GetComponent, using the provided context for the underlying requests.
+	GetComponentWithContext(ctx context.Context, name string) (*workspace.Component, error)
+
+	// CreateOrUpdateComponent Create or update the component with the data provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateComponent(component *workspace.Component) (*workspace.Component, error)
+
+	// CreateOrUpdateComponentWithContext Same as CreateOrUpdateComponent, using the provided context for the underlying requests.
+	CreateOrUpdateComponentWithContext(ctx context.Context, component *workspace.Component) (*workspace.Component, error)
+
+	// ArchiveComponent Archive the component with the name provided as argument
+	ArchiveComponent(name string) (*workspace.Component, error)
+
+	// ArchiveComponentWithContext Same as ArchiveComponent, using the provided context for the underlying requests.
+	ArchiveComponentWithContext(ctx context.Context, name string) (*workspace.Component, error)
+
+	// DeleteComponent Delete the component (all its versions) with the name provided as argument. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteComponent(name string) error
+
+	// DeleteComponentWithContext Same as DeleteComponent, using the provided context for the underlying requests.
+	DeleteComponentWithContext(ctx context.Context, name string) error
+
+	// GetComponentVersions Return all the versions of the component with the name provided as argument
+	GetComponentVersions(componentName string) ([]workspace.ComponentVersion, error)
+
+	// GetComponentVersionsWithContext Same as GetComponentVersions, using the provided context for the underlying requests.
+	GetComponentVersionsWithContext(ctx context.Context, componentName string) ([]workspace.ComponentVersion, error)
+
+	// NewComponentVersionPager Return a pager for iterating page by page over the versions of the component with the
+	// name provided as argument.
+	NewComponentVersionPager(componentName string) *workspace.ComponentVersionPager
+
+	// GetComponentVersion Return the component version with the name and version provided as argument
+	GetComponentVersion(componentName, version string) (*workspace.ComponentVersion, error)
+
+	// GetComponentVersionWithContext Same as GetComponentVersion, using the provided context for the underlying requests.
+	GetComponentVersionWithContext(ctx context.Context, componentName, version string) (*workspace.ComponentVersion, error)
+
+	// CreateOrUpdateComponentVersion Create or update the component version with the data provided as argument, checking
+	// that every placeholder of the command references a declared input or output. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	CreateOrUpdateComponentVersion(componentVersion *workspace.ComponentVersion) (*workspace.ComponentVersion, error)
+
+	// CreateOrUpdateComponentVersionWithContext Same as CreateOrUpdateComponentVersion, using the provided context for the underlying requests.
+	CreateOrUpdateComponentVersionWithContext(ctx context.Context, componentVersion *workspace.ComponentVersion) (*workspace.ComponentVersion, error)
+
+	// ArchiveComponentVersion Archive the version provided as argument of the component with the specified name
+	ArchiveComponentVersion(componentName, version string) (*workspace.ComponentVersion, error)
+
+	// ArchiveComponentVersionWithContext Same as ArchiveComponentVersion, using the provided context for the underlying requests.
+	ArchiveComponentVersionWithContext(ctx context.Context, componentName, version string) (*workspace.ComponentVersion, error)
+
+	// DeleteComponentVersion Delete the version provided as argument of the component with the specified name. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	DeleteComponentVersion(componentName, version string) error
+
+	// DeleteComponentVersionWithContext Same as DeleteComponentVersion, using the provided context for the underlying requests.
+	DeleteComponentVersionWithContext(ctx context.Context, componentName, version string) error
+
+	// GetOnlineEndpoints Return all the online endpoints of the workspace
+	GetOnlineEndpoints() ([]workspace.OnlineEndpoint, error)
+
+	// GetOnlineEndpointsWithContext Same as GetOnlineEndpoints, using the provided context for the underlying requests.
+	GetOnlineEndpointsWithContext(ctx context.Context) ([]workspace.OnlineEndpoint, error)
+
+	// NewOnlineEndpointPager Return a pager for iterating page by page over the online endpoints of the workspace.
+	NewOnlineEndpointPager() *workspace.OnlineEndpointPager
+
+	// GetOnlineEndpoint Return the online endpoint with the name provided as argument
+	GetOnlineEndpoint(name string) (*workspace.OnlineEndpoint, error)
+
+	// GetOnlineEndpointWithContext Same as GetOnlineEndpoint, using the provided context for the underlying requests.
+	GetOnlineEndpointWithContext(ctx context.Context, name string) (*workspace.OnlineEndpoint, error)
+
+	// CreateOrUpdateOnlineEndpoint Start the creation or the update of the online endpoint provided as argument
+	CreateOrUpdateOnlineEndpoint(endpoint *workspace.OnlineEndpoint) (*workspace.OnlineEndpointPoller, error)
+
+	// CreateOrUpdateOnlineEndpointWithContext Same as CreateOrUpdateOnlineEndpoint, using the provided context for the underlying requests.
+	CreateOrUpdateOnlineEndpointWithContext(ctx context.Context, endpoint *workspace.OnlineEndpoint) (*workspace.OnlineEndpointPoller, error)
+
+	// UpdateOnlineEndpointTraffic Start updating the traffic that the online endpoint routes to each deployment
+	UpdateOnlineEndpointTraffic(name string, traffic map[string]int) (*workspace.OnlineEndpointPoller, error)
+
+	// UpdateOnlineEndpointTrafficWithContext Same as UpdateOnlineEndpointTraffic, using the provided context for the underlying requests.
+	UpdateOnlineEndpointTrafficWithContext(ctx context.Context, name string, traffic map[string]int) (*workspace.OnlineEndpointPoller, error)
+
+	// DeleteOnlineEndpoint Start the deletion of the online endpoint with the name provided as argument
+	DeleteOnlineEndpoint(name string) (*workspace.DeletionPoller, error)
+
+	// DeleteOnlineEndpointWithContext Same as DeleteOnlineEndpoint, using the provided context for the underlying requests.
+	DeleteOnlineEndpointWithContext(ctx context.Context, name string) (*workspace.DeletionPoller, error)
+
+	// GetOnlineDeployments Return all the deployments of the online endpoint with the name provided as argument
+	GetOnlineDeployments(endpointName string) ([]workspace.OnlineDeployment, error)
+
+	// GetOnlineDeploymentsWithContext Same as GetOnlineDeployments, using the provided context for the underlying requests.
+	GetOnlineDeploymentsWithContext(ctx context.Context, endpointName string) ([]workspace.OnlineDeployment, error)
+
+	// NewOnlineDeploymentPager Return a pager for iterating page by page over the deployments of the online endpoint
+	// with the name provided as argument.
+	NewOnlineDeploymentPager(endpointName string) *workspace.OnlineDeploymentPager
+
+	// GetOnlineDeployment Return the deployment with the name provided as argument of the specified online endpoint
+	GetOnlineDeployment(endpointName, name string) (*workspace.OnlineDeployment, error)
+
+	// GetOnlineDeploymentWithContext Same as GetOnlineDeployment, using the provided context for the underlying requests.
+	GetOnlineDeploymentWithContext(ctx context.Context, endpointName, name string) (*workspace.OnlineDeployment, error)
+
+	// CreateOrUpdateOnlineDeployment Start the creation or the update of the online deployment provided as argument
+	CreateOrUpdateOnlineDeployment(deployment *workspace.OnlineDeployment) (*workspace.OnlineDeploymentPoller, error)
+
+	// CreateOrUpdateOnlineDeploymentWithContext Same as CreateOrUpdateOnlineDeployment, using the provided context for the underlying requests.
+	CreateOrUpdateOnlineDeploymentWithContext(ctx context.Context, deployment *workspace.OnlineDeployment) (*workspace.OnlineDeploymentPoller, error)
+
+	// DeleteOnlineDeployment Start the deletion of the deployment with the name provided as argument of the specified online endpoint
+	DeleteOnlineDeployment(endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// DeleteOnlineDeploymentWithContext Same as DeleteOnlineDeployment, using the provided context for the underlying requests.
+	DeleteOnlineDeploymentWithContext(ctx context.Context, endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// GetOnlineEndpointKeys Return the keys of the online endpoint with the name provided as argument, which must use the Key auth mode
+	GetOnlineEndpointKeys(name string) (*workspace.EndpointKeys, error)
+
+	// GetOnlineEndpointKeysWithContext Same as GetOnlineEndpointKeys, using the provided context for the underlying requests.
+	GetOnlineEndpointKeysWithContext(ctx context.Context, name string) (*workspace.EndpointKeys, error)
+
+	// GetOnlineEndpointToken Return a new token for the online endpoint with the name provided as argument, which must use the AMLToken auth mode
+	GetOnlineEndpointToken(name string) (*workspace.EndpointToken, error)
+
+	// GetOnlineEndpointTokenWithContext Same as GetOnlineEndpointToken, using the provided context for the underlying requests.
+	GetOnlineEndpointTokenWithContext(ctx context.Context, name string) (*workspace.EndpointToken, error)
+
+	// NewScoringClient Return a client for sending scoring requests to the online endpoint with the name provided as argument
+	NewScoringClient(endpointName string) (*workspace.ScoringClient, error)
+
+	// NewScoringClientWithContext Same as NewScoringClient, using the provided context for the underlying requests.
+	NewScoringClientWithContext(ctx context.Context, endpointName string) (*workspace.ScoringClient, error)
+
+	// GetBatchEndpoints Return all the batch endpoints of the workspace
+	GetBatchEndpoints() ([]workspace.BatchEndpoint, error)
+
+	// GetBatchEndpointsWithContext Same as GetBatchEndpoints, using the provided context for the underlying requests.
+	GetBatchEndpointsWithContext(ctx context.Context) ([]workspace.BatchEndpoint, error)
+
+	// NewBatchEndpointPager Return a pager for iterating page by page over the batch endpoints of the workspace.
+	NewBatchEndpointPager() *workspace.BatchEndpointPager
+
+	// GetBatchEndpoint Return the batch endpoint with the name provided as argument
+	GetBatchEndpoint(name string) (*workspace.BatchEndpoint, error)
+
+	// GetBatchEndpointWithContext Same as GetBatchEndpoint, using the provided context for the underlying requests.
+	GetBatchEndpointWithContext(ctx context.Context, name string) (*workspace.BatchEndpoint, error)
+
+	// CreateOrUpdateBatchEndpoint Start the creation or the update of the batch endpoint provided as argument
+	CreateOrUpdateBatchEndpoint(endpoint *workspace.BatchEndpoint) (*workspace.BatchEndpointPoller, error)
+
+	// CreateOrUpdateBatchEndpointWithContext Same as CreateOrUpdateBatchEndpoint, using the provided context for the underlying requests.
+	CreateOrUpdateBatchEndpointWithContext(ctx context.Context, endpoint *workspace.BatchEndpoint) (*workspace.BatchEndpointPoller, error)
+
+	// DeleteBatchEndpoint Start the deletion of the batch endpoint with the name provided as argument
+	DeleteBatchEndpoint(name string) (*workspace.DeletionPoller, error)
+
+	// DeleteBatchEndpointWithContext Same as DeleteBatchEndpoint, using the provided context for the underlying requests.
+	DeleteBatchEndpointWithContext(ctx context.Context, name string) (*workspace.DeletionPoller, error)
+
+	// GetBatchDeployments Return all the deployments of the batch endpoint with the name provided as argument
+	GetBatchDeployments(endpointName string) ([]workspace.BatchDeployment, error)
+
+	// GetBatchDeploymentsWithContext Same as GetBatchDeployments, using the provided context for the underlying requests.
+	GetBatchDeploymentsWithContext(ctx context.Context, endpointName string) ([]workspace.BatchDeployment, error)
+
+	// NewBatchDeploymentPager Return a pager for iterating page by page over the deployments of a batch endpoint.
+	NewBatchDeploymentPager(endpointName string) *workspace.BatchDeploymentPager
+
+	// GetBatchDeployment Return the deployment with the name provided as argument of the specified batch endpoint
+	GetBatchDeployment(endpointName, name string) (*workspace.BatchDeployment, error)
+
+	// GetBatchDeploymentWithContext Same as GetBatchDeployment, using the provided context for the underlying requests.
+	GetBatchDeploymentWithContext(ctx context.Context, endpointName, name string) (*workspace.BatchDeployment, error)
+
+	// CreateOrUpdateBatchDeployment Start the creation or the update of the batch deployment provided as argument
+	CreateOrUpdateBatchDeployment(deployment *workspace.BatchDeployment) (*workspace.BatchDeploymentPoller, error)
+
+	// CreateOrUpdateBatchDeploymentWithContext Same as CreateOrUpdateBatchDeployment, using the provided context for the underlying requests.
+	CreateOrUpdateBatchDeploymentWithContext(ctx context.Context, deployment *workspace.BatchDeployment) (*workspace.BatchDeploymentPoller, error)
+
+	// DeleteBatchDeployment Start the deletion of the deployment with the name provided as argument of the specified batch endpoint
+	DeleteBatchDeployment(endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// DeleteBatchDeploymentWithContext Same as DeleteBatchDeployment, using the provided context for the underlying requests.
+	DeleteBatchDeploymentWithContext(ctx context.Context, endpointName, name string) (*workspace.DeletionPoller, error)
+
+	// InvokeBatchEndpoint Start a job scoring the data of the input provided as argument with the specified batch endpoint
+	InvokeBatchEndpoint(endpointName string, input workspace.JobInput, options *workspace.BatchInvocationOptions) (*workspace.Job, error)
+
+	// InvokeBatchEndpointWithContext Same as InvokeBatchEndpoint, using the provided context for the underlying requests.
+	InvokeBatchEndpointWithContext(ctx context.Context, endpointName string, input workspace.JobInput, options *workspace.BatchInvocationOptions) (*workspace.Job, error)
+
+	// BeginCreateOrUpdateCompute Start the creation or the update of the compute provided as argument, returning a poller for waiting until the compute is provisioned
+	BeginCreateOrUpdateCompute(compute *workspace.Compute) (*workspace.ComputePoller, error)
+
+	// BeginCreateOrUpdateComputeWithContext Same as BeginCreateOrUpdateCompute, using the provided context for the underlying requests.
+	BeginCreateOrUpdateComputeWithContext(ctx context.Context, compute *workspace.Compute) (*workspace.ComputePoller, error)
+
+	// BeginDeleteCompute Start the deletion of the compute with the name provided as argument, returning a poller for waiting until the compute is deleted
+	BeginDeleteCompute(name string, action workspace.UnderlyingResourceAction) (*workspace.DeletionPoller, error)
+
+	// BeginDeleteComputeWithContext Same as BeginDeleteCompute, using the provided context for the underlying requests.
+	BeginDeleteComputeWithContext(ctx context.Context, name string, action workspace.UnderlyingResourceAction) (*workspace.DeletionPoller, error)
+
+	// GetAmlWorkspace Return the workspace
+	GetAmlWorkspace() (*workspace.AmlWorkspace, error)
+
+	// GetAmlWorkspaceWithContext Same as GetAmlWorkspace, using the provided context for the underlying requests.
+	GetAmlWorkspaceWithContext(ctx context.Context) (*workspace.AmlWorkspace, error)
+
+	// DeleteAmlWorkspace Start the deletion of the workspace, purging it if forceToPurge is true
+	DeleteAmlWorkspace(forceToPurge bool) (*workspace.DeletionPoller, error)
+
+	// DeleteAmlWorkspaceWithContext Same as DeleteAmlWorkspace, using the provided context for the underlying requests.
+	DeleteAmlWorkspaceWithContext(ctx context.Context, forceToPurge bool) (*workspace.DeletionPoller, error)
+
+	// GetAmlWorkspaceKeys Return the keys of the resources associated with the workspace
+	GetAmlWorkspaceKeys() (*workspace.AmlWorkspaceKeys, error)
+
+	// GetAmlWorkspaceKeysWithContext Same as GetAmlWorkspaceKeys, using the provided context for the underlying requests.
+	GetAmlWorkspaceKeysWithContext(ctx context.Context) (*workspace.AmlWorkspaceKeys, error)
+
+	// ResyncAmlWorkspaceKeys Resynchronize the keys of the resources associated with the workspace. Block until the operation completes, checking it every workspace.DefaultOperationPollInterval if the APIs run it asynchronously.
+	ResyncAmlWorkspaceKeys() error
+
+	// ResyncAmlWorkspaceKeysWithContext Same as ResyncAmlWorkspaceKeys, using the provided context for the underlying requests.
+	ResyncAmlWorkspaceKeysWithContext(ctx context.Context) error
+}
+
+var _ ScopedWorkspaceAPI = (*workspace.ScopedWorkspace)(nil)